@@ -7,18 +7,31 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var (
+	cleanDryRunFlag bool
+	cleanGlobalFlag bool
+	cleanYesFlag    bool
+)
+
 type cleanImplParams struct {
+	dryRun    bool
+	global    bool
+	yes       bool
 	container container.ContainerCLI
 }
 
 func cleanImpl(p *cleanImplParams) error {
-	return p.container.CleanResources()
+	return p.container.CleanResources(container.CleanOptions{
+		DryRun: p.dryRun,
+		Global: p.global,
+		Yes:    p.yes,
+	})
 }
 
 var cleanCmd = &cobra.Command{
 	Use:   "clean",
-	Short: "Remove containers e redes parados no Docker",
-	Long:  "Executa uma varredura de manutenção no host, liberando recursos do Motor de containers ao remover agressivamente containers parados, volumes anônimos não referenciados e redes órfãs geradas pelos ciclos de Dev Containers.",
+	Short: "Remove os recursos de dev containers parados",
+	Long:  "Remove apenas os recursos deixados por dev containers parados: seus containers e serviços do compose, volumes anônimos, redes do projeto compose e imagens vsc-* que nenhum container usa. Ao final, exibe o tamanho das imagens removidas, uma estimativa que inclui camadas compartilhadas com outras imagens. Use --dry-run para listar o que seria removido e --global para remover todos os containers parados, redes não utilizadas e o cache de build sem referência do motor. O cache de build é compartilhado por todo o motor e não pertence a um workspace, por isso só é limpo com --global. A remoção pede confirmação, que pode ser dispensada com --yes e é obrigatória sem um terminal na entrada.",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		executor := exec.NewExecutor()
 		config := config.NewConfig()
@@ -29,11 +42,17 @@ var cleanCmd = &cobra.Command{
 		)
 
 		return cleanImpl(&cleanImplParams{
+			dryRun:    cleanDryRunFlag,
+			global:    cleanGlobalFlag,
+			yes:       cleanYesFlag,
 			container: container,
 		})
 	},
 }
 
 func init() {
+	cleanCmd.Flags().BoolVar(&cleanDryRunFlag, "dry-run", false, "Lista os recursos que seriam removidos sem remover nada")
+	cleanCmd.Flags().BoolVar(&cleanGlobalFlag, "global", false, "Remove todos os containers parados, redes não utilizadas e o cache de build do motor, não só os de dev containers")
+	cleanCmd.Flags().BoolVarP(&cleanYesFlag, "yes", "y", false, "Remove sem pedir confirmação")
 	rootCmd.AddCommand(cleanCmd)
 }
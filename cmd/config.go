@@ -10,6 +10,7 @@ import (
 )
 
 var globalFlag bool
+var localFlag bool
 var interactiveFlag bool
 
 type configImplParams struct {
@@ -18,11 +19,6 @@ type configImplParams struct {
 }
 
 func configImpl(p *configImplParams) error {
-	if !globalFlag {
-		logger.Error("Atualmente apenas a flag --global é suportada")
-		return nil
-	}
-
 	key := p.args[0]
 	if !p.config.ValidateKey(key) {
 		logger.Error("Chave `%s` de configuração desconhecida", key)
@@ -30,7 +26,17 @@ func configImpl(p *configImplParams) error {
 	}
 
 	if len(p.args) == 2 || interactiveFlag {
-		value, err := p.config.TrySave(key, p.args[1])
+		if !globalFlag && !localFlag {
+			logger.Error("Informe o escopo da configuração com --global ou --local")
+			return nil
+		}
+
+		var value string
+		if len(p.args) == 2 {
+			value = p.args[1]
+		}
+
+		value, err := p.config.TrySave(key, value)
 		if err != nil {
 			logger.Error("Erro ao salvar a configuração `%s` com o valor `%s`", key, value)
 			return nil
@@ -40,9 +46,12 @@ func configImpl(p *configImplParams) error {
 		return nil
 	}
 
-	value := p.config.LoadByKey(key)
+	value, source, err := p.config.LoadByKeyWithSource(key)
+	if err != nil {
+		return err
+	}
 
-	logger.Info(value)
+	logger.Info("%s (origem: %s)", value, source)
 
 	return nil
 }
@@ -50,6 +59,7 @@ func configImpl(p *configImplParams) error {
 var configCmd = &cobra.Command{
 	Use:          "config [chave] [valor]",
 	Short:        "Gerencia as configurações da CLI",
+	Long:         "Lê e grava as configurações da CLI. As configurações são resolvidas na ordem flag > variável de ambiente > projeto (.dev-cli.json) > perfil ativo > global (~/.dev-cli/config.json) > padrão. Com um perfil ativo, --global grava no perfil. Use os subcomandos para listar, remover, exportar, importar ou editar as configurações e para trocar de perfil.",
 	SilenceUsage: true,
 	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		if len(args) == 0 {
@@ -66,7 +76,7 @@ var configCmd = &cobra.Command{
 			key := args[0]
 			if handler, exists := (*config.GetHandlers())[key]; exists {
 				var values []string
-				for _, v := range handler.ValidValues {
+				for _, v := range config.GetCompletionValues(&handler) {
 					if strings.HasPrefix(v, toComplete) {
 						values = append(values, v)
 					}
@@ -88,6 +98,7 @@ var configCmd = &cobra.Command{
 			config.WithConfigFlags(
 				&config.ConfigFlags{
 					Global:     globalFlag,
+					Local:      localFlag,
 					Interative: interactiveFlag,
 				},
 			),
@@ -101,7 +112,9 @@ var configCmd = &cobra.Command{
 }
 
 func init() {
-	configCmd.Flags().BoolVar(&globalFlag, "global", false, "Aplica a configuração no escopo global")
+	configCmd.PersistentFlags().BoolVar(&globalFlag, "global", false, "Aplica a configuração no escopo global")
+	configCmd.PersistentFlags().BoolVar(&localFlag, "local", false, "Aplica a configuração no projeto atual (.dev-cli.json)")
+	configCmd.MarkFlagsMutuallyExclusive("global", "local")
 	configCmd.Flags().BoolVarP(&interactiveFlag, "interactive", "i", false, "Abre um menu interativo para seleção de opções válidas")
 	rootCmd.AddCommand(configCmd)
 }
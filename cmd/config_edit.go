@@ -0,0 +1,147 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+	"runtime"
+	"strings"
+
+	"github.com/Brennon-Oliveira/dev-cli/internal/config"
+	"github.com/Brennon-Oliveira/dev-cli/internal/exec"
+	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
+	"github.com/spf13/cobra"
+)
+
+type configEditImplParams struct {
+	config     config.Config
+	executor   exec.Executor
+	getenv     func(key string) string
+	readFile   func(name string) ([]byte, error)
+	createTemp func(dir string, pattern string) (*os.File, error)
+	remove     func(name string) error
+}
+
+func getEditorCommand(getenv func(key string) string) []string {
+	for _, name := range []string{"VISUAL", "EDITOR"} {
+		if fields := strings.Fields(getenv(name)); len(fields) > 0 {
+			return fields
+		}
+	}
+
+	if runtime.GOOS == "windows" {
+		return []string{"notepad"}
+	}
+
+	return []string{"vi"}
+}
+
+// repairConfigFile opens a corrupt configuration file itself in the editor,
+// since its values can't be read into a copy, and checks it again once the
+// editor is closed.
+func repairConfigFile(p *configEditImplParams, path string) error {
+	editor := getEditorCommand(p.getenv)
+	logger.Warn("Abrindo %s com %s para correção", path, strings.Join(editor, " "))
+
+	if err := p.executor.RunInteractive(editor[0], append(editor[1:], path)...); err != nil {
+		logger.Error("O editor foi encerrado com erro")
+		return err
+	}
+
+	if err := p.config.Migrate(); err != nil {
+		return err
+	}
+
+	logger.Success("Arquivo %s corrigido", path)
+
+	return nil
+}
+
+func configEditImpl(p *configEditImplParams) error {
+	path, err := p.config.GetScopePath()
+	if err != nil {
+		return err
+	}
+
+	// The pre-run check is skipped so a corrupt file can be repaired here,
+	// but the files written by an older version are still migrated first.
+	if err := p.config.Migrate(); err != nil && !errors.Is(err, config.ErrCorruptConfig) {
+		return err
+	}
+
+	current, err := p.config.ReadScope()
+	if errors.Is(err, config.ErrCorruptConfig) {
+		return repairConfigFile(p, path)
+	}
+	if err != nil {
+		logger.Error("Não foi possível ler %s", path)
+		return err
+	}
+
+	tmp, err := p.createTemp("", "dev-cli-config-*.json")
+	if err != nil {
+		return err
+	}
+	defer p.remove(tmp.Name())
+
+	if _, err := tmp.Write(current); err != nil {
+		tmp.Close()
+		return err
+	}
+	tmp.Close()
+
+	editor := getEditorCommand(p.getenv)
+	logger.Verbose("Abrindo %s com %s", path, strings.Join(editor, " "))
+
+	if err := p.executor.RunInteractive(editor[0], append(editor[1:], tmp.Name())...); err != nil {
+		logger.Error("O editor foi encerrado com erro, nenhuma alteração foi salva")
+		return err
+	}
+
+	edited, err := p.readFile(tmp.Name())
+	if err != nil {
+		return err
+	}
+
+	if err := p.config.Import(edited, false); err != nil {
+		logger.Error("O arquivo editado é inválido, nenhuma alteração foi salva")
+		return err
+	}
+
+	logger.Success("Configurações de %s atualizadas", path)
+
+	return nil
+}
+
+var configEditCmd = &cobra.Command{
+	Use:   "edit",
+	Short: "Abre o JSON do escopo informado (--global ou --local) no $EDITOR",
+	Long:  "Abre uma cópia do arquivo de configuração no editor definido em $VISUAL ou $EDITOR. Ao fechar o editor, o conteúdo é validado contra as chaves registradas antes de ser salvo. Um arquivo corrompido é aberto diretamente para ser corrigido.",
+	Args:  cobra.NoArgs,
+	Annotations: map[string]string{
+		skipConfigCheckAnnotation: "true",
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		executor := exec.NewExecutor()
+		config := config.NewConfig(
+			config.WithConfigFlags(
+				&config.ConfigFlags{
+					Global: globalFlag,
+					Local:  localFlag,
+				},
+			),
+		)
+
+		return configEditImpl(&configEditImplParams{
+			config:     config,
+			executor:   executor,
+			getenv:     os.Getenv,
+			readFile:   os.ReadFile,
+			createTemp: os.CreateTemp,
+			remove:     os.Remove,
+		})
+	},
+}
+
+func init() {
+	configCmd.AddCommand(configEditCmd)
+}
@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/Brennon-Oliveira/dev-cli/internal/config"
+	"github.com/spf13/cobra"
+)
+
+var configExportEffectiveFlag bool
+
+type configExportImplParams struct {
+	out       io.Writer
+	effective bool
+	config    config.Config
+}
+
+func configExportImpl(p *configExportImplParams) error {
+	data, err := p.config.Export(p.effective)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(p.out, string(data))
+
+	return err
+}
+
+var configExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Exporta as configurações salvas em JSON para a saída padrão",
+	Long:  "Escreve em JSON os valores salvos nos arquivos de configuração (global, perfil ativo e projeto), permitindo compartilhar o ambiente com outras pessoas do time (ex: dev config export > dev-cli.json). Valores padrão e sobrescritas por variável de ambiente ou flag não são exportados; use --effective para exportar o valor efetivo de todas as configurações.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config := config.NewConfig()
+
+		return configExportImpl(&configExportImplParams{
+			out:       os.Stdout,
+			effective: configExportEffectiveFlag,
+			config:    config,
+		})
+	},
+}
+
+func init() {
+	configExportCmd.Flags().BoolVar(&configExportEffectiveFlag, "effective", false, "Exporta o valor efetivo de todas as configurações, incluindo padrões e sobrescritas")
+	configCmd.AddCommand(configExportCmd)
+}
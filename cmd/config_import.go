@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"io"
+	"os"
+
+	"github.com/Brennon-Oliveira/dev-cli/internal/config"
+	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
+	"github.com/spf13/cobra"
+)
+
+type configImportImplParams struct {
+	args     []string
+	stdin    io.Reader
+	readFile func(name string) ([]byte, error)
+	config   config.Config
+}
+
+func configImportImpl(p *configImportImplParams) error {
+	file := p.args[0]
+
+	var data []byte
+	var err error
+	if file == "-" {
+		data, err = io.ReadAll(p.stdin)
+	} else {
+		data, err = p.readFile(file)
+	}
+
+	if err != nil {
+		logger.Error("Não foi possível ler o arquivo %s", file)
+		return err
+	}
+
+	if err := p.config.Import(data, true); err != nil {
+		logger.Error("As configurações de %s não foram importadas", file)
+		return err
+	}
+
+	logger.Success("Configurações importadas de %s", file)
+
+	return nil
+}
+
+var configImportCmd = &cobra.Command{
+	Use:   "import <arquivo|->",
+	Short: "Importa configurações de um arquivo JSON no escopo informado (--global ou --local)",
+	Long:  "Valida o arquivo contra as chaves registradas e mescla os valores no escopo informado. Use '-' para ler da entrada padrão.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config := config.NewConfig(
+			config.WithConfigFlags(
+				&config.ConfigFlags{
+					Global: globalFlag,
+					Local:  localFlag,
+				},
+			),
+		)
+
+		return configImportImpl(&configImportImplParams{
+			args:     args,
+			stdin:    os.Stdin,
+			readFile: os.ReadFile,
+			config:   config,
+		})
+	},
+}
+
+func init() {
+	configCmd.AddCommand(configImportCmd)
+}
@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Brennon-Oliveira/dev-cli/internal/config"
+	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
+	"github.com/spf13/cobra"
+)
+
+type configListImplParams struct {
+	config config.Config
+}
+
+func configListImpl(p *configListImplParams) error {
+	var keys []string
+	for key := range *config.GetHandlers() {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	var output strings.Builder
+	output.WriteString(fmt.Sprintf("%-20s %-25s %s\n", "CHAVE", "VALOR", "ORIGEM"))
+
+	for _, key := range keys {
+		value, source, err := p.config.LoadByKeyWithSource(key)
+		if err != nil {
+			return err
+		}
+		output.WriteString(fmt.Sprintf("%-20s %-25s %s\n", key, value, source))
+	}
+
+	profile, profileSource, err := p.config.GetActiveProfile()
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Perfil ativo: %s (origem: %s)\n\n%s", profile, profileSource, output.String())
+
+	return nil
+}
+
+var configListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Lista todas as configurações com o valor efetivo e a origem",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config := config.NewConfig()
+
+		return configListImpl(&configListImplParams{
+			config: config,
+		})
+	},
+}
+
+func init() {
+	configCmd.AddCommand(configListCmd)
+}
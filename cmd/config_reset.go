@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"github.com/Brennon-Oliveira/dev-cli/internal/config"
+	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
+	"github.com/spf13/cobra"
+)
+
+type configResetImplParams struct {
+	config config.Config
+}
+
+func configResetImpl(p *configResetImplParams) error {
+	path, err := p.config.GetScopePath()
+	if err != nil {
+		return err
+	}
+
+	if err := p.config.Reset(); err != nil {
+		logger.Error("Erro ao restaurar as configurações de %s", path)
+		return err
+	}
+
+	logger.Success("Configurações de %s restauradas para o padrão", path)
+
+	return nil
+}
+
+var configResetCmd = &cobra.Command{
+	Use:   "reset",
+	Short: "Remove todas as configurações do escopo informado (--global ou --local)",
+	Args:  cobra.NoArgs,
+	Annotations: map[string]string{
+		skipConfigCheckAnnotation: "true",
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config := config.NewConfig(
+			config.WithConfigFlags(
+				&config.ConfigFlags{
+					Global: globalFlag,
+					Local:  localFlag,
+				},
+			),
+		)
+
+		return configResetImpl(&configResetImplParams{
+			config: config,
+		})
+	},
+}
+
+func init() {
+	configCmd.AddCommand(configResetCmd)
+}
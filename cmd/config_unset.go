@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"github.com/Brennon-Oliveira/dev-cli/internal/config"
+	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
+	"github.com/spf13/cobra"
+)
+
+type configUnsetImplParams struct {
+	args   []string
+	config config.Config
+}
+
+func configUnsetImpl(p *configUnsetImplParams) error {
+	key := p.args[0]
+	if !p.config.ValidateKey(key) {
+		logger.Error("Chave `%s` de configuração desconhecida", key)
+		return nil
+	}
+
+	if err := p.config.Unset(key); err != nil {
+		logger.Error("Erro ao remover a configuração `%s`", key)
+		return err
+	}
+
+	value, source, err := p.config.LoadByKeyWithSource(key)
+	if err != nil {
+		return err
+	}
+
+	logger.Success("Configuração '%s' removida. Valor efetivo: %s (origem: %s)", key, value, source)
+
+	return nil
+}
+
+var configUnsetCmd = &cobra.Command{
+	Use:   "unset <chave>",
+	Short: "Remove uma configuração do escopo informado (--global ou --local)",
+	Args:  cobra.ExactArgs(1),
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return configCmd.ValidArgsFunction(cmd, nil, toComplete)
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config := config.NewConfig(
+			config.WithConfigFlags(
+				&config.ConfigFlags{
+					Global: globalFlag,
+					Local:  localFlag,
+				},
+			),
+		)
+
+		return configUnsetImpl(&configUnsetImplParams{
+			args:   args,
+			config: config,
+		})
+	},
+}
+
+func init() {
+	configCmd.AddCommand(configUnsetCmd)
+}
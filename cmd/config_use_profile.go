@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"github.com/Brennon-Oliveira/dev-cli/internal/config"
+	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
+	"github.com/spf13/cobra"
+)
+
+type configUseProfileImplParams struct {
+	args   []string
+	config config.Config
+}
+
+func configUseProfileImpl(p *configUseProfileImplParams) error {
+	name := p.args[0]
+
+	if err := p.config.UseProfile(name); err != nil {
+		logger.Error("Erro ao ativar o perfil '%s'", name)
+		return err
+	}
+
+	logger.Success("Perfil '%s' ativado", name)
+
+	return nil
+}
+
+var configUseProfileCmd = &cobra.Command{
+	Use:   "use-profile <perfil>",
+	Short: "Ativa um perfil de configuração (criando-o se não existir)",
+	Long:  "Define o perfil usado por padrão nas próximas execuções. Os valores do perfil são mesclados sobre o perfil 'default' do arquivo global, e 'dev config --global' passa a gravar no perfil ativo. Use 'default' para voltar ao perfil padrão.",
+	Args:  cobra.ExactArgs(1),
+	Annotations: map[string]string{
+		allowUnknownProfileAnnotation: "true",
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return completeProfiles()
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config := config.NewConfig()
+
+		return configUseProfileImpl(&configUseProfileImplParams{
+			args:   args,
+			config: config,
+		})
+	},
+}
+
+func init() {
+	configCmd.AddCommand(configUseProfileCmd)
+}
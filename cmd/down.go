@@ -9,32 +9,41 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var downDryRunFlag bool
+
 type downImplParams struct {
 	args      []string
+	dryRun    bool
 	pather    pather.Pather
 	container container.ContainerCLI
 }
 
 func downImpl(p *downImplParams) error {
-	path := p.pather.GetPathFromArgs(p.args)
-	absPath, _ := p.pather.GetAbsPath(path)
+	absPath, err := resolveWorkspace(p.pather, p.args)
+	if err != nil {
+		return err
+	}
 
 	logger.Info("Iniciando queda dos containers")
 	logger.Verbose("Caminho absoluto encontrado: %s", absPath)
 
-	return p.container.DownContainer(absPath)
+	return p.container.DownContainer(absPath, container.DownOptions{
+		DryRun: p.dryRun,
+	})
 }
 
 var downCmd = &cobra.Command{
-	Use:   "down [caminho]",
-	Short: "Para graciosamente o container do workspace atual",
-	Long:  "Executa a parada graciosa do container principal e de todos os serviços secundários (bancos de dados, caches, etc.) vinculados à mesma stack do composer do Motor de containers, mantendo os containers intactos para reinício rápido.",
-	Args:  cobra.MaximumNArgs(1),
+	Use:               "down [caminho|projeto]",
+	Short:             "Para graciosamente o container do workspace atual",
+	Long:              "Executa a parada graciosa do container principal e de todos os serviços secundários (bancos de dados, caches, etc.) vinculados à mesma stack do composer do Motor de containers, mantendo os containers intactos para reinício rápido.",
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeProjectPath,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		executor := exec.NewExecutor()
 		config := config.NewConfig()
 		pather := pather.NewPather(
 			pather.WithExecutor(executor),
+			pather.WithDiscover(!noDiscoverFlag),
 		)
 
 		container := container.NewContainerCLI(
@@ -45,6 +54,7 @@ var downCmd = &cobra.Command{
 
 		return downImpl(&downImplParams{
 			args:      args,
+			dryRun:    downDryRunFlag,
 			pather:    pather,
 			container: container,
 		})
@@ -52,5 +62,6 @@ var downCmd = &cobra.Command{
 }
 
 func init() {
+	downCmd.Flags().BoolVar(&downDryRunFlag, "dry-run", false, "Lista os containers que seriam parados sem parar nada")
 	rootCmd.AddCommand(downCmd)
 }
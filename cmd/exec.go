@@ -16,7 +16,10 @@ type execImplParams struct {
 }
 
 func execImpl(p *execImplParams) error {
-	absPath, _ := p.pather.GetAbsPath(execPath)
+	absPath, err := resolveWorkspace(p.pather, []string{execPath})
+	if err != nil {
+		return err
+	}
 
 	return p.devcontainer.RunInteractive(absPath, p.args[0])
 }
@@ -31,6 +34,7 @@ var execCmd = &cobra.Command{
 		executor := exec.NewExecutor()
 		pather := pather.NewPather(
 			pather.WithExecutor(executor),
+			pather.WithDiscover(!noDiscoverFlag),
 		)
 
 		devcontainer := devcontainer.NewDevContainerCLI(
@@ -46,7 +50,10 @@ var execCmd = &cobra.Command{
 }
 
 func init() {
-	execCmd.Flags().StringVarP(&execPath, "path", "p", "", "Caminho do projeto (padrão '.')")
+	execCmd.Flags().StringVarP(&execPath, "path", "p", "", "Caminho ou nome do projeto registrado (padrão '.')")
+	execCmd.RegisterFlagCompletionFunc("path", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return completeProjectNames(toComplete), cobra.ShellCompDirectiveFilterDirs
+	})
 	execCmd.Flags().SetInterspersed(false)
 
 	rootCmd.AddCommand(execCmd)
@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/Brennon-Oliveira/dev-cli/internal/config"
+	"github.com/Brennon-Oliveira/dev-cli/internal/container"
+	"github.com/Brennon-Oliveira/dev-cli/internal/container/container_utils"
+	"github.com/Brennon-Oliveira/dev-cli/internal/exec"
+	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
+	"github.com/Brennon-Oliveira/dev-cli/internal/pather"
+	"github.com/spf13/cobra"
+)
+
+type forwardImplParams struct {
+	args      []string
+	pather    pather.Pather
+	container container.ContainerCLI
+}
+
+func forwardImpl(p *forwardImplParams) error {
+	forwards, pathArgs, err := parseForwardArgs(p.args)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	absPath, err := resolveWorkspace(p.pather, pathArgs)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Iniciando encaminhamento de portas")
+	logger.Verbose("Caminho absoluto encontrado: %s", absPath)
+
+	return p.container.ForwardPorts(absPath, forwards)
+}
+
+// parseForwardArgs splits the ports from the optional path, the last
+// argument when it is not a port.
+func parseForwardArgs(args []string) ([]container_utils.PortForward, []string, error) {
+	var forwards []container_utils.PortForward
+	for i, arg := range args {
+		forward, err := container_utils.ParsePortForward(arg)
+		if err != nil {
+			if i > 0 && i == len(args)-1 {
+				return forwards, args[i:], nil
+			}
+			return nil, nil, err
+		}
+
+		forwards = append(forwards, forward)
+	}
+
+	if len(forwards) == 0 {
+		return nil, nil, fmt.Errorf("informe ao menos uma porta para encaminhar")
+	}
+
+	return forwards, nil, nil
+}
+
+var forwardCmd = &cobra.Command{
+	Use:   "forward <porta>[:porta do host]... [caminho|projeto]",
+	Short: "Encaminha portas do host para o dev container",
+	Long:  "Escuta em portas do host (127.0.0.1) e encaminha cada conexão TCP para a porta correspondente dentro do dev container, através do exec do Motor de containers, como faz a extensão do VS Code. Permite acessar serviços que não foram publicados sem recriar o container. A porta do host é a mesma do container, a menos que seja informada após ':'. O container precisa de socat, nc ou bash. Ctrl+C encerra todos os encaminhamentos.",
+	Example: `  dev forward 3000
+  dev forward 3000 5432:15432 ./meu-projeto`,
+	Args: cobra.MinimumNArgs(1),
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return completeProjectNames(toComplete), cobra.ShellCompDirectiveDefault
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		executor := exec.NewExecutor()
+		config := config.NewConfig()
+		pather := pather.NewPather(
+			pather.WithExecutor(executor),
+			pather.WithDiscover(!noDiscoverFlag),
+		)
+
+		container := container.NewContainerCLI(
+			container.WithExecutor(executor),
+			container.WithConfig(config),
+			container.WithPather(pather),
+		)
+
+		return forwardImpl(&forwardImplParams{
+			args:      args,
+			pather:    pather,
+			container: container,
+		})
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(forwardCmd)
+}
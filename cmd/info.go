@@ -7,19 +7,30 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var (
+	infoAllFlag   bool
+	infoStatsFlag bool
+)
+
 type infoImplParams struct {
 	args      []string
+	all       bool
+	stats     bool
 	container container.ContainerCLI
 }
 
 func infoImpl(p *infoImplParams) error {
-	return p.container.ListContainersOfActiveDevcontainers()
+	if p.stats {
+		return p.container.ListDevcontainerStats(p.all)
+	}
+
+	return p.container.ListDevcontainers(p.all)
 }
 
 var infoCmd = &cobra.Command{
 	Use:   "info",
 	Short: "Lista dev containers ativos",
-	Long:  "Consulta o daemon do Motor de containers e retorna uma listagem contendo exclusivamente os processos mapeados como Dev Containers, filtrando ativamente através das labels de controle da extensão.",
+	Long:  "Consulta o daemon do Motor de containers e retorna uma listagem contendo exclusivamente os processos mapeados como Dev Containers, filtrando ativamente através das labels de controle da extensão. Os serviços do mesmo projeto compose são agrupados com o dev container, com estado, tempo de execução e projeto de cada um. Use --all para incluir os containers parados e --stats para ver o uso de CPU, memória, rede e disco somado por workspace.",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		executor := exec.NewExecutor()
 		config := config.NewConfig()
@@ -31,11 +42,15 @@ var infoCmd = &cobra.Command{
 
 		return infoImpl(&infoImplParams{
 			args:      args,
+			all:       infoAllFlag,
+			stats:     infoStatsFlag,
 			container: container,
 		})
 	},
 }
 
 func init() {
+	infoCmd.Flags().BoolVarP(&infoAllFlag, "all", "a", false, "Inclui os dev containers parados")
+	infoCmd.Flags().BoolVar(&infoStatsFlag, "stats", false, "Mostra o uso de recursos somado por workspace")
 	rootCmd.AddCommand(infoCmd)
 }
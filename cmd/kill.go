@@ -9,32 +9,46 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var (
+	killDryRunFlag bool
+	killYesFlag    bool
+)
+
 type killImplParams struct {
 	args      []string
+	dryRun    bool
+	yes       bool
 	pather    pather.Pather
 	container container.ContainerCLI
 }
 
 func killImpl(p *killImplParams) error {
-	path := p.pather.GetPathFromArgs(p.args)
-	absPath, _ := p.pather.GetAbsPath(path)
+	absPath, err := resolveWorkspace(p.pather, p.args)
+	if err != nil {
+		return err
+	}
 
 	logger.Info("Iniciando exclusão dos containers")
 	logger.Verbose("Caminho absoluto encontrado: %s", absPath)
 
-	return p.container.KillContainer(absPath)
+	return p.container.KillContainer(absPath, container.KillOptions{
+		DryRun: p.dryRun,
+		Yes:    p.yes,
+	})
 }
 
 var killCmd = &cobra.Command{
-	Use:   "kill [caminho]",
-	Short: "Encerra o container do workspace atual",
-	Long:  "Força o encerramento e destrói o container alvo e todos os serviços acoplados via composer do Motor de containers, limpando de forma definitiva o estado de execução daquele workspace no Motor de containers do host.",
-	Args:  cobra.MaximumNArgs(1),
+	Use:               "kill [caminho|projeto]",
+	Short:             "Encerra o container do workspace atual",
+	Long:              "Força o encerramento e destrói o container alvo e todos os serviços acoplados via composer do Motor de containers, limpando de forma definitiva o estado de execução daquele workspace no Motor de containers do host. Antes de remover, lista os containers, seus serviços e volumes e pede confirmação, que pode ser dispensada com --yes. Sem um terminal na entrada, o --yes é obrigatório.",
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeProjectPath,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		executor := exec.NewExecutor()
 		config := config.NewConfig()
 		pather := pather.NewPather(
 			pather.WithExecutor(executor),
+			pather.WithDiscover(!noDiscoverFlag),
 		)
 
 		container := container.NewContainerCLI(
@@ -45,6 +59,8 @@ var killCmd = &cobra.Command{
 
 		return killImpl(&killImplParams{
 			args:      args,
+			dryRun:    killDryRunFlag,
+			yes:       killYesFlag,
 			pather:    pather,
 			container: container,
 		})
@@ -52,5 +68,7 @@ var killCmd = &cobra.Command{
 }
 
 func init() {
+	killCmd.Flags().BoolVar(&killDryRunFlag, "dry-run", false, "Lista os containers que seriam removidos sem remover nada")
+	killCmd.Flags().BoolVarP(&killYesFlag, "yes", "y", false, "Remove sem pedir confirmação")
 	rootCmd.AddCommand(killCmd)
 }
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"io"
 	"os"
 
 	"github.com/Brennon-Oliveira/dev-cli/internal/config"
@@ -11,38 +12,44 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var follow bool
+var logsFlags container.LogsOptions
 
 type logsImplParams struct {
 	args      []string
+	opts      container.LogsOptions
 	pather    pather.Pather
 	container container.ContainerCLI
 }
 
 func logsImpl(p *logsImplParams) error {
-	path := p.pather.GetPathFromArgs(p.args)
-	absPath, _ := p.pather.GetAbsPath(path)
+	absPath, err := resolveWorkspace(p.pather, p.args)
+	if err != nil {
+		return err
+	}
 
-	logger.Info("Buscando logs do container")
+	logger.Info("Buscando logs dos containers")
 	logger.Verbose("Caminho absoluto encontrado: %s", absPath)
 
-	return p.container.ShowLogs(absPath, follow)
+	return p.container.ShowLogs(absPath, p.opts)
 }
 
 var logsCmd = &cobra.Command{
-	Use:   "logs [caminho]",
-	Short: "Exibe os logs do container do workspace",
-	Long:  "Exibe o stream de saída padrão (stdout/stderr) do container ativo. Utilizado para diagnóstico e debug de falhas de provisionamento, scripts de entrypoint ou da aplicação interna rodando em background.",
-	Args:  cobra.MaximumNArgs(1),
+	Use:               "logs [caminho|projeto]",
+	Short:             "Exibe os logs dos containers do workspace",
+	Long:              "Exibe, ao mesmo tempo, o stream de saída padrão do dev container e de todos os serviços da mesma stack do compose, com cada linha prefixada pelo nome colorido do serviço. Utilizado para diagnóstico e debug de falhas de provisionamento, scripts de entrypoint ou da aplicação interna rodando em background. Ctrl+C encerra o acompanhamento.",
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeProjectPath,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		// The logs are written by the container renderer, following --output.
 		executorIO := exec.NewExecutor(
 			exec.WithStdin(os.Stdin),
-			exec.WithStdout(os.Stdout),
+			exec.WithStdout(io.Discard),
 		)
 		executor := exec.NewExecutor()
 		config := config.NewConfig()
 		pather := pather.NewPather(
 			pather.WithExecutor(executor),
+			pather.WithDiscover(!noDiscoverFlag),
 		)
 
 		container := container.NewContainerCLI(
@@ -53,6 +60,7 @@ var logsCmd = &cobra.Command{
 
 		return logsImpl(&logsImplParams{
 			args:      args,
+			opts:      logsFlags,
 			pather:    pather,
 			container: container,
 		})
@@ -60,6 +68,11 @@ var logsCmd = &cobra.Command{
 }
 
 func init() {
-	logsCmd.Flags().BoolVarP(&follow, "follow", "f", false, "Acompanha os logs em tempo real")
+	logsCmd.Flags().BoolVarP(&logsFlags.Follow, "follow", "f", false, "Acompanha os logs em tempo real")
+	logsCmd.Flags().StringSliceVarP(&logsFlags.Services, "service", "s", nil, "Mostra apenas estes serviços (repetível ou separado por vírgulas)")
+	logsCmd.Flags().StringVar(&logsFlags.Since, "since", "", "Mostra os logs a partir de um horário ou duração relativa (ex: 10m, 2024-01-15T10:00:00)")
+	logsCmd.Flags().IntVar(&logsFlags.Tail, "tail", 0, "Número de linhas finais por container (padrão: logs.tail)")
+	logsCmd.Flags().BoolVarP(&logsFlags.Timestamps, "timestamps", "t", false, "Mostra o horário de cada linha")
+	logsCmd.Flags().StringVar(&logsFlags.Grep, "grep", "", "Mostra apenas as linhas que casam com a expressão regular")
 	rootCmd.AddCommand(logsCmd)
 }
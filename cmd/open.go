@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"github.com/Brennon-Oliveira/dev-cli/internal/config"
 	"github.com/Brennon-Oliveira/dev-cli/internal/devcontainer"
 	"github.com/Brennon-Oliveira/dev-cli/internal/exec"
 	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
@@ -18,8 +19,10 @@ type openImplParams struct {
 func openImpl(p *openImplParams) error {
 	args := p.args
 	logger.Info("Iniciando projeto")
-	path := p.pather.GetPathFromArgs(args)
-	absPath, _ := p.pather.GetAbsPath(path)
+	absPath, err := resolveWorkspace(p.pather, args)
+	if err != nil {
+		return err
+	}
 
 	workspaceURI, err := p.vscode.GetContainerWorkspaceURI(absPath)
 
@@ -34,14 +37,17 @@ func openImpl(p *openImplParams) error {
 }
 
 var openCmd = &cobra.Command{
-	Use:   "open [caminho]",
-	Short: "Abre o VS Code no container",
-	Long:  "Abre o VS Code conectado a um dev container já em execução. Utiliza resolução dinâmica de URIs para forçar a montagem exata da raiz do projeto, independente da profundidade do diretório definido nas configurações.",
-	Args:  cobra.MaximumNArgs(1),
+	Use:               "open [caminho|projeto]",
+	Short:             "Abre o VS Code no container",
+	Long:              "Abre o VS Code conectado a um dev container já em execução. Utiliza resolução dinâmica de URIs para forçar a montagem exata da raiz do projeto, independente da profundidade do diretório definido nas configurações.",
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeProjectPath,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		executor := exec.NewExecutor()
+		config := config.NewConfig()
 		pather := pather.NewPather(
 			pather.WithExecutor(executor),
+			pather.WithDiscover(!noDiscoverFlag),
 		)
 
 		devcontainer := devcontainer.NewDevContainerCLI(
@@ -50,6 +56,7 @@ var openCmd = &cobra.Command{
 		vscode := vscode.NewVSCode(
 			vscode.WithExecutor(executor),
 			vscode.WithPather(pather),
+			vscode.WithConfig(config),
 			vscode.WithDevcontainerCLI(devcontainer),
 		)
 
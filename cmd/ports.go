@@ -3,6 +3,7 @@ package cmd
 import (
 	"github.com/Brennon-Oliveira/dev-cli/internal/config"
 	"github.com/Brennon-Oliveira/dev-cli/internal/container"
+	"github.com/Brennon-Oliveira/dev-cli/internal/devcontainer"
 	"github.com/Brennon-Oliveira/dev-cli/internal/exec"
 	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
 	"github.com/Brennon-Oliveira/dev-cli/internal/pather"
@@ -16,31 +17,40 @@ type portsImplParams struct {
 }
 
 func portsImpl(p *portsImplParams) error {
-	path := p.pather.GetPathFromArgs(p.args)
-	absPath, _ := p.pather.GetAbsPath(path)
+	absPath, err := resolveWorkspace(p.pather, p.args)
+	if err != nil {
+		return err
+	}
 
 	logger.Info("Bucando portas")
 	logger.Verbose("Caminho absoluto encontrado: %s", absPath)
-	p.container.ListPorts(absPath)
-	return nil
+	return p.container.ListPorts(absPath)
 }
 
 var portsCmd = &cobra.Command{
-	Use:   "ports [caminho]",
-	Short: "Lista as portas mapeadas do container",
-	Long:  "Inspeciona as interfaces de rede do Motor de containers e exibe o mapeamento ativo de portas e protocolos expostos/bindados entre a máquina host e a rede isolada do container do workspace atual.",
-	Args:  cobra.MaximumNArgs(1),
+	Use:               "ports [caminho|projeto]",
+	Short:             "Lista as portas mapeadas dos containers do workspace",
+	Long:              "Inspeciona o devcontainer e todos os serviços do compose do workspace, exibindo as portas publicadas no host. As portas declaradas em forwardPorts e appPort do devcontainer.json são comparadas com as publicadas, e as que não respondem no host são sinalizadas.",
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeProjectPath,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		executor := exec.NewExecutor()
 		config := config.NewConfig()
 		pather := pather.NewPather(
 			pather.WithExecutor(executor),
+			pather.WithDiscover(!noDiscoverFlag),
+		)
+
+		devcontainerCLI := devcontainer.NewDevContainerCLI(
+			devcontainer.WithExecutor(executor),
+			devcontainer.WithConfig(config),
 		)
 
 		container := container.NewContainerCLI(
 			container.WithExecutor(executor),
 			container.WithConfig(config),
 			container.WithPather(pather),
+			container.WithDevContainerCLI(devcontainerCLI),
 		)
 
 		return portsImpl(&portsImplParams{
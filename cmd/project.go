@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"strings"
+
+	"github.com/Brennon-Oliveira/dev-cli/internal/project"
+	"github.com/spf13/cobra"
+)
+
+var projectCmd = &cobra.Command{
+	Use:   "project",
+	Short: "Gerencia os projetos registrados",
+	Long:  "Registra caminhos de workspaces com um nome curto. Os comandos que recebem [caminho] aceitam o nome do projeto no lugar do caminho (ex: dev run api).",
+}
+
+// completeProjectPath completes the [caminho] argument with the registered
+// project names, falling back to the shell file completion.
+func completeProjectPath(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return completeProjectNames(toComplete), cobra.ShellCompDirectiveDefault
+}
+
+func completeProjectNames(toComplete string) []string {
+	projects, err := project.NewRegistry().List()
+	if err != nil {
+		return nil
+	}
+
+	var names []string
+	for _, p := range projects {
+		if strings.HasPrefix(p.Name, toComplete) {
+			names = append(names, p.Name)
+		}
+	}
+
+	return names
+}
+
+func init() {
+	rootCmd.AddCommand(projectCmd)
+}
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
+	"github.com/Brennon-Oliveira/dev-cli/internal/pather"
+	"github.com/Brennon-Oliveira/dev-cli/internal/project"
+	"github.com/spf13/cobra"
+)
+
+type projectAddImplParams struct {
+	args     []string
+	pather   pather.Pather
+	registry project.Registry
+}
+
+func projectAddImpl(p *projectAddImplParams) error {
+	name := p.args[0]
+
+	path := ""
+	if len(p.args) > 1 {
+		path = p.args[1]
+	}
+
+	absPath, err := p.pather.GetAbsPath(path)
+	if err != nil {
+		logger.Error("Não foi possível resolver o caminho %s", path)
+		return err
+	}
+
+	if err := p.registry.Add(name, absPath); err != nil {
+		return err
+	}
+
+	logger.Success("Projeto '%s' registrado em %s", name, absPath)
+
+	return nil
+}
+
+var projectAddCmd = &cobra.Command{
+	Use:   "add <nome> [caminho]",
+	Short: "Registra um projeto com um nome curto (padrão: diretório atual)",
+	Args:  cobra.RangeArgs(1, 2),
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 1 {
+			return nil, cobra.ShellCompDirectiveFilterDirs
+		}
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		registry := project.NewRegistry()
+		pather := pather.NewPather(
+			pather.WithRegistry(registry),
+		)
+
+		return projectAddImpl(&projectAddImplParams{
+			args:     args,
+			pather:   pather,
+			registry: registry,
+		})
+	},
+}
+
+func init() {
+	projectCmd.AddCommand(projectAddCmd)
+}
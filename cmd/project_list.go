@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
+	"github.com/Brennon-Oliveira/dev-cli/internal/project"
+	"github.com/spf13/cobra"
+)
+
+type projectListImplParams struct {
+	registry project.Registry
+}
+
+func projectListImpl(p *projectListImplParams) error {
+	projects, err := p.registry.List()
+	if err != nil {
+		return err
+	}
+
+	if len(projects) == 0 {
+		logger.Info("Nenhum projeto registrado. Use 'dev project add <nome> [caminho]'")
+		return nil
+	}
+
+	var output strings.Builder
+	output.WriteString(fmt.Sprintf("%-20s %s\n", "NOME", "CAMINHO"))
+
+	for _, project := range projects {
+		output.WriteString(fmt.Sprintf("%-20s %s\n", project.Name, project.Path))
+	}
+
+	logger.Info("Projetos registrados:\n\n%s", output.String())
+
+	return nil
+}
+
+var projectListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "Lista os projetos registrados",
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		registry := project.NewRegistry()
+
+		return projectListImpl(&projectListImplParams{
+			registry: registry,
+		})
+	},
+}
+
+func init() {
+	projectCmd.AddCommand(projectListCmd)
+}
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
+	"github.com/Brennon-Oliveira/dev-cli/internal/project"
+	"github.com/spf13/cobra"
+)
+
+type projectRmImplParams struct {
+	args     []string
+	registry project.Registry
+}
+
+func projectRmImpl(p *projectRmImplParams) error {
+	name := p.args[0]
+
+	if err := p.registry.Remove(name); err != nil {
+		return err
+	}
+
+	logger.Success("Projeto '%s' removido do registro", name)
+
+	return nil
+}
+
+var projectRmCmd = &cobra.Command{
+	Use:     "rm <nome>",
+	Aliases: []string{"remove"},
+	Short:   "Remove um projeto do registro (os arquivos não são alterados)",
+	Args:    cobra.ExactArgs(1),
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return completeProjectNames(toComplete), cobra.ShellCompDirectiveNoFileComp
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		registry := project.NewRegistry()
+
+		return projectRmImpl(&projectRmImplParams{
+			args:     args,
+			registry: registry,
+		})
+	},
+}
+
+func init() {
+	projectCmd.AddCommand(projectRmCmd)
+}
@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"github.com/Brennon-Oliveira/dev-cli/internal/config"
+	"github.com/Brennon-Oliveira/dev-cli/internal/container"
+	"github.com/Brennon-Oliveira/dev-cli/internal/devcontainer"
+	"github.com/Brennon-Oliveira/dev-cli/internal/exec"
+	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
+	"github.com/Brennon-Oliveira/dev-cli/internal/pather"
+	"github.com/spf13/cobra"
+)
+
+// rebuildOptions are the rebuild flags shared by up, run and rebuild.
+type rebuildOptions struct {
+	rebuild       bool
+	noCache       bool
+	removeVolumes bool
+	yes           bool
+}
+
+var rebuildFlags = rebuildOptions{rebuild: true}
+
+// addRebuildFlags registers --no-cache and --remove-volumes, and --rebuild
+// when the command does not always rebuild.
+func addRebuildFlags(cmd *cobra.Command, opts *rebuildOptions) {
+	implies := ""
+	if !opts.rebuild {
+		cmd.Flags().BoolVar(&opts.rebuild, "rebuild", false, "Remove os containers do workspace e reconstrói a imagem")
+		implies = " (implica --rebuild)"
+	}
+	cmd.Flags().BoolVar(&opts.noCache, "no-cache", false, "Reconstrói a imagem sem o cache de build"+implies)
+	cmd.Flags().BoolVar(&opts.removeVolumes, "remove-volumes", false, "Remove também os volumes nomeados dos containers, após confirmação"+implies)
+	cmd.Flags().BoolVarP(&opts.yes, "yes", "y", false, "Remove os volumes de --remove-volumes sem pedir confirmação")
+}
+
+// prepareUp removes the containers of the workspace when rebuilding and
+// returns the options of `devcontainer up`.
+func prepareUp(c container.ContainerCLI, absPath string, opts rebuildOptions) (devcontainer.UpOptions, error) {
+	if !opts.rebuild && !opts.noCache && !opts.removeVolumes {
+		return devcontainer.UpOptions{}, nil
+	}
+
+	logger.Info("Removendo os containers para reconstruir o workspace")
+
+	if err := c.RemoveContainers(absPath, container.RemoveOptions{Volumes: opts.removeVolumes, Yes: opts.yes}); err != nil {
+		return devcontainer.UpOptions{}, err
+	}
+
+	return devcontainer.UpOptions{
+		BuildNoCache: opts.noCache,
+	}, nil
+}
+
+var rebuildCmd = &cobra.Command{
+	Use:               "rebuild [caminho|projeto]",
+	Short:             "Reconstrói o devcontainer do zero",
+	Long:              "Remove o container principal e todos os serviços da mesma stack do compose e sobe o devcontainer novamente, reconstruindo a imagem (equivalente ao 'up --rebuild'). Os volumes nomeados são preservados, a menos que --remove-volumes seja informado; nesse caso os containers e volumes são listados e a remoção pede confirmação, que pode ser dispensada com --yes. Use --no-cache para ignorar o cache de build.",
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeProjectPath,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		executor := exec.NewExecutor()
+		config := config.NewConfig()
+		pather := pather.NewPather(
+			pather.WithExecutor(executor),
+			pather.WithDiscover(!noDiscoverFlag),
+		)
+		devcontainerCLI := devcontainer.NewDevContainerCLI(
+			devcontainer.WithExecutor(executor),
+		)
+		container := container.NewContainerCLI(
+			container.WithExecutor(executor),
+			container.WithConfig(config),
+			container.WithPather(pather),
+		)
+
+		return upImpl(&upImplParams{
+			args:         args,
+			rebuild:      rebuildFlags,
+			pather:       pather,
+			devcontainer: devcontainerCLI,
+			container:    container,
+		})
+	},
+}
+
+func init() {
+	addRebuildFlags(rebuildCmd, &rebuildFlags)
+	rootCmd.AddCommand(rebuildCmd)
+}
@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"github.com/Brennon-Oliveira/dev-cli/internal/config"
+	"github.com/Brennon-Oliveira/dev-cli/internal/container"
+	"github.com/Brennon-Oliveira/dev-cli/internal/exec"
+	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
+	"github.com/Brennon-Oliveira/dev-cli/internal/pather"
+	"github.com/spf13/cobra"
+)
+
+type restartImplParams struct {
+	args      []string
+	pather    pather.Pather
+	container container.ContainerCLI
+}
+
+func restartImpl(p *restartImplParams) error {
+	absPath, err := resolveWorkspace(p.pather, p.args)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Reiniciando containers do workspace")
+	logger.Verbose("Caminho absoluto encontrado: %s", absPath)
+
+	return p.container.RestartContainer(absPath)
+}
+
+var restartCmd = &cobra.Command{
+	Use:               "restart [caminho|projeto]",
+	Short:             "Reinicia os containers do workspace",
+	Long:              "Para graciosamente o container principal e todos os serviços da mesma stack do compose e os inicia novamente, respeitando a ordem de dependências do compose quando disponível, sem reexecutar o fluxo completo do `dev up`.",
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeProjectPath,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		executor := exec.NewExecutor()
+		config := config.NewConfig()
+		pather := pather.NewPather(
+			pather.WithExecutor(executor),
+			pather.WithDiscover(!noDiscoverFlag),
+		)
+
+		container := container.NewContainerCLI(
+			container.WithExecutor(executor),
+			container.WithConfig(config),
+			container.WithPather(pather),
+		)
+
+		return restartImpl(&restartImplParams{
+			args:      args,
+			pather:    pather,
+			container: container,
+		})
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(restartCmd)
+}
@@ -1,15 +1,30 @@
 package cmd
 
 import (
+	"fmt"
 	"os"
 
+	"github.com/Brennon-Oliveira/dev-cli/internal/config"
 	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
+	"github.com/Brennon-Oliveira/dev-cli/internal/output"
+	"github.com/Brennon-Oliveira/dev-cli/internal/pather"
 	"github.com/spf13/cobra"
 )
 
 var Version = "dev"
 
+// skipConfigCheckAnnotation marks commands that must keep working with a
+// corrupt configuration file, e.g. the ones used to recover it.
+const skipConfigCheckAnnotation = "skipConfigCheck"
+
+// allowUnknownProfileAnnotation marks commands that may select a profile
+// that doesn't exist yet, e.g. the one that creates it.
+const allowUnknownProfileAnnotation = "allowUnknownProfile"
+
 var verboseFlag bool
+var profileFlag string
+var noDiscoverFlag bool
+var outputFlag string
 
 var rootCmd = &cobra.Command{
 	Use:     "dev",
@@ -33,8 +48,27 @@ Ele permite provisionar, acessar e destruir ambientes de desenvolvimento isolado
   # Derruba e exclui o container e todos os serviços acoplados
   dev kill .`,
 	SilenceUsage: true,
-	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 		logger.SetVerbose(verboseFlag)
+		if err := applyOutputFlag(); err != nil {
+			return err
+		}
+
+		if err := applyConfigFlags(cmd); err != nil {
+			return err
+		}
+
+		applyWorkspace()
+
+		if cmd.Annotations[skipConfigCheckAnnotation] == "true" || isCompletionRequest(cmd) {
+			return nil
+		}
+
+		if err := config.NewConfig().Migrate(); err != nil {
+			return err
+		}
+
+		return checkActiveProfile(cmd)
 	},
 	PreRunE: func(cmd *cobra.Command, args []string) error {
 		return nil
@@ -49,8 +83,149 @@ func initLogger() {
 	)
 }
 
+func initPathFlags() {
+	rootCmd.PersistentFlags().BoolVar(&noDiscoverFlag, "no-discover", false, "Usa o caminho informado como raiz do workspace, sem procurar o devcontainer.json nos diretórios acima")
+}
+
+func initOutputFlags() {
+	rootCmd.PersistentFlags().StringVarP(&outputFlag, "output", "o", string(output.FormatTable), "Formato da saída de dados: table, json, yaml ou template=<go template>")
+	rootCmd.RegisterFlagCompletionFunc("output", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return output.CompletionValues(), cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
+	})
+}
+
+// applyOutputFlag selects the output format. Machine readable formats move
+// the log messages to stderr, leaving only data on stdout.
+func applyOutputFlag() error {
+	if err := output.SetFormatOverride(outputFlag); err != nil {
+		logger.Error("Valor inválido para --output: %v", err)
+		return fmt.Errorf("valor inválido para --output: %w", err)
+	}
+
+	if format, _ := output.GetFormatOverride(); format != output.FormatTable {
+		logger.SetOutput(os.Stderr)
+	}
+
+	return nil
+}
+
+// applyWorkspace selects the workspace of the working directory for the
+// local configuration. Commands that take a path select theirs once it is
+// resolved.
+func applyWorkspace() {
+	wd, err := os.Getwd()
+	if err != nil {
+		return
+	}
+
+	root := pather.NewPather(pather.WithDiscover(!noDiscoverFlag)).DiscoverRoot(wd)
+	config.SetWorkspaceOverride(root)
+}
+
+// resolveWorkspace returns the workspace root for the path or project given
+// in args and selects it for the local configuration.
+func resolveWorkspace(p pather.Pather, args []string) (string, error) {
+	path, err := p.GetPathFromArgs(args)
+	if err != nil {
+		return "", err
+	}
+
+	absPath, err := p.GetAbsPath(path)
+	if err != nil {
+		logger.Error("Não foi possível resolver o caminho %s", path)
+		return "", err
+	}
+
+	absPath = p.DiscoverRoot(absPath)
+	config.SetWorkspaceOverride(absPath)
+
+	return absPath, nil
+}
+
+// isCompletionRequest reports whether cmd is the hidden command run by the
+// shell completion scripts, which must not fail on the configuration checks.
+func isCompletionRequest(cmd *cobra.Command) bool {
+	return cmd.Name() == cobra.ShellCompRequestCmd || cmd.Name() == cobra.ShellCompNoDescRequestCmd
+}
+
+// checkActiveProfile rejects an active profile that doesn't exist. Writes
+// with --global create it, like `dev config use-profile` does.
+func checkActiveProfile(cmd *cobra.Command) error {
+	if cmd.Annotations[allowUnknownProfileAnnotation] == "true" {
+		return nil
+	}
+
+	if global, err := cmd.Flags().GetBool("global"); err == nil && global {
+		return nil
+	}
+
+	return config.NewConfig().ValidateActiveProfile()
+}
+
+// completeProfiles lists the profiles for shell completion. Completion
+// skips the pre-run checks, so a corrupt global file is reported here.
+func completeProfiles() ([]string, cobra.ShellCompDirective) {
+	profiles, err := config.NewConfig().ListProfiles()
+	if err != nil {
+		cobra.CompDebugln(err.Error(), true)
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	return profiles, cobra.ShellCompDirectiveNoFileComp
+}
+
+func initConfigFlags() {
+	rootCmd.PersistentFlags().StringVar(&profileFlag, "profile", "", "Usa o perfil de configuração informado apenas nesta execução")
+	rootCmd.RegisterFlagCompletionFunc("profile", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return completeProfiles()
+	})
+
+	for key, handler := range *config.GetHandlers() {
+		if handler.Flag == "" {
+			continue
+		}
+
+		rootCmd.PersistentFlags().String(handler.Flag, "", fmt.Sprintf("Sobrescreve a configuração '%s' apenas nesta execução (%s)", key, config.GetValueHint(&handler)))
+
+		completionValues := config.GetCompletionValues(&handler)
+		rootCmd.RegisterFlagCompletionFunc(handler.Flag, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			return completionValues, cobra.ShellCompDirectiveNoFileComp
+		})
+	}
+}
+
+func applyConfigFlags(cmd *cobra.Command) error {
+	if cmd.Flags().Changed("profile") {
+		if err := config.ValidateProfileName(profileFlag); err != nil {
+			logger.Error("%v", err)
+			return err
+		}
+
+		config.SetProfileOverride(profileFlag)
+	}
+
+	for key, handler := range *config.GetHandlers() {
+		if handler.Flag == "" || !cmd.Flags().Changed(handler.Flag) {
+			continue
+		}
+
+		value, _ := cmd.Flags().GetString(handler.Flag)
+		if err := config.ValidateValue(&handler, value); err != nil {
+			logger.Error("Valor inválido para --%s: %v", handler.Flag, err)
+			return fmt.Errorf("valor inválido para --%s: %w", handler.Flag, err)
+		}
+
+		config.SetFlagOverride(key, value)
+	}
+
+	return nil
+}
+
 func Execute() {
 	initLogger()
+	initConfigFlags()
+	initPathFlags()
+	initOutputFlags()
 	err := rootCmd.Execute()
 	if err != nil {
 		os.Exit(1)
@@ -1,6 +1,8 @@
 package cmd
 
 import (
+	"github.com/Brennon-Oliveira/dev-cli/internal/config"
+	"github.com/Brennon-Oliveira/dev-cli/internal/container"
 	"github.com/Brennon-Oliveira/dev-cli/internal/devcontainer"
 	"github.com/Brennon-Oliveira/dev-cli/internal/exec"
 	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
@@ -9,21 +11,32 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var runRebuildFlags rebuildOptions
+
 type runImplParams struct {
 	args         []string
+	rebuild      rebuildOptions
 	pather       pather.Pather
 	devcontainer devcontainer.DevContainerCLI
+	container    container.ContainerCLI
 	vscode       vscode.VSCode
 }
 
 func runImpl(p *runImplParams) error {
 	logger.Info("Iniciando projeto")
-	path := p.pather.GetPathFromArgs(p.args)
-	absPath, _ := p.pather.GetAbsPath(path)
+	absPath, err := resolveWorkspace(p.pather, p.args)
+	if err != nil {
+		return err
+	}
 
 	logger.Verbose("Rodando projeto na pasta %s", absPath)
 
-	if err := p.devcontainer.Up(absPath); err != nil {
+	opts, err := prepareUp(p.container, absPath, p.rebuild)
+	if err != nil {
+		return err
+	}
+
+	if err := p.devcontainer.Up(absPath, opts); err != nil {
 		return err
 	}
 
@@ -37,33 +50,45 @@ func runImpl(p *runImplParams) error {
 }
 
 var runCmd = &cobra.Command{
-	Use:   "run [caminho]",
-	Short: "Sobe o container e abre o VS Code",
-	Long:  "Executa a rotina completa de inicialização: provisiona o container (equivalente ao 'up') e imediatamente anexa o VS Code ao ambiente remoto. Resolve dinamicamente o workspaceFolder e contorna falhas de URI em ambientes como WSL.",
-	Args:  cobra.MaximumNArgs(1),
+	Use:               "run [caminho|projeto]",
+	Short:             "Sobe o container e abre o VS Code",
+	Long:              "Executa a rotina completa de inicialização: provisiona o container (equivalente ao 'up') e imediatamente anexa o VS Code ao ambiente remoto. Resolve dinamicamente o workspaceFolder e contorna falhas de URI em ambientes como WSL. Com --rebuild, reconstrói o container antes de reabrir o editor.",
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeProjectPath,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		executor := exec.NewExecutor()
+		config := config.NewConfig()
 		pather := pather.NewPather(
 			pather.WithExecutor(executor),
+			pather.WithDiscover(!noDiscoverFlag),
 		)
 		devcontainerCLI := devcontainer.NewDevContainerCLI(
 			devcontainer.WithExecutor(executor),
 		)
+		container := container.NewContainerCLI(
+			container.WithExecutor(executor),
+			container.WithConfig(config),
+			container.WithPather(pather),
+		)
 		vscode := vscode.NewVSCode(
 			vscode.WithPather(pather),
 			vscode.WithExecutor(executor),
+			vscode.WithConfig(config),
 			vscode.WithDevcontainerCLI(devcontainerCLI),
 		)
 
 		return runImpl(&runImplParams{
 			args:         args,
+			rebuild:      runRebuildFlags,
 			pather:       pather,
 			devcontainer: devcontainerCLI,
+			container:    container,
 			vscode:       vscode,
 		})
 	},
 }
 
 func init() {
+	addRebuildFlags(runCmd, &runRebuildFlags)
 	rootCmd.AddCommand(runCmd)
 }
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"github.com/Brennon-Oliveira/dev-cli/internal/config"
 	"github.com/Brennon-Oliveira/dev-cli/internal/devcontainer"
 	"github.com/Brennon-Oliveira/dev-cli/internal/exec"
 	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
@@ -15,8 +16,10 @@ type shellImplParams struct {
 }
 
 func shellImpl(p *shellImplParams) error {
-	path := p.pather.GetPathFromArgs(p.args)
-	absPath, _ := p.pather.GetAbsPath(path)
+	absPath, err := resolveWorkspace(p.pather, p.args)
+	if err != nil {
+		return err
+	}
 
 	logger.Info("Iniciando shell interativo")
 	logger.Verbose("Caminho absoluto encontrado: %s", absPath)
@@ -25,18 +28,22 @@ func shellImpl(p *shellImplParams) error {
 }
 
 var shellCmd = &cobra.Command{
-	Use:   "shell [caminho]",
-	Short: "Abre um shell interativo dentro do container",
-	Long:  "Aloca um TTY e injeta uma sessão de terminal interativa no container ativo, detectando e priorizando automaticamente o uso de zsh, bash ou sh, conforme a disponibilidade no sistema de arquivos remoto.",
-	Args:  cobra.MaximumNArgs(1),
+	Use:               "shell [caminho|projeto]",
+	Short:             "Abre um shell interativo dentro do container",
+	Long:              "Aloca um TTY e injeta uma sessão de terminal interativa no container ativo, detectando e priorizando automaticamente o uso de zsh, bash ou sh, conforme a disponibilidade no sistema de arquivos remoto.",
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeProjectPath,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		executor := exec.NewExecutor()
+		config := config.NewConfig()
 		pather := pather.NewPather(
 			pather.WithExecutor(executor),
+			pather.WithDiscover(!noDiscoverFlag),
 		)
 
 		devcontainer := devcontainer.NewDevContainerCLI(
 			devcontainer.WithExecutor(executor),
+			devcontainer.WithConfig(config),
 		)
 
 		return shellImpl(&shellImplParams{
@@ -0,0 +1,25 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var snapshotCmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "Salva e restaura snapshots do dev container",
+	Long:  "Salva o dev container do workspace em uma imagem local, marcada com o workspace e a data, e recria o dev container a partir dela. Útil para testar atualizações arriscadas de pacotes dentro do container e voltar atrás rapidamente. Os volumes não fazem parte do snapshot; use dev volumes backup para eles.",
+}
+
+// completeSnapshotArgs leaves the snapshot name to the user and completes
+// the workspace path or project name after it.
+func completeSnapshotArgs(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) == 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return completeProjectPath(cmd, args[1:], toComplete)
+}
+
+func init() {
+	rootCmd.AddCommand(snapshotCmd)
+}
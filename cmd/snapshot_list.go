@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"github.com/Brennon-Oliveira/dev-cli/internal/config"
+	"github.com/Brennon-Oliveira/dev-cli/internal/container"
+	"github.com/Brennon-Oliveira/dev-cli/internal/exec"
+	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
+	"github.com/Brennon-Oliveira/dev-cli/internal/pather"
+	"github.com/spf13/cobra"
+)
+
+type snapshotListImplParams struct {
+	args      []string
+	pather    pather.Pather
+	container container.ContainerCLI
+}
+
+func snapshotListImpl(p *snapshotListImplParams) error {
+	absPath, err := resolveWorkspace(p.pather, p.args)
+	if err != nil {
+		return err
+	}
+
+	logger.Verbose("Caminho absoluto encontrado: %s", absPath)
+	return p.container.ListSnapshots(absPath)
+}
+
+var snapshotListCmd = &cobra.Command{
+	Use:               "list [caminho|projeto]",
+	Aliases:           []string{"ls"},
+	Short:             "Lista os snapshots do workspace",
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeProjectPath,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		executor := exec.NewExecutor()
+		config := config.NewConfig()
+		pather := pather.NewPather(
+			pather.WithExecutor(executor),
+			pather.WithDiscover(!noDiscoverFlag),
+		)
+
+		container := container.NewContainerCLI(
+			container.WithExecutor(executor),
+			container.WithConfig(config),
+			container.WithPather(pather),
+		)
+
+		return snapshotListImpl(&snapshotListImplParams{
+			args:      args,
+			pather:    pather,
+			container: container,
+		})
+	},
+}
+
+func init() {
+	snapshotCmd.AddCommand(snapshotListCmd)
+}
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"github.com/Brennon-Oliveira/dev-cli/internal/config"
+	"github.com/Brennon-Oliveira/dev-cli/internal/container"
+	"github.com/Brennon-Oliveira/dev-cli/internal/devcontainer"
+	"github.com/Brennon-Oliveira/dev-cli/internal/exec"
+	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
+	"github.com/Brennon-Oliveira/dev-cli/internal/pather"
+	"github.com/spf13/cobra"
+)
+
+var snapshotRestoreYesFlag bool
+
+type snapshotRestoreImplParams struct {
+	args      []string
+	yes       bool
+	pather    pather.Pather
+	container container.ContainerCLI
+}
+
+func snapshotRestoreImpl(p *snapshotRestoreImplParams) error {
+	absPath, err := resolveWorkspace(p.pather, p.args[1:])
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Iniciando restauração do snapshot")
+	logger.Verbose("Caminho absoluto encontrado: %s", absPath)
+	return p.container.RestoreSnapshot(absPath, p.args[0], p.yes)
+}
+
+var snapshotRestoreCmd = &cobra.Command{
+	Use:               "restore <nome> [caminho|projeto]",
+	Short:             "Recria o dev container a partir de um snapshot",
+	Long:              "Recria o dev container do workspace a partir do snapshot mais recente com o nome informado (ou da tag exata), usando a configuração do devcontainer.json sem construir a imagem. Em workspaces com docker compose, o serviço do devcontainer passa a usar a imagem do snapshot, o que exige o Docker Compose 2.24 ou mais recente. Pede confirmação, que pode ser dispensada com --yes.",
+	Args:              cobra.RangeArgs(1, 2),
+	ValidArgsFunction: completeSnapshotArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		executor := exec.NewExecutor()
+		config := config.NewConfig()
+		pather := pather.NewPather(
+			pather.WithExecutor(executor),
+			pather.WithDiscover(!noDiscoverFlag),
+		)
+
+		devcontainerCLI := devcontainer.NewDevContainerCLI(
+			devcontainer.WithExecutor(executor),
+			devcontainer.WithConfig(config),
+		)
+
+		container := container.NewContainerCLI(
+			container.WithExecutor(executor),
+			container.WithConfig(config),
+			container.WithPather(pather),
+			container.WithDevContainerCLI(devcontainerCLI),
+		)
+
+		return snapshotRestoreImpl(&snapshotRestoreImplParams{
+			args:      args,
+			yes:       snapshotRestoreYesFlag,
+			pather:    pather,
+			container: container,
+		})
+	},
+}
+
+func init() {
+	snapshotRestoreCmd.Flags().BoolVarP(&snapshotRestoreYesFlag, "yes", "y", false, "Restaura sem pedir confirmação")
+	snapshotCmd.AddCommand(snapshotRestoreCmd)
+}
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"github.com/Brennon-Oliveira/dev-cli/internal/config"
+	"github.com/Brennon-Oliveira/dev-cli/internal/container"
+	"github.com/Brennon-Oliveira/dev-cli/internal/exec"
+	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
+	"github.com/Brennon-Oliveira/dev-cli/internal/pather"
+	"github.com/spf13/cobra"
+)
+
+type snapshotSaveImplParams struct {
+	args      []string
+	pather    pather.Pather
+	container container.ContainerCLI
+}
+
+func snapshotSaveImpl(p *snapshotSaveImplParams) error {
+	absPath, err := resolveWorkspace(p.pather, p.args[1:])
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Salvando snapshot")
+	logger.Verbose("Caminho absoluto encontrado: %s", absPath)
+	return p.container.SaveSnapshot(absPath, p.args[0])
+}
+
+var snapshotSaveCmd = &cobra.Command{
+	Use:               "save <nome> [caminho|projeto]",
+	Short:             "Salva o dev container do workspace em uma imagem local",
+	Long:              "Salva o sistema de arquivos do dev container do workspace (docker commit) em uma imagem local marcada com o workspace, o nome informado e a data. Os serviços do compose e os volumes não fazem parte do snapshot.",
+	Args:              cobra.RangeArgs(1, 2),
+	ValidArgsFunction: completeSnapshotArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		executor := exec.NewExecutor()
+		config := config.NewConfig()
+		pather := pather.NewPather(
+			pather.WithExecutor(executor),
+			pather.WithDiscover(!noDiscoverFlag),
+		)
+
+		container := container.NewContainerCLI(
+			container.WithExecutor(executor),
+			container.WithConfig(config),
+			container.WithPather(pather),
+		)
+
+		return snapshotSaveImpl(&snapshotSaveImplParams{
+			args:      args,
+			pather:    pather,
+			container: container,
+		})
+	},
+}
+
+func init() {
+	snapshotCmd.AddCommand(snapshotSaveCmd)
+}
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"github.com/Brennon-Oliveira/dev-cli/internal/config"
+	"github.com/Brennon-Oliveira/dev-cli/internal/container"
+	"github.com/Brennon-Oliveira/dev-cli/internal/exec"
+	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
+	"github.com/Brennon-Oliveira/dev-cli/internal/pather"
+	"github.com/spf13/cobra"
+)
+
+var startWaitFlags waitOptions
+
+type startImplParams struct {
+	args      []string
+	wait      waitOptions
+	pather    pather.Pather
+	container container.ContainerCLI
+}
+
+func startImpl(p *startImplParams) error {
+	absPath, err := resolveWorkspace(p.pather, p.args)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Iniciando containers do workspace")
+	logger.Verbose("Caminho absoluto encontrado: %s", absPath)
+
+	if err := p.container.StartContainer(absPath); err != nil {
+		return err
+	}
+
+	return waitForWorkspace(p.container, absPath, p.wait)
+}
+
+var startCmd = &cobra.Command{
+	Use:               "start [caminho|projeto]",
+	Short:             "Inicia os containers parados do workspace",
+	Long:              "Inicia o container principal e todos os serviços da mesma stack do compose que foram parados com `dev down`, respeitando a ordem de dependências do compose quando disponível. Muito mais rápido que `dev up`, pois não reexecuta o fluxo completo do devcontainer. Com --wait, só retorna quando os containers estiverem em execução e saudáveis.",
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeProjectPath,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		executor := exec.NewExecutor()
+		config := config.NewConfig()
+		pather := pather.NewPather(
+			pather.WithExecutor(executor),
+			pather.WithDiscover(!noDiscoverFlag),
+		)
+
+		container := container.NewContainerCLI(
+			container.WithExecutor(executor),
+			container.WithConfig(config),
+			container.WithPather(pather),
+		)
+
+		return startImpl(&startImplParams{
+			args:      args,
+			wait:      startWaitFlags,
+			pather:    pather,
+			container: container,
+		})
+	},
+}
+
+func init() {
+	addWaitFlags(startCmd, &startWaitFlags)
+	rootCmd.AddCommand(startCmd)
+}
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"github.com/Brennon-Oliveira/dev-cli/internal/config"
+	"github.com/Brennon-Oliveira/dev-cli/internal/container"
+	"github.com/Brennon-Oliveira/dev-cli/internal/exec"
+	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
+	"github.com/Brennon-Oliveira/dev-cli/internal/pather"
+	"github.com/spf13/cobra"
+)
+
+type statsImplParams struct {
+	args      []string
+	pather    pather.Pather
+	container container.ContainerCLI
+}
+
+func statsImpl(p *statsImplParams) error {
+	absPath, err := resolveWorkspace(p.pather, p.args)
+	if err != nil {
+		return err
+	}
+
+	logger.Verbose("Caminho absoluto encontrado: %s", absPath)
+
+	return p.container.ShowStats(absPath)
+}
+
+var statsCmd = &cobra.Command{
+	Use:               "stats [caminho|projeto]",
+	Short:             "Mostra o uso de recursos dos containers do workspace",
+	Long:              "Coleta uma única amostra (stats --no-stream) de CPU, memória, rede e I/O de disco do container principal e de todos os serviços da mesma stack do compose, exibindo o uso de cada container e o total do workspace. Para comparar todos os workspaces, use dev info --stats.",
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeProjectPath,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		executor := exec.NewExecutor()
+		config := config.NewConfig()
+		pather := pather.NewPather(
+			pather.WithExecutor(executor),
+			pather.WithDiscover(!noDiscoverFlag),
+		)
+
+		container := container.NewContainerCLI(
+			container.WithExecutor(executor),
+			container.WithConfig(config),
+			container.WithPather(pather),
+		)
+
+		return statsImpl(&statsImplParams{
+			args:      args,
+			pather:    pather,
+			container: container,
+		})
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(statsCmd)
+}
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/Brennon-Oliveira/dev-cli/internal/config"
+	"github.com/Brennon-Oliveira/dev-cli/internal/container"
+	"github.com/Brennon-Oliveira/dev-cli/internal/dashboard"
+	"github.com/Brennon-Oliveira/dev-cli/internal/devcontainer"
+	"github.com/Brennon-Oliveira/dev-cli/internal/exec"
+	"github.com/Brennon-Oliveira/dev-cli/internal/pather"
+	"github.com/Brennon-Oliveira/dev-cli/internal/vscode"
+	"github.com/spf13/cobra"
+)
+
+var topIntervalFlag time.Duration
+
+type topImplParams struct {
+	interval  time.Duration
+	dashboard dashboard.Dashboard
+}
+
+func topImpl(p *topImplParams) error {
+	if p.interval <= 0 {
+		return fmt.Errorf("intervalo inválido: %s", p.interval)
+	}
+
+	return p.dashboard.Run()
+}
+
+var topCmd = &cobra.Command{
+	Use:   "top",
+	Short: "Painel ao vivo dos dev containers",
+	Long:  "Abre um painel em tela cheia, atualizado periodicamente, com todos os workspaces de dev containers, seus serviços, estado, uso de CPU e memória e portas. Pelo teclado é possível parar (s) ou remover (x) os containers, abrir o editor (o), acompanhar os logs (l) ou abrir um shell (t) no workspace selecionado.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// The dashboard owns the screen: output of the engine commands it
+		// runs in place is discarded.
+		executor := exec.NewExecutor(exec.WithStdout(io.Discard))
+		config := config.NewConfig()
+		pather := pather.NewPather(
+			pather.WithExecutor(executor),
+			pather.WithDiscover(!noDiscoverFlag),
+		)
+
+		container := container.NewContainerCLI(
+			container.WithExecutor(executor),
+			container.WithConfig(config),
+			container.WithPather(pather),
+		)
+		devcontainer := devcontainer.NewDevContainerCLI(
+			devcontainer.WithExecutor(executor),
+			devcontainer.WithConfig(config),
+		)
+		vscode := vscode.NewVSCode(
+			vscode.WithExecutor(executor),
+			vscode.WithPather(pather),
+			vscode.WithConfig(config),
+			vscode.WithDevcontainerCLI(devcontainer),
+		)
+
+		return topImpl(&topImplParams{
+			interval: topIntervalFlag,
+			dashboard: dashboard.NewDashboard(
+				dashboard.WithContainerCLI(container),
+				dashboard.WithDevContainerCLI(devcontainer),
+				dashboard.WithVSCode(vscode),
+				dashboard.WithInterval(topIntervalFlag),
+			),
+		})
+	},
+}
+
+func init() {
+	topCmd.Flags().DurationVarP(&topIntervalFlag, "interval", "n", 3*time.Second, "Intervalo entre as atualizações")
+	rootCmd.AddCommand(topCmd)
+}
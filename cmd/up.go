@@ -1,6 +1,8 @@
 package cmd
 
 import (
+	"github.com/Brennon-Oliveira/dev-cli/internal/config"
+	"github.com/Brennon-Oliveira/dev-cli/internal/container"
 	"github.com/Brennon-Oliveira/dev-cli/internal/devcontainer"
 	"github.com/Brennon-Oliveira/dev-cli/internal/exec"
 	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
@@ -8,44 +10,74 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var upRebuildFlags rebuildOptions
+var upWaitFlags waitOptions
+
 type upImplParams struct {
 	args         []string
+	rebuild      rebuildOptions
+	wait         waitOptions
 	pather       pather.Pather
 	devcontainer devcontainer.DevContainerCLI
+	container    container.ContainerCLI
 }
 
 func upImpl(p *upImplParams) error {
 	logger.Info("Iniciando projeto")
-	path := p.pather.GetPathFromArgs(p.args)
-	absPath, _ := p.pather.GetAbsPath(path)
+	absPath, err := resolveWorkspace(p.pather, p.args)
+	if err != nil {
+		return err
+	}
 
 	logger.Verbose("Rodando projeto na pasta %s", absPath)
 
-	return p.devcontainer.Up(absPath)
+	opts, err := prepareUp(p.container, absPath, p.rebuild)
+	if err != nil {
+		return err
+	}
+
+	if err := p.devcontainer.Up(absPath, opts); err != nil {
+		return err
+	}
+
+	return waitForWorkspace(p.container, absPath, p.wait)
 }
 
 var upCmd = &cobra.Command{
-	Use:   "up [caminho]",
-	Short: "Apenas sobe o devcontainer",
-	Long:  "Provisiona e inicia o dev container associado ao diretório atual em segundo plano (background). Executa o build da imagem e aplica as configurações do devcontainer.json sem instanciar a interface gráfica do VS Code.",
-	Args:  cobra.MaximumNArgs(1),
+	Use:               "up [caminho|projeto]",
+	Short:             "Apenas sobe o devcontainer",
+	Long:              "Provisiona e inicia o dev container associado ao diretório atual em segundo plano (background). Executa o build da imagem e aplica as configurações do devcontainer.json sem instanciar a interface gráfica do VS Code. Com --rebuild, remove os containers existentes e reconstrói a imagem. Com --wait, só retorna quando os containers estiverem em execução e saudáveis.",
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeProjectPath,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		executor := exec.NewExecutor()
+		config := config.NewConfig()
 		pather := pather.NewPather(
 			pather.WithExecutor(executor),
+			pather.WithDiscover(!noDiscoverFlag),
 		)
 		devcontainerCLI := devcontainer.NewDevContainerCLI(
 			devcontainer.WithExecutor(executor),
 		)
+		container := container.NewContainerCLI(
+			container.WithExecutor(executor),
+			container.WithConfig(config),
+			container.WithPather(pather),
+		)
 
 		return upImpl(&upImplParams{
 			args:         args,
+			rebuild:      upRebuildFlags,
+			wait:         upWaitFlags,
 			pather:       pather,
 			devcontainer: devcontainerCLI,
+			container:    container,
 		})
 	},
 }
 
 func init() {
+	addRebuildFlags(upCmd, &upRebuildFlags)
+	addWaitFlags(upCmd, &upWaitFlags)
 	rootCmd.AddCommand(upCmd)
 }
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"github.com/Brennon-Oliveira/dev-cli/internal/config"
+	"github.com/Brennon-Oliveira/dev-cli/internal/container"
+	"github.com/Brennon-Oliveira/dev-cli/internal/exec"
+	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
+	"github.com/Brennon-Oliveira/dev-cli/internal/pather"
+	"github.com/spf13/cobra"
+)
+
+type volumesImplParams struct {
+	args      []string
+	pather    pather.Pather
+	container container.ContainerCLI
+}
+
+func volumesImpl(p *volumesImplParams) error {
+	absPath, err := resolveWorkspace(p.pather, p.args)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Buscando volumes")
+	logger.Verbose("Caminho absoluto encontrado: %s", absPath)
+	return p.container.ListVolumes(absPath)
+}
+
+// completeBackupDirAndProject completes the backup directory, then the
+// workspace path or project name.
+func completeBackupDirAndProject(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) == 0 {
+		return nil, cobra.ShellCompDirectiveFilterDirs
+	}
+
+	return completeProjectPath(cmd, args[1:], toComplete)
+}
+
+var volumesCmd = &cobra.Command{
+	Use:               "volumes [caminho|projeto]",
+	Short:             "Lista os volumes nomeados dos containers do workspace",
+	Long:              "Inspeciona o devcontainer e todos os serviços do compose do workspace, listando os volumes nomeados que eles montam, como os dados de Postgres e Redis. Volumes anônimos são ignorados, pois um rebuild cria outros no lugar. Use os subcomandos backup e restore para salvar e recuperar os volumes.",
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeProjectPath,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		executor := exec.NewExecutor()
+		config := config.NewConfig()
+		pather := pather.NewPather(
+			pather.WithExecutor(executor),
+			pather.WithDiscover(!noDiscoverFlag),
+		)
+
+		container := container.NewContainerCLI(
+			container.WithExecutor(executor),
+			container.WithConfig(config),
+			container.WithPather(pather),
+		)
+
+		return volumesImpl(&volumesImplParams{
+			args:      args,
+			pather:    pather,
+			container: container,
+		})
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(volumesCmd)
+}
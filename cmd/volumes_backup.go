@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"path/filepath"
+
+	"github.com/Brennon-Oliveira/dev-cli/internal/config"
+	"github.com/Brennon-Oliveira/dev-cli/internal/container"
+	"github.com/Brennon-Oliveira/dev-cli/internal/exec"
+	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
+	"github.com/Brennon-Oliveira/dev-cli/internal/pather"
+	"github.com/spf13/cobra"
+)
+
+type volumesBackupImplParams struct {
+	args      []string
+	pather    pather.Pather
+	container container.ContainerCLI
+}
+
+func volumesBackupImpl(p *volumesBackupImplParams) error {
+	dir, err := filepath.Abs(p.args[0])
+	if err != nil {
+		return err
+	}
+
+	absPath, err := resolveWorkspace(p.pather, p.args[1:])
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Iniciando backup dos volumes")
+	logger.Verbose("Caminho absoluto encontrado: %s", absPath)
+	return p.container.BackupVolumes(absPath, dir)
+}
+
+var volumesBackupCmd = &cobra.Command{
+	Use:               "backup <diretório> [caminho|projeto]",
+	Short:             "Salva os volumes nomeados do workspace em arquivos tar",
+	Long:              "Salva cada volume nomeado dos containers do workspace em <diretório>/<volume>.tar, usando um container temporário da imagem configurada em volumes.image. Para um backup consistente de bancos de dados, pare o workspace com dev down antes.",
+	Args:              cobra.RangeArgs(1, 2),
+	ValidArgsFunction: completeBackupDirAndProject,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		executor := exec.NewExecutor()
+		config := config.NewConfig()
+		pather := pather.NewPather(
+			pather.WithExecutor(executor),
+			pather.WithDiscover(!noDiscoverFlag),
+		)
+
+		container := container.NewContainerCLI(
+			container.WithExecutor(executor),
+			container.WithConfig(config),
+			container.WithPather(pather),
+		)
+
+		return volumesBackupImpl(&volumesBackupImplParams{
+			args:      args,
+			pather:    pather,
+			container: container,
+		})
+	},
+}
+
+func init() {
+	volumesCmd.AddCommand(volumesBackupCmd)
+}
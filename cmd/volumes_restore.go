@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"path/filepath"
+
+	"github.com/Brennon-Oliveira/dev-cli/internal/config"
+	"github.com/Brennon-Oliveira/dev-cli/internal/container"
+	"github.com/Brennon-Oliveira/dev-cli/internal/exec"
+	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
+	"github.com/Brennon-Oliveira/dev-cli/internal/pather"
+	"github.com/spf13/cobra"
+)
+
+var volumesRestoreYesFlag bool
+
+type volumesRestoreImplParams struct {
+	args      []string
+	yes       bool
+	pather    pather.Pather
+	container container.ContainerCLI
+}
+
+func volumesRestoreImpl(p *volumesRestoreImplParams) error {
+	dir, err := filepath.Abs(p.args[0])
+	if err != nil {
+		return err
+	}
+
+	absPath, err := resolveWorkspace(p.pather, p.args[1:])
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Iniciando restauração dos volumes")
+	logger.Verbose("Caminho absoluto encontrado: %s", absPath)
+	return p.container.RestoreVolumes(absPath, dir, p.yes)
+}
+
+var volumesRestoreCmd = &cobra.Command{
+	Use:               "restore <diretório> [caminho|projeto]",
+	Short:             "Restaura os volumes nomeados do workspace a partir de um backup",
+	Long:              "Substitui o conteúdo de cada volume nomeado dos containers do workspace pelo arquivo <diretório>/<volume>.tar gerado por dev volumes backup. Volumes sem arquivo no diretório são mantidos. Os containers do workspace precisam existir e estar parados (dev down), e a restauração pede confirmação, que pode ser dispensada com --yes.",
+	Args:              cobra.RangeArgs(1, 2),
+	ValidArgsFunction: completeBackupDirAndProject,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		executor := exec.NewExecutor()
+		config := config.NewConfig()
+		pather := pather.NewPather(
+			pather.WithExecutor(executor),
+			pather.WithDiscover(!noDiscoverFlag),
+		)
+
+		container := container.NewContainerCLI(
+			container.WithExecutor(executor),
+			container.WithConfig(config),
+			container.WithPather(pather),
+		)
+
+		return volumesRestoreImpl(&volumesRestoreImplParams{
+			args:      args,
+			yes:       volumesRestoreYesFlag,
+			pather:    pather,
+			container: container,
+		})
+	},
+}
+
+func init() {
+	volumesRestoreCmd.Flags().BoolVarP(&volumesRestoreYesFlag, "yes", "y", false, "Restaura sem pedir confirmação")
+	volumesCmd.AddCommand(volumesRestoreCmd)
+}
@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"time"
+
+	"github.com/Brennon-Oliveira/dev-cli/internal/config"
+	"github.com/Brennon-Oliveira/dev-cli/internal/container"
+	"github.com/Brennon-Oliveira/dev-cli/internal/exec"
+	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
+	"github.com/Brennon-Oliveira/dev-cli/internal/pather"
+	"github.com/spf13/cobra"
+)
+
+const defaultWaitTimeout = 5 * time.Minute
+
+// waitOptions are the wait flags shared by up, start and wait.
+type waitOptions struct {
+	wait    bool
+	timeout time.Duration
+}
+
+var waitFlags = waitOptions{wait: true}
+
+// addWaitFlags registers --timeout, and --wait when the command does not
+// always wait.
+func addWaitFlags(cmd *cobra.Command, opts *waitOptions) {
+	implies := ""
+	if !opts.wait {
+		cmd.Flags().BoolVar(&opts.wait, "wait", false, "Aguarda os containers ficarem em execução e saudáveis antes de sair")
+		implies = " (usado com --wait)"
+	}
+	cmd.Flags().DurationVar(&opts.timeout, "timeout", defaultWaitTimeout, "Tempo máximo de espera pelos containers"+implies)
+}
+
+// waitForWorkspace waits for the workspace when opts ask for it.
+func waitForWorkspace(c container.ContainerCLI, absPath string, opts waitOptions) error {
+	if !opts.wait {
+		return nil
+	}
+
+	return c.WaitContainers(absPath, container.WaitOptions{Timeout: opts.timeout})
+}
+
+type waitImplParams struct {
+	args      []string
+	wait      waitOptions
+	pather    pather.Pather
+	container container.ContainerCLI
+}
+
+func waitImpl(p *waitImplParams) error {
+	absPath, err := resolveWorkspace(p.pather, p.args)
+	if err != nil {
+		return err
+	}
+
+	logger.Verbose("Caminho absoluto encontrado: %s", absPath)
+
+	return waitForWorkspace(p.container, absPath, p.wait)
+}
+
+var waitCmd = &cobra.Command{
+	Use:               "wait [caminho|projeto]",
+	Short:             "Aguarda os containers do workspace ficarem prontos",
+	Long:              "Bloqueia até que o devcontainer e todos os serviços da mesma stack do compose estejam em execução e, quando possuem healthcheck, saudáveis. Mostra uma linha por serviço a cada mudança de estado e falha ao fim do --timeout listando os serviços que não ficaram prontos. Um serviço que terminou com erro, morreu (dead) ou ficou unhealthy falha na hora, sem esperar o --timeout, e um serviço do compose que terminou com código 0, como uma migração, conta como concluído. Útil em scripts antes de rodar migrações com `dev exec`.",
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeProjectPath,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		executor := exec.NewExecutor()
+		config := config.NewConfig()
+		pather := pather.NewPather(
+			pather.WithExecutor(executor),
+			pather.WithDiscover(!noDiscoverFlag),
+		)
+
+		container := container.NewContainerCLI(
+			container.WithExecutor(executor),
+			container.WithConfig(config),
+			container.WithPather(pather),
+		)
+
+		return waitImpl(&waitImplParams{
+			args:      args,
+			wait:      waitFlags,
+			pather:    pather,
+			container: container,
+		})
+	},
+}
+
+func init() {
+	addWaitFlags(waitCmd, &waitFlags)
+	rootCmd.AddCommand(waitCmd)
+}
@@ -1,17 +1,59 @@
 package config
 
+import "errors"
+
 type ConfigFlags struct {
 	Global     bool
+	Local      bool
 	Interative bool
 }
 
+type ConfigSource string
+
+const (
+	SourceDefault ConfigSource = "padrão"
+	SourceGlobal  ConfigSource = "global"
+	SourceProfile ConfigSource = "perfil"
+	SourceLocal   ConfigSource = "local"
+	SourceEnv     ConfigSource = "variável de ambiente"
+	SourceFlag    ConfigSource = "flag"
+)
+
+// DefaultProfile is the profile stored at the root of the global file. Named
+// profiles live under "profiles" and are merged on top of it.
+const DefaultProfile = "default"
+
+const (
+	activeProfileKey = "activeProfile"
+	profilesKey      = "profiles"
+	versionKey       = "version"
+	profileEnvName   = "DEV_CLI_PROFILE"
+)
+
+// ErrCorruptConfig is wrapped by the errors of configuration files that
+// can't be parsed or hold values of the wrong type.
+var ErrCorruptConfig = errors.New("arquivo de configuração corrompido")
+
 type Config interface {
 	GetConfigPath() (string, error)
+	GetLocalConfigPath() (string, error)
+	GetScopePath() (string, error)
+	GetActiveProfile() (string, ConfigSource, error)
+	ListProfiles() ([]string, error)
+	ValidateActiveProfile() error
+	UseProfile(name string) error
 	HasConfigFile() bool
-	Load() GlobalConfig
-	LoadByKey(key string) string
+	Migrate() error
+	Load() (GlobalConfig, error)
+	LoadByKey(key string) (string, error)
+	LoadByKeyWithSource(key string) (string, ConfigSource, error)
 	TrySave(key string, value string) (string, error)
 	Save(key string, value string) error
+	Unset(key string) error
+	Reset() error
+	Export(effective bool) ([]byte, error)
+	ReadScope() ([]byte, error)
+	Import(data []byte, merge bool) error
 	InterativeSelect(key string) (string, error)
 	ValidateKey(key string) bool
 }
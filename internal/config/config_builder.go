@@ -2,6 +2,8 @@ package config
 
 import (
 	"os"
+
+	"github.com/Brennon-Oliveira/dev-cli/internal/env"
 )
 
 type realConfig struct {
@@ -10,9 +12,15 @@ type realConfig struct {
 	mkdirAll      func(path string, perm os.FileMode) error
 	writeFile     func(name string, data []byte, perm os.FileMode) error
 	stat          func(name string) (os.FileInfo, error)
+	getwd         func() (string, error)
 	getDefault    func() *GlobalConfig
 	getHandlers   func() *map[string]ConfigHandler
-	isAValidValue func(handler *ConfigHandler, value string) bool
+	getMigrations func() *[]Migration
+	getOverrides  func() *map[string]string
+	getProfile    func() string
+	getWorkspace  func() string
+	lookupEnv     env.LookupEnvFunc
+	validateValue func(handler *ConfigHandler, value string) error
 	flags         *ConfigFlags
 }
 
@@ -25,11 +33,18 @@ func NewConfig(opts ...Option) *realConfig {
 		mkdirAll:      os.MkdirAll,
 		writeFile:     os.WriteFile,
 		stat:          os.Stat,
+		getwd:         os.Getwd,
 		getDefault:    getDefaultConfig,
 		getHandlers:   GetHandlers,
-		isAValidValue: IsAValidValue,
+		getMigrations: GetMigrations,
+		getOverrides:  GetFlagOverrides,
+		getProfile:    GetProfileOverride,
+		getWorkspace:  GetWorkspaceOverride,
+		lookupEnv:     env.LookupEnv,
+		validateValue: ValidateValue,
 		flags: &ConfigFlags{
 			Global:     false,
+			Local:      false,
 			Interative: false,
 		},
 	}
@@ -71,6 +86,12 @@ func WithStat(f func(name string) (os.FileInfo, error)) Option {
 	}
 }
 
+func WithGetwd(f func() (string, error)) Option {
+	return func(c *realConfig) {
+		c.getwd = f
+	}
+}
+
 func WithGetDefault(f func() *GlobalConfig) Option {
 	return func(c *realConfig) {
 		c.getDefault = f
@@ -83,6 +104,36 @@ func WithGetHandlers(f func() *map[string]ConfigHandler) Option {
 	}
 }
 
+func WithGetMigrations(f func() *[]Migration) Option {
+	return func(c *realConfig) {
+		c.getMigrations = f
+	}
+}
+
+func WithGetOverrides(f func() *map[string]string) Option {
+	return func(c *realConfig) {
+		c.getOverrides = f
+	}
+}
+
+func WithGetProfile(f func() string) Option {
+	return func(c *realConfig) {
+		c.getProfile = f
+	}
+}
+
+func WithGetWorkspace(f func() string) Option {
+	return func(c *realConfig) {
+		c.getWorkspace = f
+	}
+}
+
+func WithLookupEnv(l env.LookupEnvFunc) Option {
+	return func(c *realConfig) {
+		c.lookupEnv = l
+	}
+}
+
 func WithConfigFlags(flags *ConfigFlags) Option {
 	return func(c *realConfig) {
 		c.flags = flags
@@ -3,7 +3,11 @@ package config
 func getDefaultConfig() *GlobalConfig {
 	cfg := &GlobalConfig{}
 
-	cfg.Core.Tool = "docker"
+	for _, handler := range handlers {
+		if handler.Default != "" {
+			handler.Set(cfg, handler.Default)
+		}
+	}
 
 	return cfg
 }
@@ -4,8 +4,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
@@ -22,6 +24,56 @@ func (c *realConfig) GetConfigPath() (string, error) {
 	return filepath.Join(home, ".dev-cli", "config.json"), nil
 }
 
+// GetLocalConfigPath returns the project-local configuration file of the
+// workspace, or of the current directory when no workspace was selected.
+// An existing `.dev-cli.json` or `.dev-cli/config.json` is searched upward
+// up to the git root, like the workspace root itself; otherwise
+// `.dev-cli.json` of the workspace is used as the target for writes. The
+// global file is never taken as a local one when the walk reaches home.
+func (c *realConfig) GetLocalConfigPath() (string, error) {
+	start := c.getWorkspace()
+	if start == "" {
+		wd, err := c.getwd()
+		if err != nil {
+			logger.Error("Não foi possível determinar o diretório atual")
+			return "", err
+		}
+		start = wd
+	}
+
+	globalPath, _ := c.GetConfigPath()
+
+	dir := start
+	for {
+		candidates := []string{
+			filepath.Join(dir, ".dev-cli.json"),
+			filepath.Join(dir, ".dev-cli", "config.json"),
+		}
+
+		for _, candidate := range candidates {
+			if candidate == globalPath {
+				continue
+			}
+
+			if _, err := c.stat(candidate); err == nil {
+				return candidate, nil
+			}
+		}
+
+		if _, err := c.stat(filepath.Join(dir, ".git")); err == nil {
+			break
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			break
+		}
+		dir = parent
+	}
+
+	return filepath.Join(start, ".dev-cli.json"), nil
+}
+
 func (c *realConfig) HasConfigFile() bool {
 	configPath, err := c.GetConfigPath()
 
@@ -36,36 +88,123 @@ func (c *realConfig) HasConfigFile() bool {
 	return true
 }
 
-func (c *realConfig) Load() GlobalConfig {
+// Migrate checks every configuration file, upgrading the ones written by an
+// older version in place (keeping a backup copy). Corrupt files are
+// reported instead of being silently replaced by the defaults.
+func (c *realConfig) Migrate() error {
+	var paths []string
+
+	// The paths are resolved without reading the files, since the active
+	// profile itself is stored in a file that may be corrupt.
+	for _, getPath := range []func() (string, error){c.GetConfigPath, c.GetLocalConfigPath} {
+		if path, err := getPath(); err == nil && path != "" && !slices.Contains(paths, path) {
+			paths = append(paths, path)
+		}
+	}
+
+	for _, path := range paths {
+		if err := c.migrateFile(path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Load merges the defaults, the configuration files, the DEV_CLI_*
+// variables and the flags. A file that can't be read or holds values of the
+// wrong type is an error instead of being silently skipped.
+func (c *realConfig) Load() (GlobalConfig, error) {
 	cfg := *c.getDefault()
 
-	path, err := c.GetConfigPath()
+	layers, err := c.getLayers()
+	if err != nil {
+		return GlobalConfig{}, err
+	}
+
+	for _, layer := range layers {
+		values, err := c.readLayer(layer)
+		if err != nil {
+			return GlobalConfig{}, err
+		}
+
+		data, err := json.Marshal(values)
+		if err == nil {
+			err = json.Unmarshal(data, &cfg)
+		}
 
-	if err != nil || path == "" {
-		return cfg
+		if err != nil {
+			return GlobalConfig{}, newCorruptConfigError(layer.path, err)
+		}
 	}
 
-	data, err := c.readFile(path)
+	handlers := *c.getHandlers()
+	overrides := *c.getOverrides()
+
+	for key, handler := range handlers {
+		if value, exists := c.lookupEnv(GetEnvName(key)); exists {
+			if c.validateValue(&handler, value) == nil {
+				handler.Set(&cfg, value)
+			} else {
+				logger.Warn("Valor '%s' de %s é inválido para '%s' e foi ignorado", value, GetEnvName(key), key)
+			}
+		}
 
-	if err == nil {
-		json.Unmarshal(data, &cfg)
+		if value, exists := overrides[key]; exists {
+			handler.Set(&cfg, value)
+		}
 	}
 
-	return cfg
+	return cfg, nil
 }
 
-func (c *realConfig) LoadByKey(key string) string {
-	cfg := c.Load()
+func (c *realConfig) LoadByKey(key string) (string, error) {
+	value, _, err := c.LoadByKeyWithSource(key)
+
+	return value, err
+}
+
+func (c *realConfig) LoadByKeyWithSource(key string) (string, ConfigSource, error) {
+	cfg, err := c.Load()
+	if err != nil {
+		return "", "", err
+	}
 
 	handlers := *c.getHandlers()
+	handler := handlers[key]
+	value := handler.Get(&cfg)
+
+	if _, exists := (*c.getOverrides())[key]; exists {
+		return value, SourceFlag, nil
+	}
+
+	if envValue, exists := c.lookupEnv(GetEnvName(key)); exists && c.validateValue(&handler, envValue) == nil {
+		return value, SourceEnv, nil
+	}
+
+	layers, err := c.getLayers()
+	if err != nil {
+		return "", "", err
+	}
+
+	for i := len(layers) - 1; i >= 0; i-- {
+		raw, err := c.readLayer(layers[i])
+		if err != nil {
+			return "", "", err
+		}
 
-	return handlers[key].Get(&cfg)
+		if _, exists := getRawValue(raw, key); exists {
+			return value, layers[i].source, nil
+		}
+	}
+
+	return value, SourceDefault, nil
 }
 
 func (c *realConfig) TrySave(key string, value string) (string, error) {
-	if !c.flags.Global {
-		logger.Error("atualmente apenas a flag --global é suportada")
-		return "", fmt.Errorf("atualmente apenas a flag --global é suportada")
+	if !c.flags.Global && !c.flags.Local {
+		logger.Error("é necessário informar o escopo com --global ou --local")
+		return "", fmt.Errorf("é necessário informar o escopo com --global ou --local")
 	}
 
 	if c.flags.Interative {
@@ -88,60 +227,354 @@ func (c *realConfig) TrySave(key string, value string) (string, error) {
 }
 
 func (c *realConfig) Save(key string, value string) error {
-	if !c.flags.Global {
-		logger.Error("atualmente apenas a flag --global é suportada")
-		return fmt.Errorf("atualmente apenas a flag --global é suportada")
+	layer, err := c.getScopeLayer()
+	if err != nil {
+		return err
 	}
 
-	path, err := c.GetConfigPath()
+	// Only the key being saved is written to the target layer, so a local
+	// file never pins values that should keep coming from the global one.
+	typedValue, err := c.toRawValue(key, value)
+	if err != nil {
+		return err
+	}
 
+	raw, err := c.readLayer(layer)
 	if err != nil {
 		return err
 	}
+	setRawValue(raw, key, typedValue)
 
-	if err := c.mkdirAll(filepath.Dir(path), 0755); err != nil {
+	return c.writeLayer(layer, raw)
+}
+
+// GetScopePath returns the file selected by the --global or --local flag.
+func (c *realConfig) GetScopePath() (string, error) {
+	if !c.flags.Global && !c.flags.Local {
+		logger.Error("é necessário informar o escopo com --global ou --local")
+		return "", fmt.Errorf("é necessário informar o escopo com --global ou --local")
+	}
+
+	if c.flags.Local {
+		return c.GetLocalConfigPath()
+	}
+
+	return c.GetConfigPath()
+}
+
+func (c *realConfig) Unset(key string) error {
+	layer, err := c.getScopeLayer()
+	if err != nil {
+		return err
+	}
+
+	raw, err := c.readLayer(layer)
+	if err != nil {
+		return err
+	}
+
+	if !deleteRawValue(raw, key) {
+		return nil
+	}
+
+	return c.writeLayer(layer, raw)
+}
+
+func (c *realConfig) Reset() error {
+	path, err := c.GetScopePath()
+	if err != nil {
 		return err
 	}
 
-	cfg := c.Load()
+	// Nothing can be kept from a corrupt file, so it is recreated empty.
+	if _, err := c.readRawLayer(path); errors.Is(err, ErrCorruptConfig) {
+		return c.writeRawLayer(path, map[string]any{})
+	}
+
+	layer, err := c.getScopeLayer()
+	if err != nil {
+		return err
+	}
 
+	return c.writeLayer(layer, map[string]any{})
+}
+
+// Export returns the configuration as JSON, in the same shape as the
+// configuration files. Only the values stored in the files are exported,
+// merged as Load does, so defaults and the DEV_CLI_* or flag overrides of
+// the current run are not pinned into another file. With effective, every
+// registered key is exported with the value in use, except the unset ones,
+// so the output can be imported back.
+func (c *realConfig) Export(effective bool) ([]byte, error) {
 	handlers := *c.getHandlers()
+	raw := map[string]any{}
 
-	handler := handlers[key]
+	if effective {
+		cfg, err := c.Load()
+		if err != nil {
+			return nil, err
+		}
 
-	if !c.isAValidValue(&handler, value) {
+		for key, handler := range handlers {
+			if handler.Get(&cfg) == "" {
+				continue
+			}
 
-		var optionsList []string
-		for _, validVal := range handler.ValidValues {
-			optionsList = append(optionsList, fmt.Sprintf("* %s", validVal))
+			value, err := extractRawValue(&cfg, key)
+			if err != nil {
+				return nil, err
+			}
+			setRawValue(raw, key, value)
 		}
 
-		logger.Error("Valor inválido para '%s'. Opções permitidas:\n%s", key, strings.Join(optionsList, "\n"))
-		return fmt.Errorf("valor inválido para '%s'.\n\nOpções permitidas:\n%s", key, strings.Join(optionsList, "\n"))
+		return json.MarshalIndent(raw, "", " ")
 	}
 
-	handler.Set(&cfg, value)
+	layers, err := c.getLayers()
+	if err != nil {
+		return nil, err
+	}
 
-	data, err := json.MarshalIndent(cfg, "", " ")
+	for _, layer := range layers {
+		values, err := c.readLayer(layer)
+		if err != nil {
+			return nil, err
+		}
+
+		flat := map[string]any{}
+		flattenRawValues(values, "", flat)
+
+		for key, value := range flat {
+			if _, exists := handlers[key]; exists {
+				setRawValue(raw, key, value)
+			}
+		}
+	}
+
+	return json.MarshalIndent(raw, "", " ")
+}
+
+// ReadScope returns the values stored in the selected scope as JSON. For the
+// global scope only the active profile is returned.
+func (c *realConfig) ReadScope() ([]byte, error) {
+	layer, err := c.getScopeLayer()
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := c.readLayer(layer)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(values, "", " ")
+}
+
+// Import validates data against the registered handlers and writes it to
+// the selected scope, merging with the existing keys or replacing them.
+func (c *realConfig) Import(data []byte, merge bool) error {
+	layer, err := c.getScopeLayer()
 	if err != nil {
 		return err
 	}
 
-	return c.writeFile(path, data, 0644)
+	incoming := map[string]any{}
+	if err := json.Unmarshal(data, &incoming); err != nil {
+		logger.Error("O conteúdo informado não é um JSON válido")
+		return fmt.Errorf("json inválido: %w", err)
+	}
+
+	// A configuration file may be imported as is: its version and profile
+	// bookkeeping belong to the file it came from.
+	if profiles, ok := incoming[profilesKey].(map[string]any); ok && len(profiles) > 0 {
+		logger.Warn("Os perfis do arquivo importado foram ignorados. Importe cada um com 'dev --profile <perfil> config import --global'")
+	}
+	maps.DeleteFunc(incoming, func(key string, value any) bool {
+		return isBookkeepingKey(key)
+	})
+
+	flat := map[string]any{}
+	flattenRawValues(incoming, "", flat)
+
+	raw := map[string]any{}
+	if merge {
+		if raw, err = c.readLayer(layer); err != nil {
+			return err
+		}
+	}
+
+	for key, value := range flat {
+		if !c.ValidateKey(key) {
+			logger.Error("Chave `%s` de configuração desconhecida", key)
+			return fmt.Errorf("chave de configuração desconhecida: %s", key)
+		}
+
+		typedValue, err := c.toRawValue(key, formatRawValue(value))
+		if err != nil {
+			return err
+		}
+
+		setRawValue(raw, key, typedValue)
+	}
+
+	return c.writeLayer(layer, raw)
+}
+
+// GetActiveProfile resolves the profile in use: --profile, then
+// DEV_CLI_PROFILE, then the one persisted by `dev config use-profile`.
+func (c *realConfig) GetActiveProfile() (string, ConfigSource, error) {
+	if profile := c.getProfile(); profile != "" {
+		return profile, SourceFlag, nil
+	}
+
+	if profile, exists := c.lookupEnv(profileEnvName); exists && profile != "" {
+		return profile, SourceEnv, nil
+	}
+
+	if path, err := c.GetConfigPath(); err == nil {
+		raw, err := c.readRawLayer(path)
+		if err != nil {
+			return "", "", err
+		}
+
+		if profile, ok := raw[activeProfileKey].(string); ok && profile != "" {
+			return profile, SourceGlobal, nil
+		}
+	}
+
+	return DefaultProfile, SourceDefault, nil
+}
+
+// ListProfiles returns the default profile followed by the named profiles
+// of the global file, sorted by name.
+func (c *realConfig) ListProfiles() ([]string, error) {
+	profiles := []string{DefaultProfile}
+
+	path, err := c.GetConfigPath()
+	if err != nil {
+		return profiles, nil
+	}
+
+	raw, err := c.readRawLayer(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	if section, ok := raw[profilesKey].(map[string]any); ok {
+		for name := range section {
+			if name != DefaultProfile {
+				names = append(names, name)
+			}
+		}
+	}
+	slices.Sort(names)
+
+	return append(profiles, names...), nil
+}
+
+// ValidateActiveProfile fails when the active profile doesn't exist in the
+// global file, so a typo in --profile or DEV_CLI_PROFILE doesn't silently
+// fall back to the default values.
+func (c *realConfig) ValidateActiveProfile() error {
+	profile, source, err := c.GetActiveProfile()
+	if err != nil {
+		return err
+	}
+
+	profiles, err := c.ListProfiles()
+	if err != nil {
+		return err
+	}
+
+	if slices.Contains(profiles, profile) {
+		return nil
+	}
+
+	origins := map[ConfigSource]string{
+		SourceFlag:   "--profile",
+		SourceEnv:    profileEnvName,
+		SourceGlobal: activeProfileKey,
+	}
+
+	err = fmt.Errorf("perfil '%s' (%s) não existe; perfis disponíveis: %s", profile, origins[source], strings.Join(profiles, ", "))
+	logger.Error("%v", err)
+	logger.Error("Crie o perfil com 'dev config use-profile %s' ou gravando um valor com --global", profile)
+	return err
+}
+
+// UseProfile persists name as the active profile of the global file,
+// creating it empty if it doesn't exist yet.
+func (c *realConfig) UseProfile(name string) error {
+	if err := ValidateProfileName(name); err != nil {
+		logger.Error("%v", err)
+		return err
+	}
+
+	path, err := c.GetConfigPath()
+	if err != nil {
+		return err
+	}
+
+	raw, err := c.readRawLayer(path)
+	if err != nil {
+		return err
+	}
+
+	if name == DefaultProfile {
+		delete(raw, activeProfileKey)
+		return c.writeRawLayer(path, raw)
+	}
+
+	profiles, ok := raw[profilesKey].(map[string]any)
+	if !ok {
+		profiles = map[string]any{}
+		raw[profilesKey] = profiles
+	}
+
+	if _, exists := profiles[name]; !exists {
+		profiles[name] = map[string]any{}
+	}
+
+	raw[activeProfileKey] = name
+
+	return c.writeRawLayer(path, raw)
 }
 
 func (c *realConfig) InterativeSelect(key string) (string, error) {
 	handlers := *c.getHandlers()
 	handler := handlers[key]
 
-	prompt := promptui.Select{
-		Label: handler.Label,
-		Items: handler.ValidValues,
+	if items := GetCompletionValues(&handler); len(items) > 0 {
+		prompt := promptui.Select{
+			Label: handler.Label,
+			Items: items,
+		}
+
+		_, result, err := prompt.Run()
+		if err != nil {
+			return "", fmt.Errorf("seleção cancelada: %v", err)
+		}
+
+		return result, nil
 	}
 
-	_, result, err := prompt.Run()
+	current, err := c.LoadByKey(key)
 	if err != nil {
-		return "", fmt.Errorf("seleção cancelada: %v", err)
+		return "", err
+	}
+
+	prompt := promptui.Prompt{
+		Label:   handler.Label,
+		Default: current,
+		Validate: func(input string) error {
+			return c.validateValue(&handler, input)
+		},
+	}
+
+	result, err := prompt.Run()
+	if err != nil {
+		return "", fmt.Errorf("entrada cancelada: %v", err)
 	}
 
 	return result, nil
@@ -158,3 +591,261 @@ func (c *realConfig) ValidateKey(key string) bool {
 
 	return true
 }
+
+// configLayer is a set of values stored in a configuration file. When
+// profile is set, the values live under "profiles.<profile>" of the file.
+type configLayer struct {
+	source  ConfigSource
+	path    string
+	profile string
+}
+
+// getLayers returns the configuration files in ascending order of
+// precedence. Layers whose path can't be resolved are skipped.
+func (c *realConfig) getLayers() ([]configLayer, error) {
+	var layers []configLayer
+
+	if path, err := c.GetConfigPath(); err == nil && path != "" {
+		layers = append(layers, configLayer{source: SourceGlobal, path: path})
+
+		profile, _, err := c.GetActiveProfile()
+		if err != nil {
+			return nil, err
+		}
+
+		if profile != DefaultProfile {
+			layers = append(layers, configLayer{source: SourceProfile, path: path, profile: profile})
+		}
+	}
+
+	if path, err := c.GetLocalConfigPath(); err == nil && path != "" {
+		layers = append(layers, configLayer{source: SourceLocal, path: path})
+	}
+
+	return layers, nil
+}
+
+// getScopeLayer returns the layer written by the --global or --local flag.
+// Global writes go to the active profile.
+func (c *realConfig) getScopeLayer() (configLayer, error) {
+	path, err := c.GetScopePath()
+	if err != nil {
+		return configLayer{}, err
+	}
+
+	if c.flags.Local {
+		return configLayer{source: SourceLocal, path: path}, nil
+	}
+
+	profile, _, err := c.GetActiveProfile()
+	if err != nil {
+		return configLayer{}, err
+	}
+
+	if profile != DefaultProfile {
+		return configLayer{source: SourceProfile, path: path, profile: profile}, nil
+	}
+
+	return configLayer{source: SourceGlobal, path: path}, nil
+}
+
+// readLayer returns only the configuration values of the layer, without
+// the profile bookkeeping keys of the global file.
+func (c *realConfig) readLayer(layer configLayer) (map[string]any, error) {
+	raw, err := c.readRawLayer(layer.path)
+	if err != nil {
+		return nil, err
+	}
+
+	if layer.profile == "" {
+		maps.DeleteFunc(raw, func(key string, value any) bool {
+			return isBookkeepingKey(key)
+		})
+		return raw, nil
+	}
+
+	profiles, _ := raw[profilesKey].(map[string]any)
+	values, ok := profiles[layer.profile].(map[string]any)
+	if !ok {
+		return map[string]any{}, nil
+	}
+
+	return values, nil
+}
+
+// writeLayer replaces the values of the layer, preserving the profiles
+// stored in the same file.
+func (c *realConfig) writeLayer(layer configLayer, values map[string]any) error {
+	raw, err := c.readRawLayer(layer.path)
+	if err != nil {
+		return err
+	}
+
+	if layer.profile == "" {
+		for _, key := range []string{activeProfileKey, profilesKey} {
+			if value, exists := raw[key]; exists {
+				values[key] = value
+			}
+		}
+		return c.writeRawLayer(layer.path, values)
+	}
+
+	profiles, ok := raw[profilesKey].(map[string]any)
+	if !ok {
+		profiles = map[string]any{}
+		raw[profilesKey] = profiles
+	}
+	profiles[layer.profile] = values
+
+	return c.writeRawLayer(layer.path, raw)
+}
+
+// readRawLayer returns the whole content of the file at path, or an empty
+// map when it doesn't exist yet.
+func (c *realConfig) readRawLayer(path string) (map[string]any, error) {
+	raw := map[string]any{}
+
+	data, err := c.readFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return raw, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("não foi possível ler o arquivo de configuração %s: %w", path, err)
+	}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, newCorruptConfigError(path, err)
+	}
+
+	return raw, nil
+}
+
+// writeRawLayer writes raw to path, stamped with the current version.
+func (c *realConfig) writeRawLayer(path string, raw map[string]any) error {
+	if err := c.mkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	raw[versionKey] = getCurrentVersion(*c.getMigrations())
+
+	data, err := json.MarshalIndent(raw, "", " ")
+	if err != nil {
+		return err
+	}
+
+	return c.writeFile(path, data, 0644)
+}
+
+// toRawValue validates value for key and converts it to the JSON type used
+// by the matching GlobalConfig field.
+func (c *realConfig) toRawValue(key string, value string) (any, error) {
+	handlers := *c.getHandlers()
+
+	handler := handlers[key]
+
+	if err := c.validateValue(&handler, value); err != nil {
+		logger.Error("Valor inválido para '%s': %v", key, err)
+		return nil, fmt.Errorf("valor inválido para '%s': %w", key, err)
+	}
+
+	var cfg GlobalConfig
+	handler.Set(&cfg, value)
+
+	return extractRawValue(&cfg, key)
+}
+
+func (c *realConfig) migrateFile(path string) error {
+	data, err := c.readFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		logger.Error("Não foi possível ler o arquivo de configuração %s", path)
+		return err
+	}
+
+	raw := map[string]any{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return corruptConfigError(path, err)
+	}
+
+	version, err := getFileVersion(raw)
+	if err != nil {
+		return corruptConfigError(path, err)
+	}
+
+	migrations := *c.getMigrations()
+	current := getCurrentVersion(migrations)
+
+	if version > current {
+		logger.Error("O arquivo %s foi gravado por uma versão mais nova do dev-cli (versão %d, suportada até %d). Atualize o dev-cli", path, version, current)
+		return fmt.Errorf("versão de configuração não suportada em %s: %d", path, version)
+	}
+
+	if version < current {
+		sections := getFileSections(raw)
+
+		for _, migration := range migrations {
+			if migration.Version <= version {
+				continue
+			}
+
+			logger.Verbose("Migrando %s para a versão %d: %s", path, migration.Version, migration.Description)
+
+			for _, values := range sections {
+				if err := migration.Migrate(values); err != nil {
+					logger.Error("Falha ao migrar %s para a versão %d", path, migration.Version)
+					return err
+				}
+			}
+		}
+
+		// The root section is a copy without the bookkeeping keys.
+		for key := range raw {
+			if !isBookkeepingKey(key) {
+				delete(raw, key)
+			}
+		}
+		maps.Copy(raw, sections[0])
+
+		backupPath := fmt.Sprintf("%s.v%d.bak", path, version)
+		if err := c.writeFile(backupPath, data, 0644); err != nil {
+			logger.Error("Não foi possível criar o backup %s, a migração foi cancelada", backupPath)
+			return err
+		}
+
+		if err := c.writeRawLayer(path, raw); err != nil {
+			return err
+		}
+
+		logger.Info("Configuração %s atualizada da versão %d para a %d (backup em %s)", path, version, current, backupPath)
+	}
+
+	for _, values := range getFileSections(raw) {
+		var cfg GlobalConfig
+
+		data, err := json.Marshal(values)
+		if err == nil {
+			err = json.Unmarshal(data, &cfg)
+		}
+
+		if err != nil {
+			return corruptConfigError(path, err)
+		}
+	}
+
+	return nil
+}
+
+// corruptConfigError reports a corrupt file with the commands that repair
+// it.
+func corruptConfigError(path string, err error) error {
+	logger.Error("O arquivo de configuração %s está corrompido: %v", path, err)
+	logger.Error("Corrija o arquivo com 'dev config edit --global' (ou --local) ou recrie-o com 'dev config reset --global' (ou --local)")
+
+	return newCorruptConfigError(path, err)
+}
+
+func newCorruptConfigError(path string, err error) error {
+	return fmt.Errorf("%w %s: %w", ErrCorruptConfig, path, err)
+}
@@ -0,0 +1,17 @@
+package config
+
+// migrations must be kept in ascending order of Version, starting at 2.
+// Files without a "version" field were written with version 1.
+var migrations = []Migration{}
+
+func GetMigrations() *[]Migration {
+	return &migrations
+}
+
+func getCurrentVersion(migrations []Migration) int {
+	if len(migrations) == 0 {
+		return 1
+	}
+
+	return migrations[len(migrations)-1].Version
+}
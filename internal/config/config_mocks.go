@@ -35,6 +35,127 @@ func (_m *MockConfig) EXPECT() *MockConfig_Expecter {
 	return &MockConfig_Expecter{mock: &_m.Mock}
 }
 
+// Export provides a mock function for the type MockConfig
+func (_mock *MockConfig) Export(effective bool) ([]byte, error) {
+	ret := _mock.Called(effective)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Export")
+	}
+
+	var r0 []byte
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(bool) ([]byte, error)); ok {
+		return returnFunc(effective)
+	}
+	if returnFunc, ok := ret.Get(0).(func(bool) []byte); ok {
+		r0 = returnFunc(effective)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(bool) error); ok {
+		r1 = returnFunc(effective)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockConfig_Export_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Export'
+type MockConfig_Export_Call struct {
+	*mock.Call
+}
+
+// Export is a helper method to define mock.On call
+//   - effective bool
+func (_e *MockConfig_Expecter) Export(effective interface{}) *MockConfig_Export_Call {
+	return &MockConfig_Export_Call{Call: _e.mock.On("Export", effective)}
+}
+
+func (_c *MockConfig_Export_Call) Run(run func(effective bool)) *MockConfig_Export_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 bool
+		if args[0] != nil {
+			arg0 = args[0].(bool)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConfig_Export_Call) Return(bytes []byte, err error) *MockConfig_Export_Call {
+	_c.Call.Return(bytes, err)
+	return _c
+}
+
+func (_c *MockConfig_Export_Call) RunAndReturn(run func(effective bool) ([]byte, error)) *MockConfig_Export_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActiveProfile provides a mock function for the type MockConfig
+func (_mock *MockConfig) GetActiveProfile() (string, ConfigSource, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveProfile")
+	}
+
+	var r0 string
+	var r1 ConfigSource
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func() (string, ConfigSource, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func() ConfigSource); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Get(1).(ConfigSource)
+	}
+	if returnFunc, ok := ret.Get(2).(func() error); ok {
+		r2 = returnFunc()
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockConfig_GetActiveProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveProfile'
+type MockConfig_GetActiveProfile_Call struct {
+	*mock.Call
+}
+
+// GetActiveProfile is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) GetActiveProfile() *MockConfig_GetActiveProfile_Call {
+	return &MockConfig_GetActiveProfile_Call{Call: _e.mock.On("GetActiveProfile")}
+}
+
+func (_c *MockConfig_GetActiveProfile_Call) Run(run func()) *MockConfig_GetActiveProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_GetActiveProfile_Call) Return(s string, configSource ConfigSource, err error) *MockConfig_GetActiveProfile_Call {
+	_c.Call.Return(s, configSource, err)
+	return _c
+}
+
+func (_c *MockConfig_GetActiveProfile_Call) RunAndReturn(run func() (string, ConfigSource, error)) *MockConfig_GetActiveProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetConfigPath provides a mock function for the type MockConfig
 func (_mock *MockConfig) GetConfigPath() (string, error) {
 	ret := _mock.Called()
@@ -88,6 +209,112 @@ func (_c *MockConfig_GetConfigPath_Call) RunAndReturn(run func() (string, error)
 	return _c
 }
 
+// GetLocalConfigPath provides a mock function for the type MockConfig
+func (_mock *MockConfig) GetLocalConfigPath() (string, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLocalConfigPath")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (string, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockConfig_GetLocalConfigPath_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLocalConfigPath'
+type MockConfig_GetLocalConfigPath_Call struct {
+	*mock.Call
+}
+
+// GetLocalConfigPath is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) GetLocalConfigPath() *MockConfig_GetLocalConfigPath_Call {
+	return &MockConfig_GetLocalConfigPath_Call{Call: _e.mock.On("GetLocalConfigPath")}
+}
+
+func (_c *MockConfig_GetLocalConfigPath_Call) Run(run func()) *MockConfig_GetLocalConfigPath_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_GetLocalConfigPath_Call) Return(s string, err error) *MockConfig_GetLocalConfigPath_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockConfig_GetLocalConfigPath_Call) RunAndReturn(run func() (string, error)) *MockConfig_GetLocalConfigPath_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetScopePath provides a mock function for the type MockConfig
+func (_mock *MockConfig) GetScopePath() (string, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetScopePath")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (string, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockConfig_GetScopePath_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetScopePath'
+type MockConfig_GetScopePath_Call struct {
+	*mock.Call
+}
+
+// GetScopePath is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) GetScopePath() *MockConfig_GetScopePath_Call {
+	return &MockConfig_GetScopePath_Call{Call: _e.mock.On("GetScopePath")}
+}
+
+func (_c *MockConfig_GetScopePath_Call) Run(run func()) *MockConfig_GetScopePath_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_GetScopePath_Call) Return(s string, err error) *MockConfig_GetScopePath_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockConfig_GetScopePath_Call) RunAndReturn(run func() (string, error)) *MockConfig_GetScopePath_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // HasConfigFile provides a mock function for the type MockConfig
 func (_mock *MockConfig) HasConfigFile() bool {
 	ret := _mock.Called()
@@ -132,6 +359,63 @@ func (_c *MockConfig_HasConfigFile_Call) RunAndReturn(run func() bool) *MockConf
 	return _c
 }
 
+// Import provides a mock function for the type MockConfig
+func (_mock *MockConfig) Import(data []byte, merge bool) error {
+	ret := _mock.Called(data, merge)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Import")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func([]byte, bool) error); ok {
+		r0 = returnFunc(data, merge)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockConfig_Import_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Import'
+type MockConfig_Import_Call struct {
+	*mock.Call
+}
+
+// Import is a helper method to define mock.On call
+//   - data []byte
+//   - merge bool
+func (_e *MockConfig_Expecter) Import(data interface{}, merge interface{}) *MockConfig_Import_Call {
+	return &MockConfig_Import_Call{Call: _e.mock.On("Import", data, merge)}
+}
+
+func (_c *MockConfig_Import_Call) Run(run func(data []byte, merge bool)) *MockConfig_Import_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []byte
+		if args[0] != nil {
+			arg0 = args[0].([]byte)
+		}
+		var arg1 bool
+		if args[1] != nil {
+			arg1 = args[1].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConfig_Import_Call) Return(err error) *MockConfig_Import_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockConfig_Import_Call) RunAndReturn(run func(data []byte, merge bool) error) *MockConfig_Import_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // InterativeSelect provides a mock function for the type MockConfig
 func (_mock *MockConfig) InterativeSelect(key string) (string, error) {
 	ret := _mock.Called(key)
@@ -192,8 +476,63 @@ func (_c *MockConfig_InterativeSelect_Call) RunAndReturn(run func(key string) (s
 	return _c
 }
 
+// ListProfiles provides a mock function for the type MockConfig
+func (_mock *MockConfig) ListProfiles() ([]string, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProfiles")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]string, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []string); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockConfig_ListProfiles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProfiles'
+type MockConfig_ListProfiles_Call struct {
+	*mock.Call
+}
+
+// ListProfiles is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) ListProfiles() *MockConfig_ListProfiles_Call {
+	return &MockConfig_ListProfiles_Call{Call: _e.mock.On("ListProfiles")}
+}
+
+func (_c *MockConfig_ListProfiles_Call) Run(run func()) *MockConfig_ListProfiles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_ListProfiles_Call) Return(strings []string, err error) *MockConfig_ListProfiles_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockConfig_ListProfiles_Call) RunAndReturn(run func() ([]string, error)) *MockConfig_ListProfiles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Load provides a mock function for the type MockConfig
-func (_mock *MockConfig) Load() GlobalConfig {
+func (_mock *MockConfig) Load() (GlobalConfig, error) {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
@@ -201,12 +540,21 @@ func (_mock *MockConfig) Load() GlobalConfig {
 	}
 
 	var r0 GlobalConfig
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (GlobalConfig, error)); ok {
+		return returnFunc()
+	}
 	if returnFunc, ok := ret.Get(0).(func() GlobalConfig); ok {
 		r0 = returnFunc()
 	} else {
 		r0 = ret.Get(0).(GlobalConfig)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // MockConfig_Load_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Load'
@@ -226,18 +574,18 @@ func (_c *MockConfig_Load_Call) Run(run func()) *MockConfig_Load_Call {
 	return _c
 }
 
-func (_c *MockConfig_Load_Call) Return(globalConfig GlobalConfig) *MockConfig_Load_Call {
-	_c.Call.Return(globalConfig)
+func (_c *MockConfig_Load_Call) Return(globalConfig GlobalConfig, err error) *MockConfig_Load_Call {
+	_c.Call.Return(globalConfig, err)
 	return _c
 }
 
-func (_c *MockConfig_Load_Call) RunAndReturn(run func() GlobalConfig) *MockConfig_Load_Call {
+func (_c *MockConfig_Load_Call) RunAndReturn(run func() (GlobalConfig, error)) *MockConfig_Load_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // LoadByKey provides a mock function for the type MockConfig
-func (_mock *MockConfig) LoadByKey(key string) string {
+func (_mock *MockConfig) LoadByKey(key string) (string, error) {
 	ret := _mock.Called(key)
 
 	if len(ret) == 0 {
@@ -245,12 +593,21 @@ func (_mock *MockConfig) LoadByKey(key string) string {
 	}
 
 	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return returnFunc(key)
+	}
 	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
 		r0 = returnFunc(key)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(key)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // MockConfig_LoadByKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadByKey'
@@ -277,12 +634,221 @@ func (_c *MockConfig_LoadByKey_Call) Run(run func(key string)) *MockConfig_LoadB
 	return _c
 }
 
-func (_c *MockConfig_LoadByKey_Call) Return(s string) *MockConfig_LoadByKey_Call {
-	_c.Call.Return(s)
+func (_c *MockConfig_LoadByKey_Call) Return(s string, err error) *MockConfig_LoadByKey_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockConfig_LoadByKey_Call) RunAndReturn(run func(key string) (string, error)) *MockConfig_LoadByKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoadByKeyWithSource provides a mock function for the type MockConfig
+func (_mock *MockConfig) LoadByKeyWithSource(key string) (string, ConfigSource, error) {
+	ret := _mock.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoadByKeyWithSource")
+	}
+
+	var r0 string
+	var r1 ConfigSource
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(string) (string, ConfigSource, error)); ok {
+		return returnFunc(key)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(key)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) ConfigSource); ok {
+		r1 = returnFunc(key)
+	} else {
+		r1 = ret.Get(1).(ConfigSource)
+	}
+	if returnFunc, ok := ret.Get(2).(func(string) error); ok {
+		r2 = returnFunc(key)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockConfig_LoadByKeyWithSource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadByKeyWithSource'
+type MockConfig_LoadByKeyWithSource_Call struct {
+	*mock.Call
+}
+
+// LoadByKeyWithSource is a helper method to define mock.On call
+//   - key string
+func (_e *MockConfig_Expecter) LoadByKeyWithSource(key interface{}) *MockConfig_LoadByKeyWithSource_Call {
+	return &MockConfig_LoadByKeyWithSource_Call{Call: _e.mock.On("LoadByKeyWithSource", key)}
+}
+
+func (_c *MockConfig_LoadByKeyWithSource_Call) Run(run func(key string)) *MockConfig_LoadByKeyWithSource_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConfig_LoadByKeyWithSource_Call) Return(s string, configSource ConfigSource, err error) *MockConfig_LoadByKeyWithSource_Call {
+	_c.Call.Return(s, configSource, err)
+	return _c
+}
+
+func (_c *MockConfig_LoadByKeyWithSource_Call) RunAndReturn(run func(key string) (string, ConfigSource, error)) *MockConfig_LoadByKeyWithSource_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Migrate provides a mock function for the type MockConfig
+func (_mock *MockConfig) Migrate() error {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Migrate")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func() error); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockConfig_Migrate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Migrate'
+type MockConfig_Migrate_Call struct {
+	*mock.Call
+}
+
+// Migrate is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) Migrate() *MockConfig_Migrate_Call {
+	return &MockConfig_Migrate_Call{Call: _e.mock.On("Migrate")}
+}
+
+func (_c *MockConfig_Migrate_Call) Run(run func()) *MockConfig_Migrate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_Migrate_Call) Return(err error) *MockConfig_Migrate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockConfig_Migrate_Call) RunAndReturn(run func() error) *MockConfig_Migrate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadScope provides a mock function for the type MockConfig
+func (_mock *MockConfig) ReadScope() ([]byte, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadScope")
+	}
+
+	var r0 []byte
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]byte, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []byte); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockConfig_ReadScope_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadScope'
+type MockConfig_ReadScope_Call struct {
+	*mock.Call
+}
+
+// ReadScope is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) ReadScope() *MockConfig_ReadScope_Call {
+	return &MockConfig_ReadScope_Call{Call: _e.mock.On("ReadScope")}
+}
+
+func (_c *MockConfig_ReadScope_Call) Run(run func()) *MockConfig_ReadScope_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_ReadScope_Call) Return(bytes []byte, err error) *MockConfig_ReadScope_Call {
+	_c.Call.Return(bytes, err)
 	return _c
 }
 
-func (_c *MockConfig_LoadByKey_Call) RunAndReturn(run func(key string) string) *MockConfig_LoadByKey_Call {
+func (_c *MockConfig_ReadScope_Call) RunAndReturn(run func() ([]byte, error)) *MockConfig_ReadScope_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reset provides a mock function for the type MockConfig
+func (_mock *MockConfig) Reset() error {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reset")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func() error); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockConfig_Reset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reset'
+type MockConfig_Reset_Call struct {
+	*mock.Call
+}
+
+// Reset is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) Reset() *MockConfig_Reset_Call {
+	return &MockConfig_Reset_Call{Call: _e.mock.On("Reset")}
+}
+
+func (_c *MockConfig_Reset_Call) Run(run func()) *MockConfig_Reset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_Reset_Call) Return(err error) *MockConfig_Reset_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockConfig_Reset_Call) RunAndReturn(run func() error) *MockConfig_Reset_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -410,6 +976,152 @@ func (_c *MockConfig_TrySave_Call) RunAndReturn(run func(key string, value strin
 	return _c
 }
 
+// Unset provides a mock function for the type MockConfig
+func (_mock *MockConfig) Unset(key string) error {
+	ret := _mock.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unset")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(key)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockConfig_Unset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unset'
+type MockConfig_Unset_Call struct {
+	*mock.Call
+}
+
+// Unset is a helper method to define mock.On call
+//   - key string
+func (_e *MockConfig_Expecter) Unset(key interface{}) *MockConfig_Unset_Call {
+	return &MockConfig_Unset_Call{Call: _e.mock.On("Unset", key)}
+}
+
+func (_c *MockConfig_Unset_Call) Run(run func(key string)) *MockConfig_Unset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConfig_Unset_Call) Return(err error) *MockConfig_Unset_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockConfig_Unset_Call) RunAndReturn(run func(key string) error) *MockConfig_Unset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UseProfile provides a mock function for the type MockConfig
+func (_mock *MockConfig) UseProfile(name string) error {
+	ret := _mock.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UseProfile")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockConfig_UseProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UseProfile'
+type MockConfig_UseProfile_Call struct {
+	*mock.Call
+}
+
+// UseProfile is a helper method to define mock.On call
+//   - name string
+func (_e *MockConfig_Expecter) UseProfile(name interface{}) *MockConfig_UseProfile_Call {
+	return &MockConfig_UseProfile_Call{Call: _e.mock.On("UseProfile", name)}
+}
+
+func (_c *MockConfig_UseProfile_Call) Run(run func(name string)) *MockConfig_UseProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConfig_UseProfile_Call) Return(err error) *MockConfig_UseProfile_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockConfig_UseProfile_Call) RunAndReturn(run func(name string) error) *MockConfig_UseProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ValidateActiveProfile provides a mock function for the type MockConfig
+func (_mock *MockConfig) ValidateActiveProfile() error {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateActiveProfile")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func() error); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockConfig_ValidateActiveProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateActiveProfile'
+type MockConfig_ValidateActiveProfile_Call struct {
+	*mock.Call
+}
+
+// ValidateActiveProfile is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) ValidateActiveProfile() *MockConfig_ValidateActiveProfile_Call {
+	return &MockConfig_ValidateActiveProfile_Call{Call: _e.mock.On("ValidateActiveProfile")}
+}
+
+func (_c *MockConfig_ValidateActiveProfile_Call) Run(run func()) *MockConfig_ValidateActiveProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_ValidateActiveProfile_Call) Return(err error) *MockConfig_ValidateActiveProfile_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockConfig_ValidateActiveProfile_Call) RunAndReturn(run func() error) *MockConfig_ValidateActiveProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ValidateKey provides a mock function for the type MockConfig
 func (_mock *MockConfig) ValidateKey(key string) bool {
 	ret := _mock.Called(key)
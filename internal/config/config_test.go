@@ -139,7 +139,8 @@ func TestLoad_ConfigFileExists_ParsesAndReturnsConfig(t *testing.T) {
 		}),
 	)
 
-	loaded := cfg.Load()
+	loaded, err := cfg.Load()
+	r.Nil(err)
 
 	r.Equal("podman", loaded.Core.Tool)
 }
@@ -156,12 +157,13 @@ func TestLoad_ConfigFileDoesNotExist_ReturnsDefaultConfig(t *testing.T) {
 		}),
 	)
 
-	loaded := cfg.Load()
+	loaded, err := cfg.Load()
+	r.Nil(err)
 
 	r.Equal("docker", loaded.Core.Tool)
 }
 
-func TestLoad_ReadFileError_ReturnsDefaultConfig(t *testing.T) {
+func TestLoad_ReadFileError_ReturnsError(t *testing.T) {
 	r := require.New(t)
 
 	cfg := NewConfig(
@@ -173,9 +175,9 @@ func TestLoad_ReadFileError_ReturnsDefaultConfig(t *testing.T) {
 		}),
 	)
 
-	loaded := cfg.Load()
+	_, err := cfg.Load()
 
-	r.Equal("docker", loaded.Core.Tool)
+	r.ErrorContains(err, "read error")
 }
 
 func TestLoad_UserHomeDirError_ReturnsDefaultConfig(t *testing.T) {
@@ -187,12 +189,13 @@ func TestLoad_UserHomeDirError_ReturnsDefaultConfig(t *testing.T) {
 		}),
 	)
 
-	loaded := cfg.Load()
+	loaded, err := cfg.Load()
+	r.Nil(err)
 
 	r.Equal("docker", loaded.Core.Tool)
 }
 
-func TestLoad_InvalidJSON_ReturnsDefaultConfig(t *testing.T) {
+func TestLoad_InvalidJSON_ReturnsCorruptConfigError(t *testing.T) {
 	r := require.New(t)
 
 	fileData := []byte(`{invalid json}`)
@@ -206,9 +209,22 @@ func TestLoad_InvalidJSON_ReturnsDefaultConfig(t *testing.T) {
 		}),
 	)
 
-	loaded := cfg.Load()
+	_, err := cfg.Load()
 
-	r.Equal("docker", loaded.Core.Tool)
+	r.ErrorIs(err, ErrCorruptConfig)
+}
+
+func TestLoad_InvalidValueType_ReturnsCorruptConfigError(t *testing.T) {
+	r := require.New(t)
+
+	cfg := newLayeredConfig(map[string]string{
+		"/home/testuser/project/.dev-cli.json": `{"logs":{"tail":"many"}}`,
+	})
+
+	_, err := cfg.Load()
+
+	r.ErrorIs(err, ErrCorruptConfig)
+	r.ErrorContains(err, "/home/testuser/project/.dev-cli.json")
 }
 
 // ============================================================================
@@ -229,7 +245,8 @@ func TestLoadByKey_CoreToolKey_ReturnsToolValue(t *testing.T) {
 		}),
 	)
 
-	value := cfg.LoadByKey("core.tool")
+	value, err := cfg.LoadByKey("core.tool")
+	r.Nil(err)
 
 	r.Equal("podman", value)
 }
@@ -246,9 +263,246 @@ func TestLoadByKey_ConfigFileDoesNotExist_ReturnsDefaultValue(t *testing.T) {
 		}),
 	)
 
-	value := cfg.LoadByKey("core.tool")
+	value, err := cfg.LoadByKey("core.tool")
+	r.Nil(err)
+
+	r.Equal("docker", value)
+}
+
+// ============================================================================
+// Tests for local configuration layer
+// ============================================================================
+
+func newLayeredConfig(files map[string]string) *realConfig {
+	return NewConfig(
+		WithUserHomeDir(func() (string, error) {
+			return "/home/testuser", nil
+		}),
+		WithGetwd(func() (string, error) {
+			return "/home/testuser/project", nil
+		}),
+		WithStat(func(name string) (os.FileInfo, error) {
+			if _, exists := files[name]; exists {
+				return nil, nil
+			}
+			return nil, os.ErrNotExist
+		}),
+		WithReadFile(func(name string) ([]byte, error) {
+			if data, exists := files[name]; exists {
+				return []byte(data), nil
+			}
+			return nil, os.ErrNotExist
+		}),
+		WithLookupEnv(func(key string) (string, bool) {
+			return "", false
+		}),
+	)
+}
+
+func TestGetLocalConfigPath_NoFile_ReturnsDotDevCliJSON(t *testing.T) {
+	r := require.New(t)
+
+	cfg := newLayeredConfig(map[string]string{})
+
+	path, err := cfg.GetLocalConfigPath()
+
+	r.Nil(err)
+	r.Equal("/home/testuser/project/.dev-cli.json", path)
+}
+
+func TestGetLocalConfigPath_DirectoryFileExists_ReturnsIt(t *testing.T) {
+	r := require.New(t)
+
+	cfg := newLayeredConfig(map[string]string{
+		"/home/testuser/project/.dev-cli/config.json": `{}`,
+	})
+
+	path, err := cfg.GetLocalConfigPath()
+
+	r.Nil(err)
+	r.Equal("/home/testuser/project/.dev-cli/config.json", path)
+}
+
+func TestGetLocalConfigPath_FromSubdirectory_FindsWorkspaceFile(t *testing.T) {
+	r := require.New(t)
+
+	cfg := newLayeredConfig(map[string]string{
+		"/home/testuser/project/.dev-cli.json": `{"core":{"tool":"podman"}}`,
+	})
+	cfg.getwd = func() (string, error) {
+		return "/home/testuser/project/src/api", nil
+	}
+
+	path, err := cfg.GetLocalConfigPath()
+
+	r.Nil(err)
+	r.Equal("/home/testuser/project/.dev-cli.json", path)
+	value, err := cfg.LoadByKey("core.tool")
+	r.Nil(err)
+	r.Equal("podman", value)
+}
+
+func TestGetLocalConfigPath_StopsAtGitRoot(t *testing.T) {
+	r := require.New(t)
+
+	cfg := newLayeredConfig(map[string]string{
+		"/home/testuser/.dev-cli.json": `{}`,
+		"/home/testuser/project/.git":  "",
+	})
+	cfg.getwd = func() (string, error) {
+		return "/home/testuser/project/src", nil
+	}
+
+	path, err := cfg.GetLocalConfigPath()
+
+	r.Nil(err)
+	r.Equal("/home/testuser/project/src/.dev-cli.json", path)
+}
+
+func TestGetLocalConfigPath_WorkspaceSelected_IgnoresWorkingDirectory(t *testing.T) {
+	r := require.New(t)
+
+	cfg := newLayeredConfig(map[string]string{
+		"/home/testuser/project/.dev-cli.json": `{}`,
+		"/home/testuser/other/.dev-cli.json":   `{}`,
+	})
+	cfg.getWorkspace = func() string {
+		return "/home/testuser/other"
+	}
+
+	path, err := cfg.GetLocalConfigPath()
+
+	r.Nil(err)
+	r.Equal("/home/testuser/other/.dev-cli.json", path)
+}
+
+func TestLoad_LocalFileOverridesGlobal(t *testing.T) {
+	r := require.New(t)
+
+	cfg := newLayeredConfig(map[string]string{
+		"/home/testuser/.dev-cli/config.json":  `{"core":{"tool":"docker"}}`,
+		"/home/testuser/project/.dev-cli.json": `{"core":{"tool":"podman"}}`,
+	})
+
+	loaded, err := cfg.Load()
+	r.Nil(err)
+
+	r.Equal("podman", loaded.Core.Tool)
+}
+
+func TestLoadByKeyWithSource_ReportsLayer(t *testing.T) {
+	tests := []struct {
+		name       string
+		files      map[string]string
+		wantValue  string
+		wantSource ConfigSource
+	}{
+		{
+			name:       "default",
+			files:      map[string]string{},
+			wantValue:  "docker",
+			wantSource: SourceDefault,
+		},
+		{
+			name: "global",
+			files: map[string]string{
+				"/home/testuser/.dev-cli/config.json": `{"core":{"tool":"podman"}}`,
+			},
+			wantValue:  "podman",
+			wantSource: SourceGlobal,
+		},
+		{
+			name: "local",
+			files: map[string]string{
+				"/home/testuser/.dev-cli/config.json":  `{"core":{"tool":"podman"}}`,
+				"/home/testuser/project/.dev-cli.json": `{"core":{"tool":"docker"}}`,
+			},
+			wantValue:  "docker",
+			wantSource: SourceLocal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			cfg := newLayeredConfig(tt.files)
+
+			value, source, err := cfg.LoadByKeyWithSource("core.tool")
+			r.Nil(err)
+
+			r.Equal(tt.wantValue, value)
+			r.Equal(tt.wantSource, source)
+		})
+	}
+}
+
+// ============================================================================
+// Tests for environment and flag overrides
+// ============================================================================
+
+func TestGetEnvName_ConvertsKey(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal("DEV_CLI_CORE_TOOL", GetEnvName("core.tool"))
+}
+
+func TestLoad_EnvOverridesLocalFile(t *testing.T) {
+	r := require.New(t)
+
+	cfg := newLayeredConfig(map[string]string{
+		"/home/testuser/project/.dev-cli.json": `{"core":{"tool":"docker"}}`,
+	})
+	WithLookupEnv(func(key string) (string, bool) {
+		if key == "DEV_CLI_CORE_TOOL" {
+			return "podman", true
+		}
+		return "", false
+	})(cfg)
+
+	value, source, err := cfg.LoadByKeyWithSource("core.tool")
+	r.Nil(err)
+
+	loaded, err := cfg.Load()
+	r.Nil(err)
+	r.Equal("podman", loaded.Core.Tool)
+	r.Equal("podman", value)
+	r.Equal(SourceEnv, source)
+}
+
+func TestLoad_InvalidEnvValue_IsIgnored(t *testing.T) {
+	r := require.New(t)
+
+	cfg := newLayeredConfig(map[string]string{
+		"/home/testuser/.dev-cli/config.json": `{"core":{"tool":"podman"}}`,
+	})
+	WithLookupEnv(func(key string) (string, bool) {
+		return "invalid-tool", true
+	})(cfg)
+
+	value, source, err := cfg.LoadByKeyWithSource("core.tool")
+	r.Nil(err)
+
+	r.Equal("podman", value)
+	r.Equal(SourceGlobal, source)
+}
+
+func TestLoad_FlagOverridesEnv(t *testing.T) {
+	r := require.New(t)
+
+	cfg := newLayeredConfig(map[string]string{})
+	WithLookupEnv(func(key string) (string, bool) {
+		return "podman", true
+	})(cfg)
+	WithGetOverrides(func() *map[string]string {
+		return &map[string]string{"core.tool": "docker"}
+	})(cfg)
+
+	value, source, err := cfg.LoadByKeyWithSource("core.tool")
+	r.Nil(err)
 
 	r.Equal("docker", value)
+	r.Equal(SourceFlag, source)
 }
 
 // ============================================================================
@@ -320,7 +574,7 @@ func TestSave_GlobalFlagTrue_ValidValue_SavesSuccessfully(t *testing.T) {
 	r.Contains(string(savedData), "podman")
 }
 
-func TestSave_GlobalFlagFalse_ReturnsError(t *testing.T) {
+func TestSave_NoScopeFlag_ReturnsError(t *testing.T) {
 	r := require.New(t)
 
 	cfg := NewConfig(
@@ -333,7 +587,7 @@ func TestSave_GlobalFlagFalse_ReturnsError(t *testing.T) {
 	err := cfg.Save("core.tool", "podman")
 
 	r.NotNil(err)
-	r.Equal("atualmente apenas a flag --global é suportada", err.Error())
+	r.Equal("é necessário informar o escopo com --global ou --local", err.Error())
 }
 
 func TestSave_InvalidValue_ReturnsError(t *testing.T) {
@@ -432,6 +686,653 @@ func TestSave_WriteFileError_ReturnsError(t *testing.T) {
 	r.Equal("write error", err.Error())
 }
 
+func TestSave_LocalFlagTrue_WritesOnlyKeyToLocalFile(t *testing.T) {
+	r := require.New(t)
+
+	var savedPath string
+	var savedData []byte
+
+	cfg := NewConfig(
+		WithUserHomeDir(func() (string, error) {
+			return "/home/testuser", nil
+		}),
+		WithGetwd(func() (string, error) {
+			return "/home/testuser/project", nil
+		}),
+		WithStat(func(name string) (os.FileInfo, error) {
+			return nil, os.ErrNotExist
+		}),
+		WithReadFile(func(name string) ([]byte, error) {
+			if name == "/home/testuser/project/.dev-cli.json" {
+				return []byte(`{"custom":{"keep":"me"}}`), nil
+			}
+			return []byte(`{"core":{"tool":"docker"}}`), nil
+		}),
+		WithMkdirAll(func(path string, perm os.FileMode) error {
+			return nil
+		}),
+		WithWriteFile(func(name string, data []byte, perm os.FileMode) error {
+			savedPath = name
+			savedData = data
+			return nil
+		}),
+		WithConfigFlags(&ConfigFlags{
+			Local: true,
+		}),
+	)
+
+	err := cfg.Save("core.tool", "podman")
+
+	r.Nil(err)
+	r.Equal("/home/testuser/project/.dev-cli.json", savedPath)
+	r.JSONEq(`{"version":1,"core":{"tool":"podman"},"custom":{"keep":"me"}}`, string(savedData))
+}
+
+func TestSave_IntKind_WritesNumber(t *testing.T) {
+	r := require.New(t)
+
+	var savedData []byte
+
+	cfg := NewConfig(
+		WithUserHomeDir(func() (string, error) {
+			return "/home/testuser", nil
+		}),
+		WithReadFile(func(name string) ([]byte, error) {
+			return nil, os.ErrNotExist
+		}),
+		WithMkdirAll(func(path string, perm os.FileMode) error {
+			return nil
+		}),
+		WithWriteFile(func(name string, data []byte, perm os.FileMode) error {
+			savedData = data
+			return nil
+		}),
+		WithConfigFlags(&ConfigFlags{
+			Global: true,
+		}),
+	)
+
+	err := cfg.Save("logs.tail", "200")
+
+	r.Nil(err)
+	r.JSONEq(`{"version":1,"logs":{"tail":200}}`, string(savedData))
+}
+
+// ============================================================================
+// Tests for Unset, Reset, Export and Import
+// ============================================================================
+
+func newScopedConfig(files map[string]string, written map[string][]byte) *realConfig {
+	return NewConfig(
+		WithUserHomeDir(func() (string, error) {
+			return "/home/testuser", nil
+		}),
+		WithGetwd(func() (string, error) {
+			return "/home/testuser/project", nil
+		}),
+		WithStat(func(name string) (os.FileInfo, error) {
+			return nil, os.ErrNotExist
+		}),
+		WithReadFile(func(name string) ([]byte, error) {
+			if data, exists := files[name]; exists {
+				return []byte(data), nil
+			}
+			return nil, os.ErrNotExist
+		}),
+		WithMkdirAll(func(path string, perm os.FileMode) error {
+			return nil
+		}),
+		WithWriteFile(func(name string, data []byte, perm os.FileMode) error {
+			written[name] = data
+			return nil
+		}),
+		WithLookupEnv(func(key string) (string, bool) {
+			return "", false
+		}),
+		WithGetProfile(func() string {
+			return ""
+		}),
+		WithConfigFlags(&ConfigFlags{
+			Global: true,
+		}),
+	)
+}
+
+func TestUnset_ExistingKey_RemovesOnlyThatKey(t *testing.T) {
+	r := require.New(t)
+
+	written := map[string][]byte{}
+	cfg := newScopedConfig(map[string]string{
+		"/home/testuser/.dev-cli/config.json": `{"core":{"tool":"podman"},"logs":{"tail":50}}`,
+	}, written)
+
+	err := cfg.Unset("core.tool")
+
+	r.Nil(err)
+	r.JSONEq(`{"version":1,"logs":{"tail":50}}`, string(written["/home/testuser/.dev-cli/config.json"]))
+}
+
+func TestUnset_MissingKey_DoesNotWrite(t *testing.T) {
+	r := require.New(t)
+
+	written := map[string][]byte{}
+	cfg := newScopedConfig(map[string]string{
+		"/home/testuser/.dev-cli/config.json": `{"logs":{"tail":50}}`,
+	}, written)
+
+	err := cfg.Unset("core.tool")
+
+	r.Nil(err)
+	r.Empty(written)
+}
+
+func TestUnset_NoScopeFlag_ReturnsError(t *testing.T) {
+	r := require.New(t)
+
+	cfg := newScopedConfig(map[string]string{}, map[string][]byte{})
+	cfg.flags = &ConfigFlags{}
+
+	err := cfg.Unset("core.tool")
+
+	r.NotNil(err)
+}
+
+func TestReset_WritesEmptyFile(t *testing.T) {
+	r := require.New(t)
+
+	written := map[string][]byte{}
+	cfg := newScopedConfig(map[string]string{
+		"/home/testuser/.dev-cli/config.json": `{"core":{"tool":"podman"}}`,
+	}, written)
+
+	err := cfg.Reset()
+
+	r.Nil(err)
+	r.JSONEq(`{"version":1}`, string(written["/home/testuser/.dev-cli/config.json"]))
+}
+
+func TestExport_ReturnsStoredValuesOnly(t *testing.T) {
+	r := require.New(t)
+
+	cfg := newScopedConfig(map[string]string{
+		"/home/testuser/.dev-cli/config.json": `{
+			"version":1,
+			"core":{"tool":"podman"},
+			"editor":{"command":"code-insiders"},
+			"activeProfile":"work",
+			"profiles":{"work":{"editor":{"command":"cursor"}}}
+		}`,
+		"/home/testuser/project/.dev-cli.json": `{"logs":{"tail":20},"custom":{"keep":"me"}}`,
+	}, map[string][]byte{})
+	cfg.lookupEnv = func(key string) (string, bool) {
+		return "nerdctl", key == "DEV_CLI_CORE_TOOL"
+	}
+	cfg.getOverrides = func() *map[string]string {
+		return &map[string]string{"shell.preferred": "/bin/zsh"}
+	}
+
+	data, err := cfg.Export(false)
+
+	r.Nil(err)
+	r.JSONEq(`{
+		"core":{"tool":"podman"},
+		"editor":{"command":"cursor"},
+		"logs":{"tail":20}
+	}`, string(data))
+}
+
+func TestExport_Effective_ReturnsEveryValueInUse(t *testing.T) {
+	r := require.New(t)
+
+	cfg := newScopedConfig(map[string]string{
+		"/home/testuser/.dev-cli/config.json":  `{"core":{"tool":"podman"}}`,
+		"/home/testuser/project/.dev-cli.json": `{"logs":{"tail":20}}`,
+	}, map[string][]byte{})
+
+	data, err := cfg.Export(true)
+
+	r.Nil(err)
+	r.JSONEq(`{
+		"core":{"tool":"podman"},
+		"editor":{"command":"code"},
+		"logs":{"tail":20},
+		"volumes":{"image":"docker.io/library/busybox:stable"}
+	}`, string(data))
+}
+
+func TestExport_Effective_CanBeImportedBack(t *testing.T) {
+	r := require.New(t)
+
+	data, err := newScopedConfig(map[string]string{}, map[string][]byte{}).Export(true)
+	r.Nil(err)
+	r.NotContains(string(data), `"preferred"`)
+
+	written := map[string][]byte{}
+	err = newScopedConfig(map[string]string{}, written).Import(data, false)
+
+	r.Nil(err)
+	r.Contains(string(written["/home/testuser/.dev-cli/config.json"]), `"tool": "docker"`)
+}
+
+func TestImport_Merge_KeepsExistingKeys(t *testing.T) {
+	r := require.New(t)
+
+	written := map[string][]byte{}
+	cfg := newScopedConfig(map[string]string{
+		"/home/testuser/.dev-cli/config.json": `{"core":{"tool":"podman"}}`,
+	}, written)
+
+	err := cfg.Import([]byte(`{"logs":{"tail":"30"}}`), true)
+
+	r.Nil(err)
+	r.JSONEq(`{"version":1,"core":{"tool":"podman"},"logs":{"tail":30}}`, string(written["/home/testuser/.dev-cli/config.json"]))
+}
+
+func TestImport_Replace_DropsExistingKeys(t *testing.T) {
+	r := require.New(t)
+
+	written := map[string][]byte{}
+	cfg := newScopedConfig(map[string]string{
+		"/home/testuser/.dev-cli/config.json": `{"core":{"tool":"podman"}}`,
+	}, written)
+
+	err := cfg.Import([]byte(`{"logs":{"tail":30}}`), false)
+
+	r.Nil(err)
+	r.JSONEq(`{"version":1,"logs":{"tail":30}}`, string(written["/home/testuser/.dev-cli/config.json"]))
+}
+
+func TestImport_ConfigFile_IgnoresBookkeepingKeys(t *testing.T) {
+	r := require.New(t)
+
+	written := map[string][]byte{}
+	cfg := newScopedConfig(map[string]string{}, written)
+
+	err := cfg.Import([]byte(`{
+		"version":1,
+		"core":{"tool":"podman"},
+		"activeProfile":"work",
+		"profiles":{"work":{"core":{"tool":"docker"}}}
+	}`), true)
+
+	r.Nil(err)
+	r.JSONEq(`{"version":1,"core":{"tool":"podman"}}`, string(written["/home/testuser/.dev-cli/config.json"]))
+}
+
+func TestImport_UnknownKey_ReturnsErrorWithoutWriting(t *testing.T) {
+	r := require.New(t)
+
+	written := map[string][]byte{}
+	cfg := newScopedConfig(map[string]string{}, written)
+
+	err := cfg.Import([]byte(`{"core":{"unknown":"x"}}`), true)
+
+	r.NotNil(err)
+	r.Contains(err.Error(), "core.unknown")
+	r.Empty(written)
+}
+
+func TestImport_InvalidValue_ReturnsErrorWithoutWriting(t *testing.T) {
+	r := require.New(t)
+
+	written := map[string][]byte{}
+	cfg := newScopedConfig(map[string]string{}, written)
+
+	err := cfg.Import([]byte(`{"core":{"tool":"lxc"}}`), true)
+
+	r.NotNil(err)
+	r.Empty(written)
+}
+
+func TestImport_InvalidJSON_ReturnsError(t *testing.T) {
+	r := require.New(t)
+
+	written := map[string][]byte{}
+	cfg := newScopedConfig(map[string]string{}, written)
+
+	err := cfg.Import([]byte(`{core`), true)
+
+	r.NotNil(err)
+	r.Empty(written)
+}
+
+// ============================================================================
+// Tests for profiles
+// ============================================================================
+
+const profilesFile = `{
+	"core":{"tool":"docker"},
+	"activeProfile":"work",
+	"profiles":{"work":{"core":{"tool":"podman"}},"personal":{}}
+}`
+
+func TestGetActiveProfile_FromFile(t *testing.T) {
+	r := require.New(t)
+
+	cfg := newScopedConfig(map[string]string{
+		"/home/testuser/.dev-cli/config.json": profilesFile,
+	}, map[string][]byte{})
+
+	profile, source, err := cfg.GetActiveProfile()
+	r.Nil(err)
+
+	r.Equal("work", profile)
+	r.Equal(SourceGlobal, source)
+}
+
+func TestGetActiveProfile_OverrideHasPrecedence(t *testing.T) {
+	r := require.New(t)
+
+	cfg := newScopedConfig(map[string]string{
+		"/home/testuser/.dev-cli/config.json": profilesFile,
+	}, map[string][]byte{})
+	cfg.lookupEnv = func(key string) (string, bool) {
+		return "personal", key == "DEV_CLI_PROFILE"
+	}
+
+	profile, source, err := cfg.GetActiveProfile()
+	r.Nil(err)
+	r.Equal("personal", profile)
+	r.Equal(SourceEnv, source)
+
+	cfg.getProfile = func() string { return "default" }
+
+	profile, source, err = cfg.GetActiveProfile()
+	r.Nil(err)
+	r.Equal(DefaultProfile, profile)
+	r.Equal(SourceFlag, source)
+}
+
+func TestLoadByKeyWithSource_ActiveProfileOverridesDefaultProfile(t *testing.T) {
+	r := require.New(t)
+
+	cfg := newScopedConfig(map[string]string{
+		"/home/testuser/.dev-cli/config.json": profilesFile,
+	}, map[string][]byte{})
+
+	value, source, err := cfg.LoadByKeyWithSource("core.tool")
+	r.Nil(err)
+
+	r.Equal("podman", value)
+	r.Equal(SourceProfile, source)
+}
+
+func TestSave_GlobalWithActiveProfile_WritesIntoProfile(t *testing.T) {
+	r := require.New(t)
+
+	written := map[string][]byte{}
+	cfg := newScopedConfig(map[string]string{
+		"/home/testuser/.dev-cli/config.json": profilesFile,
+	}, written)
+
+	err := cfg.Save("logs.tail", "10")
+
+	r.Nil(err)
+	r.JSONEq(`{
+		"version":1,
+		"core":{"tool":"docker"},
+		"activeProfile":"work",
+		"profiles":{"work":{"core":{"tool":"podman"},"logs":{"tail":10}},"personal":{}}
+	}`, string(written["/home/testuser/.dev-cli/config.json"]))
+}
+
+func TestReset_CorruptFile_RecreatesItEmpty(t *testing.T) {
+	r := require.New(t)
+
+	written := map[string][]byte{}
+	cfg := newScopedConfig(map[string]string{
+		"/home/testuser/.dev-cli/config.json": `{"core":`,
+	}, written)
+
+	err := cfg.Reset()
+
+	r.Nil(err)
+	r.JSONEq(`{"version":1}`, string(written["/home/testuser/.dev-cli/config.json"]))
+}
+
+func TestReset_DefaultProfile_KeepsNamedProfiles(t *testing.T) {
+	r := require.New(t)
+
+	written := map[string][]byte{}
+	cfg := newScopedConfig(map[string]string{
+		"/home/testuser/.dev-cli/config.json": profilesFile,
+	}, written)
+	cfg.getProfile = func() string { return DefaultProfile }
+
+	err := cfg.Reset()
+
+	r.Nil(err)
+	r.JSONEq(`{
+		"version":1,
+		"activeProfile":"work",
+		"profiles":{"work":{"core":{"tool":"podman"}},"personal":{}}
+	}`, string(written["/home/testuser/.dev-cli/config.json"]))
+}
+
+func TestListProfiles_DefaultFirstThenSorted(t *testing.T) {
+	r := require.New(t)
+
+	cfg := newScopedConfig(map[string]string{
+		"/home/testuser/.dev-cli/config.json": profilesFile,
+	}, map[string][]byte{})
+
+	profiles, err := cfg.ListProfiles()
+	r.Nil(err)
+	r.Equal([]string{"default", "personal", "work"}, profiles)
+}
+
+func TestListProfiles_CorruptFile_ReturnsError(t *testing.T) {
+	r := require.New(t)
+
+	cfg := newScopedConfig(map[string]string{
+		"/home/testuser/.dev-cli/config.json": `{"profiles":`,
+	}, map[string][]byte{})
+
+	profiles, err := cfg.ListProfiles()
+
+	r.ErrorIs(err, ErrCorruptConfig)
+	r.Nil(profiles)
+}
+
+func TestValidateActiveProfile_ExistingProfile_ReturnsNil(t *testing.T) {
+	r := require.New(t)
+
+	cfg := newScopedConfig(map[string]string{
+		"/home/testuser/.dev-cli/config.json": profilesFile,
+	}, map[string][]byte{})
+
+	r.Nil(cfg.ValidateActiveProfile())
+}
+
+func TestValidateActiveProfile_UnknownProfile_ReturnsError(t *testing.T) {
+	tests := []struct {
+		name      string
+		file      string
+		flag      string
+		env       string
+		wantError string
+	}{
+		{name: "flag", file: profilesFile, flag: "wrok", wantError: "perfil 'wrok' (--profile) não existe"},
+		{name: "env", file: profilesFile, env: "nosuch", wantError: "perfil 'nosuch' (DEV_CLI_PROFILE) não existe"},
+		{name: "file", file: `{"activeProfile":"gone"}`, wantError: "perfil 'gone' (activeProfile) não existe"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			cfg := newScopedConfig(map[string]string{
+				"/home/testuser/.dev-cli/config.json": tt.file,
+			}, map[string][]byte{})
+			cfg.getProfile = func() string { return tt.flag }
+			cfg.lookupEnv = func(key string) (string, bool) {
+				return tt.env, key == "DEV_CLI_PROFILE" && tt.env != ""
+			}
+
+			err := cfg.ValidateActiveProfile()
+
+			r.ErrorContains(err, tt.wantError)
+			r.ErrorContains(err, "perfis disponíveis: default")
+		})
+	}
+}
+
+func TestUseProfile_NewProfile_CreatesAndActivates(t *testing.T) {
+	r := require.New(t)
+
+	written := map[string][]byte{}
+	cfg := newScopedConfig(map[string]string{
+		"/home/testuser/.dev-cli/config.json": `{"core":{"tool":"docker"}}`,
+	}, written)
+
+	err := cfg.UseProfile("work")
+
+	r.Nil(err)
+	r.JSONEq(`{
+		"version":1,
+		"core":{"tool":"docker"},
+		"activeProfile":"work",
+		"profiles":{"work":{}}
+	}`, string(written["/home/testuser/.dev-cli/config.json"]))
+}
+
+func TestUseProfile_Default_RemovesActiveProfile(t *testing.T) {
+	r := require.New(t)
+
+	written := map[string][]byte{}
+	cfg := newScopedConfig(map[string]string{
+		"/home/testuser/.dev-cli/config.json": profilesFile,
+	}, written)
+
+	err := cfg.UseProfile(DefaultProfile)
+
+	r.Nil(err)
+	r.NotContains(string(written["/home/testuser/.dev-cli/config.json"]), "activeProfile")
+}
+
+func TestUseProfile_InvalidName_ReturnsError(t *testing.T) {
+	r := require.New(t)
+
+	written := map[string][]byte{}
+	cfg := newScopedConfig(map[string]string{}, written)
+
+	err := cfg.UseProfile("my.profile")
+
+	r.NotNil(err)
+	r.Empty(written)
+}
+
+// ============================================================================
+// Tests for Migrate
+// ============================================================================
+
+func renameToolMigration() []Migration {
+	return []Migration{
+		{
+			Version:     2,
+			Description: "renomeia core.engine para core.tool",
+			Migrate: func(values map[string]any) error {
+				if core, ok := values["core"].(map[string]any); ok {
+					if engine, exists := core["engine"]; exists {
+						core["tool"] = engine
+						delete(core, "engine")
+					}
+				}
+				return nil
+			},
+		},
+	}
+}
+
+func TestMigrate_CurrentVersion_DoesNotWrite(t *testing.T) {
+	r := require.New(t)
+
+	written := map[string][]byte{}
+	cfg := newScopedConfig(map[string]string{
+		"/home/testuser/.dev-cli/config.json": `{"version":1,"core":{"tool":"podman"}}`,
+	}, written)
+
+	err := cfg.Migrate()
+
+	r.Nil(err)
+	r.Empty(written)
+}
+
+func TestMigrate_OldVersion_UpgradesInPlaceWithBackup(t *testing.T) {
+	r := require.New(t)
+
+	original := `{"core":{"engine":"podman"},"activeProfile":"work","profiles":{"work":{"core":{"engine":"docker"}}}}`
+
+	written := map[string][]byte{}
+	cfg := newScopedConfig(map[string]string{
+		"/home/testuser/.dev-cli/config.json": original,
+	}, written)
+	cfg.getMigrations = func() *[]Migration {
+		migrations := renameToolMigration()
+		return &migrations
+	}
+
+	err := cfg.Migrate()
+
+	r.Nil(err)
+	r.Equal(original, string(written["/home/testuser/.dev-cli/config.json.v1.bak"]))
+	r.JSONEq(`{
+		"version":2,
+		"core":{"tool":"podman"},
+		"activeProfile":"work",
+		"profiles":{"work":{"core":{"tool":"docker"}}}
+	}`, string(written["/home/testuser/.dev-cli/config.json"]))
+}
+
+func TestMigrate_NewerVersion_ReturnsError(t *testing.T) {
+	r := require.New(t)
+
+	written := map[string][]byte{}
+	cfg := newScopedConfig(map[string]string{
+		"/home/testuser/.dev-cli/config.json": `{"version":99}`,
+	}, written)
+
+	err := cfg.Migrate()
+
+	r.NotNil(err)
+	r.Empty(written)
+}
+
+func TestMigrate_CorruptFile_ReturnsError(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{name: "invalid json", content: `{"core":`},
+		{name: "invalid version", content: `{"version":"one"}`},
+		{name: "invalid value type", content: `{"logs":{"tail":"many"}}`},
+		{name: "invalid profile value type", content: `{"profiles":{"work":{"core":{"tool":1}}}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			cfg := newScopedConfig(map[string]string{
+				"/home/testuser/project/.dev-cli.json": tt.content,
+			}, map[string][]byte{})
+
+			err := cfg.Migrate()
+
+			r.NotNil(err)
+			r.Contains(err.Error(), "/home/testuser/project/.dev-cli.json")
+		})
+	}
+}
+
+func TestMigrate_NoFiles_ReturnsNil(t *testing.T) {
+	r := require.New(t)
+
+	cfg := newScopedConfig(map[string]string{}, map[string][]byte{})
+
+	r.Nil(cfg.Migrate())
+}
+
 // ============================================================================
 // Tests for TrySave
 // ============================================================================
@@ -468,7 +1369,7 @@ func TestTrySave_GlobalFlagTrue_CallsSaveSuccessfully(t *testing.T) {
 	r.Contains(string(savedData), "podman")
 }
 
-func TestTrySave_GlobalFlagFalse_ReturnsError(t *testing.T) {
+func TestTrySave_NoScopeFlag_ReturnsError(t *testing.T) {
 	r := require.New(t)
 
 	cfg := NewConfig(
@@ -482,7 +1383,7 @@ func TestTrySave_GlobalFlagFalse_ReturnsError(t *testing.T) {
 
 	r.NotNil(err)
 	r.Equal("", value)
-	r.Equal("atualmente apenas a flag --global é suportada", err.Error())
+	r.Equal("é necessário informar o escopo com --global ou --local", err.Error())
 }
 
 func TestTrySave_InvalidValue_ReturnsError(t *testing.T) {
@@ -511,6 +1412,62 @@ func TestTrySave_InvalidValue_ReturnsError(t *testing.T) {
 	r.Contains(err.Error(), "valor inválido")
 }
 
+// ============================================================================
+// Tests for ValidateValue
+// ============================================================================
+
+func TestValidateValue_ByKind(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler ConfigHandler
+		value   string
+		wantErr bool
+	}{
+		{"enum valid", ConfigHandler{Kind: KindEnum, ValidValues: []string{"a", "b"}}, "a", false},
+		{"enum invalid", ConfigHandler{Kind: KindEnum, ValidValues: []string{"a", "b"}}, "c", true},
+		{"string any", ConfigHandler{Kind: KindString}, "anything", false},
+		{"path empty", ConfigHandler{Kind: KindPath}, " ", true},
+		{"int valid", ConfigHandler{Kind: KindInt}, "42", false},
+		{"int invalid", ConfigHandler{Kind: KindInt}, "abc", true},
+		{"bool valid", ConfigHandler{Kind: KindBool}, "true", false},
+		{"bool invalid", ConfigHandler{Kind: KindBool}, "yes please", true},
+		{"duration valid", ConfigHandler{Kind: KindDuration}, "5m", false},
+		{"duration invalid", ConfigHandler{Kind: KindDuration}, "5 minutes", true},
+		{
+			"custom validation",
+			ConfigHandler{Kind: KindString, Validate: func(value string) error {
+				return errors.New("always invalid")
+			}},
+			"value",
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateValue(&tt.handler, tt.value)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func TestGetCompletionValues_OnlyClosedKinds(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal([]string{"docker", "podman"}, GetCompletionValues(&ConfigHandler{Kind: KindEnum, ValidValues: []string{"docker", "podman"}}))
+	r.Equal([]string{"true", "false"}, GetCompletionValues(&ConfigHandler{Kind: KindBool}))
+	r.Nil(GetCompletionValues(&ConfigHandler{Kind: KindInt}))
+}
+
+func TestRegisteredHandlers_DefaultsAreValid(t *testing.T) {
+	for key, handler := range *GetHandlers() {
+		if handler.Default == "" {
+			continue
+		}
+		assert.NoError(t, ValidateValue(&handler, handler.Default), key)
+	}
+}
+
 // ============================================================================
 // Tests for InterativeSelect (Note: Tests use mocks to avoid actual user input)
 // ============================================================================
@@ -554,6 +1511,7 @@ func TestNewConfig_WithoutOptions_CreatesWithDefaults(t *testing.T) {
 	r.NotNil(cfg.writeFile)
 	r.NotNil(cfg.stat)
 	r.False(cfg.flags.Global)
+	r.False(cfg.flags.Local)
 	r.False(cfg.flags.Interative)
 }
 
@@ -613,11 +1571,12 @@ func TestLoadAndSaveIntegration_LoadConfigModifyAndSave(t *testing.T) {
 	)
 
 	// Load initial value
-	initialValue := cfg.LoadByKey("core.tool")
+	initialValue, err := cfg.LoadByKey("core.tool")
+	r.Nil(err)
 	r.Equal("docker", initialValue)
 
 	// Save new value
-	err := cfg.Save("core.tool", "podman")
+	err = cfg.Save("core.tool", "podman")
 	r.Nil(err)
 
 	// Verify saved data contains new value
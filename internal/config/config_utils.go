@@ -1,13 +1,23 @@
 package config
 
 import (
+	"encoding/json"
 	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 )
 
 var handlers = map[string]ConfigHandler{
 	"core.tool": {
-		ValidValues: []string{"docker", "podman"},
+		Kind:        KindEnum,
+		ValidValues: []string{"docker", "podman", "nerdctl"},
+		Default:     "docker",
 		Label:       "Selecione o motor de containers padrão",
+		Flag:        "tool",
 		Get: func(cfg *GlobalConfig) string {
 			return cfg.Core.Tool
 		},
@@ -15,25 +25,359 @@ var handlers = map[string]ConfigHandler{
 			cfg.Core.Tool = val
 		},
 	},
+	"editor.command": {
+		Kind:    KindString,
+		Default: "code",
+		Label:   "Informe o comando do editor (ex: code, code-insiders)",
+		Flag:    "editor",
+		Validate: func(value string) error {
+			if strings.TrimSpace(value) == "" {
+				return fmt.Errorf("o comando do editor não pode ser vazio")
+			}
+			return nil
+		},
+		Get: func(cfg *GlobalConfig) string {
+			return cfg.Editor.Command
+		},
+		Set: func(cfg *GlobalConfig, val string) {
+			cfg.Editor.Command = val
+		},
+	},
+	"shell.preferred": {
+		Kind:  KindPath,
+		Label: "Informe o caminho do shell preferido dentro do container (ex: /bin/zsh)",
+		Validate: func(value string) error {
+			if !path.IsAbs(value) {
+				return fmt.Errorf("o shell deve ser um caminho absoluto dentro do container")
+			}
+			return nil
+		},
+		Get: func(cfg *GlobalConfig) string {
+			return cfg.Shell.Preferred
+		},
+		Set: func(cfg *GlobalConfig, val string) {
+			cfg.Shell.Preferred = val
+		},
+	},
+	"logs.tail": {
+		Kind:    KindInt,
+		Default: "0",
+		Label:   "Informe quantas linhas de log exibir (0 para todas)",
+		Validate: func(value string) error {
+			if n, _ := strconv.Atoi(value); n < 0 {
+				return fmt.Errorf("a quantidade de linhas não pode ser negativa")
+			}
+			return nil
+		},
+		Get: func(cfg *GlobalConfig) string {
+			return strconv.Itoa(cfg.Logs.Tail)
+		},
+		Set: func(cfg *GlobalConfig, val string) {
+			cfg.Logs.Tail, _ = strconv.Atoi(val)
+		},
+	},
+	"volumes.image": {
+		Kind:    KindString,
+		Default: "docker.io/library/busybox:stable",
+		Label:   "Informe a imagem usada para copiar os volumes em backup e restore (precisa de sh, find e tar)",
+		Validate: func(value string) error {
+			if strings.TrimSpace(value) == "" {
+				return fmt.Errorf("a imagem não pode ser vazia")
+			}
+			return nil
+		},
+		Get: func(cfg *GlobalConfig) string {
+			return cfg.Volumes.Image
+		},
+		Set: func(cfg *GlobalConfig, val string) {
+			cfg.Volumes.Image = val
+		},
+	},
 }
 
+var flagOverrides = map[string]string{}
+
+var profileOverride string
+
+var workspaceOverride string
+
+var profileNamePattern = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
 func GetHandlers() *map[string]ConfigHandler {
 	return &handlers
 }
 
+// SetFlagOverride registers a value given through a command line flag for
+// the current execution. It has precedence over every other source.
+func SetFlagOverride(key string, value string) {
+	flagOverrides[key] = value
+}
+
+func GetFlagOverrides() *map[string]string {
+	return &flagOverrides
+}
+
+// SetProfileOverride selects the profile used by the current execution,
+// regardless of the one persisted in the global file.
+func SetProfileOverride(name string) {
+	profileOverride = name
+}
+
+func GetProfileOverride() string {
+	return profileOverride
+}
+
+// SetWorkspaceOverride selects the workspace whose local configuration is
+// used by the current execution, instead of the working directory.
+func SetWorkspaceOverride(path string) {
+	workspaceOverride = path
+}
+
+func GetWorkspaceOverride() string {
+	return workspaceOverride
+}
+
+// ValidateProfileName ensures name can be used as a key of the profiles
+// section, e.g. "work" or "personal".
+func ValidateProfileName(name string) error {
+	if !profileNamePattern.MatchString(name) {
+		return fmt.Errorf("nome de perfil inválido '%s': use apenas letras, números, '-' e '_'", name)
+	}
+
+	return nil
+}
+
+// GetEnvName returns the environment variable that overrides the key,
+// e.g. "core.tool" -> "DEV_CLI_CORE_TOOL".
+func GetEnvName(key string) string {
+	name := strings.ToUpper(strings.ReplaceAll(key, ".", "_"))
+	name = strings.ReplaceAll(name, "-", "_")
+
+	return "DEV_CLI_" + name
+}
+
 func IsAValidValue(handler *ConfigHandler, value string) bool {
-	isValid := false
-	var optionsList []string
-	for _, validVal := range handler.ValidValues {
-		optionsList = append(optionsList, fmt.Sprintf("* %s", validVal))
-		if value == validVal {
-			isValid = true
+	return ValidateValue(handler, value) == nil
+}
+
+// ValidateValue parses value according to the handler kind and runs the
+// handler specific validation, if any.
+func ValidateValue(handler *ConfigHandler, value string) error {
+	switch handler.Kind {
+	case KindString:
+	case KindPath:
+		if strings.TrimSpace(value) == "" {
+			return fmt.Errorf("o caminho não pode ser vazio")
+		}
+	case KindInt:
+		if _, err := strconv.Atoi(value); err != nil {
+			return fmt.Errorf("'%s' não é um número inteiro", value)
+		}
+	case KindBool:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("'%s' não é um booleano (true|false)", value)
+		}
+	case KindDuration:
+		if _, err := time.ParseDuration(value); err != nil {
+			return fmt.Errorf("'%s' não é uma duração válida (ex: 30s, 5m)", value)
+		}
+	default:
+		if !slices.Contains(handler.ValidValues, value) {
+			var optionsList []string
+			for _, validVal := range handler.ValidValues {
+				optionsList = append(optionsList, fmt.Sprintf("* %s", validVal))
+			}
+			return fmt.Errorf("opções permitidas:\n%s", strings.Join(optionsList, "\n"))
 		}
 	}
 
-	if !isValid {
+	if handler.Validate != nil {
+		return handler.Validate(value)
+	}
+
+	return nil
+}
+
+// GetCompletionValues returns the values offered by shell completion, which
+// only exist for kinds with a closed set of values.
+func GetCompletionValues(handler *ConfigHandler) []string {
+	switch handler.Kind {
+	case KindBool:
+		return []string{"true", "false"}
+	case KindEnum, "":
+		return handler.ValidValues
+	}
+
+	return nil
+}
+
+// GetValueHint describes the accepted values of the handler in help texts.
+func GetValueHint(handler *ConfigHandler) string {
+	if values := GetCompletionValues(handler); len(values) > 0 {
+		return strings.Join(values, "|")
+	}
+
+	return string(handler.Kind)
+}
+
+// getRawValue walks a decoded JSON object following the dot separated key
+// (e.g. "core.tool") and reports whether the key is present.
+func getRawValue(raw map[string]any, key string) (any, bool) {
+	parts := strings.Split(key, ".")
+	current := raw
+
+	for i, part := range parts {
+		value, exists := current[part]
+		if !exists {
+			return nil, false
+		}
+
+		if i == len(parts)-1 {
+			return value, true
+		}
+
+		next, ok := value.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		current = next
+	}
+
+	return nil, false
+}
+
+func setRawValue(raw map[string]any, key string, value any) {
+	parts := strings.Split(key, ".")
+	current := raw
+
+	for _, part := range parts[:len(parts)-1] {
+		next, ok := current[part].(map[string]any)
+		if !ok {
+			next = map[string]any{}
+			current[part] = next
+		}
+		current = next
+	}
+
+	current[parts[len(parts)-1]] = value
+}
+
+// getFileVersion returns the "version" field of a configuration file.
+// Files without it were written before versioning, i.e. version 1.
+func getFileVersion(raw map[string]any) (int, error) {
+	value, exists := raw[versionKey]
+	if !exists {
+		return 1, nil
+	}
+
+	version, ok := value.(float64)
+	if !ok || version < 1 || version != float64(int(version)) {
+		return 0, fmt.Errorf("campo 'version' inválido: %v", value)
+	}
+
+	return int(version), nil
+}
+
+// isBookkeepingKey reports whether key is stored at the root of the global
+// file for the CLI itself rather than being a configuration value.
+func isBookkeepingKey(key string) bool {
+	return key == versionKey || key == activeProfileKey || key == profilesKey
+}
+
+// getFileSections returns the value maps of a configuration file: the
+// root one, with the bookkeeping keys removed, and one per profile.
+func getFileSections(raw map[string]any) []map[string]any {
+	root := map[string]any{}
+	for key, value := range raw {
+		if !isBookkeepingKey(key) {
+			root[key] = value
+		}
+	}
+	sections := []map[string]any{root}
+
+	if profiles, ok := raw[profilesKey].(map[string]any); ok {
+		for _, profile := range profiles {
+			if values, ok := profile.(map[string]any); ok {
+				sections = append(sections, values)
+			}
+		}
+	}
+
+	return sections
+}
+
+// deleteRawValue removes a dot separated key from raw, dropping sections
+// left empty. Reports whether the key was present.
+func deleteRawValue(raw map[string]any, key string) bool {
+	parts := strings.SplitN(key, ".", 2)
+
+	if len(parts) == 1 {
+		if _, exists := raw[key]; !exists {
+			return false
+		}
+		delete(raw, key)
+		return true
+	}
+
+	next, ok := raw[parts[0]].(map[string]any)
+	if !ok || !deleteRawValue(next, parts[1]) {
 		return false
 	}
 
+	if len(next) == 0 {
+		delete(raw, parts[0])
+	}
+
 	return true
 }
+
+// flattenRawValues converts nested JSON objects into dot separated keys,
+// e.g. {"core":{"tool":"docker"}} -> {"core.tool":"docker"}.
+func flattenRawValues(raw map[string]any, prefix string, out map[string]any) {
+	for name, value := range raw {
+		key := name
+		if prefix != "" {
+			key = prefix + "." + name
+		}
+
+		if nested, ok := value.(map[string]any); ok {
+			flattenRawValues(nested, key, out)
+			continue
+		}
+
+		out[key] = value
+	}
+}
+
+func formatRawValue(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case nil:
+		return ""
+	}
+
+	return fmt.Sprint(value)
+}
+
+// extractRawValue returns the JSON representation of a single key of cfg,
+// keeping the type chosen by the GlobalConfig field.
+func extractRawValue(cfg *GlobalConfig, key string) (any, error) {
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	raw := map[string]any{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	value, _ := getRawValue(raw, key)
+
+	return value, nil
+}
@@ -4,11 +4,46 @@ type GlobalConfig struct {
 	Core struct {
 		Tool string `json:"tool"`
 	} `json:"core"`
+	Editor struct {
+		Command string `json:"command"`
+	} `json:"editor"`
+	Shell struct {
+		Preferred string `json:"preferred"`
+	} `json:"shell"`
+	Logs struct {
+		Tail int `json:"tail"`
+	} `json:"logs"`
+	Volumes struct {
+		Image string `json:"image"`
+	} `json:"volumes"`
 }
 
+type ValueKind string
+
+const (
+	KindEnum     ValueKind = "enum"
+	KindString   ValueKind = "string"
+	KindPath     ValueKind = "path"
+	KindInt      ValueKind = "int"
+	KindBool     ValueKind = "bool"
+	KindDuration ValueKind = "duration"
+)
+
 type ConfigHandler struct {
+	Kind        ValueKind
 	ValidValues []string
+	Default     string
 	Label       string
+	Flag        string
+	Validate    func(value string) error
 	Get         func(cfg *GlobalConfig) string
 	Set         func(cfg *GlobalConfig, val string)
 }
+
+// Migration upgrades the values of a configuration file (the root and each
+// profile) from Version-1 to Version, e.g. renaming a key of GlobalConfig.
+type Migration struct {
+	Version     int
+	Description string
+	Migrate     func(values map[string]any) error
+}
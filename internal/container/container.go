@@ -1,11 +1,88 @@
 package container
 
+import (
+	"time"
+
+	"github.com/Brennon-Oliveira/dev-cli/internal/container/container_utils"
+)
+
 type ContainerCLI interface {
-	ListContainersOfActiveDevcontainers() error
-	CleanResources() error
-	DownContainer(path string) error
+	ListDevcontainers(all bool) error
+	ListDevcontainerStats(all bool) error
+	CleanResources(opts CleanOptions) error
+	DownContainer(path string, opts DownOptions) error
+	StartContainer(path string) error
+	RestartContainer(path string) error
 	GetAllRelatedContainers(path string) ([]string, error)
-	KillContainer(path string) error
-	ShowLogs(path string, follow bool) error
+	GetWorkspaces(all bool) ([]container_utils.Workspace, error)
+	KillContainer(path string, opts KillOptions) error
+	RemoveContainers(path string, opts RemoveOptions) error
+	ShowLogs(path string, opts LogsOptions) error
 	ListPorts(path string) error
+	ForwardPorts(path string, forwards []container_utils.PortForward) error
+	ShowStats(path string) error
+	ListVolumes(path string) error
+	BackupVolumes(path string, dir string) error
+	RestoreVolumes(path string, dir string, yes bool) error
+	SaveSnapshot(path string, name string) error
+	ListSnapshots(path string) error
+	RestoreSnapshot(path string, name string, yes bool) error
+	WaitContainers(path string, opts WaitOptions) error
+}
+
+// LogsOptions selects the containers and lines shown by ShowLogs.
+type LogsOptions struct {
+	Follow bool
+	// Services limits the logs to these compose services or container
+	// names. Empty shows every container of the workspace.
+	Services []string
+	// Since shows only the logs after a timestamp or relative time (10m).
+	Since string
+	// Tail limits each container to its last lines, overriding `logs.tail`
+	// when positive.
+	Tail       int
+	Timestamps bool
+	// Grep keeps only the lines matching this regular expression.
+	Grep string
+}
+
+// CleanOptions selects what CleanResources removes.
+type CleanOptions struct {
+	// DryRun lists the resources without removing them.
+	DryRun bool
+	// Yes removes them without asking for confirmation.
+	Yes bool
+	// Global prunes every stopped container and unused network of the
+	// engine, not only the dev container ones.
+	Global bool
+}
+
+// KillOptions controls the confirmation of KillContainer.
+type KillOptions struct {
+	// DryRun lists the containers without removing them.
+	DryRun bool
+	// Yes removes them without asking for confirmation.
+	Yes bool
+}
+
+// RemoveOptions controls what RemoveContainers removes before a rebuild.
+type RemoveOptions struct {
+	// Volumes removes the volumes of the containers too.
+	Volumes bool
+	// Yes removes the volumes without asking for confirmation.
+	Yes bool
+}
+
+// WaitOptions controls how long WaitContainers waits for the workspace.
+type WaitOptions struct {
+	// Timeout is how long to wait before failing.
+	Timeout time.Duration
+	// Interval is the time between two checks of the containers.
+	Interval time.Duration
+}
+
+// DownOptions controls DownContainer.
+type DownOptions struct {
+	// DryRun lists the containers without stopping them.
+	DryRun bool
 }
@@ -1,36 +1,108 @@
 package container
 
 import (
+	"net"
+	"os"
+	"os/signal"
+	"time"
+
 	"github.com/Brennon-Oliveira/dev-cli/internal/config"
 	"github.com/Brennon-Oliveira/dev-cli/internal/container/container_utils"
+	"github.com/Brennon-Oliveira/dev-cli/internal/devcontainer"
+	"github.com/Brennon-Oliveira/dev-cli/internal/engine"
 	"github.com/Brennon-Oliveira/dev-cli/internal/exec"
+	"github.com/Brennon-Oliveira/dev-cli/internal/output"
 	"github.com/Brennon-Oliveira/dev-cli/internal/pather"
 )
 
 type realContainerCLI struct {
 	executor                         exec.Executor
 	config                           config.Config
+	engine                           engine.Engine
 	pather                           pather.Pather
-	parseContainerOutput             container_utils.ParseContainerOutputFunc
+	devcontainer                     devcontainer.DevContainerCLI
+	renderer                         output.Renderer
+	findDevcontainers                container_utils.FindDevcontainersFunc
+	groupContainers                  container_utils.GroupContainersFunc
 	formatGroupedContainers          container_utils.FormatGroupedContainersFunc
+	parsePorts                       container_utils.ParsePortsFunc
 	tryPaths                         container_utils.TryPathsFunc
 	findMainContainersForPath        container_utils.FindMainContainersForPathFunc
 	extractProjectFromContainer      container_utils.ExtractProjectFromContainerFunc
 	findComposeContainersForProject  container_utils.FindComposeContainersForProjectFunc
 	deduplicateAndFilterContainerIDs container_utils.DeduplicateAndFilterContainerIDsFunc
+	groupContainersByPod             container_utils.GroupContainersByPodFunc
+	groupStats                       container_utils.GroupStatsFunc
+	formatWorkspaceStats             container_utils.FormatWorkspaceStatsFunc
+	formatContainerStats             container_utils.FormatContainerStatsFunc
+	probePort                        container_utils.ProbePortFunc
+	checkDeclaredPorts               container_utils.CheckDeclaredPortsFunc
+	formatPorts                      container_utils.FormatPortsFunc
+	formatForwards                   container_utils.FormatForwardsFunc
+	listen                           container_utils.ListenFunc
+	notifyInterrupt                  container_utils.NotifyFunc
+	startOrder                       container_utils.StartOrderFunc
+	formatCleanedResources           container_utils.FormatCleanedResourcesFunc
+	formatAffectedContainers         container_utils.FormatAffectedContainersFunc
+	confirm                          container_utils.ConfirmFunc
+	isTerminal                       container_utils.IsTerminalFunc
+	formatVolumes                    container_utils.FormatVolumesFunc
+	mkdirAll                         container_utils.MkdirAllFunc
+	createTemp                       container_utils.CreateTempFunc
+	rename                           container_utils.RenameFunc
+	remove                           container_utils.RemoveFunc
+	stat                             container_utils.StatFunc
+	open                             container_utils.OpenFunc
+	formatSnapshots                  container_utils.FormatSnapshotsFunc
+	mkdirTemp                        container_utils.MkdirTempFunc
+	removeAll                        container_utils.RemoveAllFunc
+	writeFile                        container_utils.WriteFileFunc
+	formatServiceStatus              container_utils.FormatServiceStatusFunc
+	now                              func() time.Time
 }
 
 type Option func(*realContainerCLI)
 
 func NewContainerCLI(opts ...Option) *realContainerCLI {
 	c := &realContainerCLI{
-		parseContainerOutput:             container_utils.ParseContainerOutput,
+		renderer:                         output.NewRenderer(),
+		findDevcontainers:                container_utils.FindDevcontainers,
+		groupContainers:                  container_utils.GroupContainers,
 		formatGroupedContainers:          container_utils.FormatGroupedContainers,
+		parsePorts:                       container_utils.ParsePorts,
 		tryPaths:                         container_utils.TryPaths,
 		findMainContainersForPath:        container_utils.FindMainContainersForPath,
 		extractProjectFromContainer:      container_utils.ExtractProjectFromContainer,
 		findComposeContainersForProject:  container_utils.FindComposeContainersForProject,
 		deduplicateAndFilterContainerIDs: container_utils.DeduplicateAndFilterContainerIDs,
+		groupContainersByPod:             container_utils.GroupContainersByPod,
+		groupStats:                       container_utils.GroupStats,
+		formatWorkspaceStats:             container_utils.FormatWorkspaceStats,
+		formatContainerStats:             container_utils.FormatContainerStats,
+		probePort:                        container_utils.ProbePort,
+		checkDeclaredPorts:               container_utils.CheckDeclaredPorts,
+		formatPorts:                      container_utils.FormatPorts,
+		formatForwards:                   container_utils.FormatForwards,
+		listen:                           net.Listen,
+		notifyInterrupt:                  signal.Notify,
+		startOrder:                       container_utils.StartOrder,
+		formatCleanedResources:           container_utils.FormatCleanedResources,
+		formatAffectedContainers:         container_utils.FormatAffectedContainers,
+		confirm:                          container_utils.Confirm,
+		isTerminal:                       container_utils.StdinIsTerminal,
+		formatVolumes:                    container_utils.FormatVolumes,
+		mkdirAll:                         os.MkdirAll,
+		createTemp:                       container_utils.CreateTemp,
+		rename:                           os.Rename,
+		remove:                           os.Remove,
+		stat:                             os.Stat,
+		open:                             container_utils.Open,
+		formatSnapshots:                  container_utils.FormatSnapshots,
+		mkdirTemp:                        os.MkdirTemp,
+		removeAll:                        os.RemoveAll,
+		writeFile:                        os.WriteFile,
+		formatServiceStatus:              container_utils.FormatServiceStatus,
+		now:                              time.Now,
 	}
 
 	for _, opt := range opts {
@@ -52,15 +124,45 @@ func WithConfig(co config.Config) Option {
 	}
 }
 
+// WithEngine fixes the container engine. Without it, the engine is selected
+// from `core.tool`.
+func WithEngine(e engine.Engine) Option {
+	return func(c *realContainerCLI) {
+		c.engine = e
+	}
+}
+
 func WithPather(p pather.Pather) Option {
 	return func(c *realContainerCLI) {
 		c.pather = p
 	}
 }
 
-func WithParseContainerOutput(f container_utils.ParseContainerOutputFunc) Option {
+// WithDevContainerCLI reads devcontainer.json, to check the declared ports.
+// Without it, `dev ports` lists only the published ports.
+func WithDevContainerCLI(d devcontainer.DevContainerCLI) Option {
+	return func(c *realContainerCLI) {
+		c.devcontainer = d
+	}
+}
+
+// WithRenderer sets where listings are written. Without it, they follow
+// the `--output` format on stdout.
+func WithRenderer(r output.Renderer) Option {
+	return func(c *realContainerCLI) {
+		c.renderer = r
+	}
+}
+
+func WithFindDevcontainers(f container_utils.FindDevcontainersFunc) Option {
+	return func(c *realContainerCLI) {
+		c.findDevcontainers = f
+	}
+}
+
+func WithGroupContainers(f container_utils.GroupContainersFunc) Option {
 	return func(c *realContainerCLI) {
-		c.parseContainerOutput = f
+		c.groupContainers = f
 	}
 }
 
@@ -70,6 +172,12 @@ func WithFormatGroupedContainers(f container_utils.FormatGroupedContainersFunc)
 	}
 }
 
+func WithParsePorts(f container_utils.ParsePortsFunc) Option {
+	return func(c *realContainerCLI) {
+		c.parsePorts = f
+	}
+}
+
 func WithTryPaths(f container_utils.TryPathsFunc) Option {
 	return func(c *realContainerCLI) {
 		c.tryPaths = f
@@ -99,3 +207,174 @@ func WithDeduplicateAndFilterContainerIDs(f container_utils.DeduplicateAndFilter
 		c.deduplicateAndFilterContainerIDs = f
 	}
 }
+
+func WithGroupContainersByPod(f container_utils.GroupContainersByPodFunc) Option {
+	return func(c *realContainerCLI) {
+		c.groupContainersByPod = f
+	}
+}
+
+func WithGroupStats(f container_utils.GroupStatsFunc) Option {
+	return func(c *realContainerCLI) {
+		c.groupStats = f
+	}
+}
+
+func WithFormatWorkspaceStats(f container_utils.FormatWorkspaceStatsFunc) Option {
+	return func(c *realContainerCLI) {
+		c.formatWorkspaceStats = f
+	}
+}
+
+func WithFormatContainerStats(f container_utils.FormatContainerStatsFunc) Option {
+	return func(c *realContainerCLI) {
+		c.formatContainerStats = f
+	}
+}
+
+func WithProbePort(f container_utils.ProbePortFunc) Option {
+	return func(c *realContainerCLI) {
+		c.probePort = f
+	}
+}
+
+func WithCheckDeclaredPorts(f container_utils.CheckDeclaredPortsFunc) Option {
+	return func(c *realContainerCLI) {
+		c.checkDeclaredPorts = f
+	}
+}
+
+func WithFormatPorts(f container_utils.FormatPortsFunc) Option {
+	return func(c *realContainerCLI) {
+		c.formatPorts = f
+	}
+}
+
+func WithFormatForwards(f container_utils.FormatForwardsFunc) Option {
+	return func(c *realContainerCLI) {
+		c.formatForwards = f
+	}
+}
+
+// WithListen replaces net.Listen for the host ports of ForwardPorts.
+func WithListen(f container_utils.ListenFunc) Option {
+	return func(c *realContainerCLI) {
+		c.listen = f
+	}
+}
+
+// WithNotifyInterrupt replaces signal.Notify, which ends ShowLogs and
+// ForwardPorts on Ctrl+C.
+func WithNotifyInterrupt(f container_utils.NotifyFunc) Option {
+	return func(c *realContainerCLI) {
+		c.notifyInterrupt = f
+	}
+}
+
+func WithStartOrder(f container_utils.StartOrderFunc) Option {
+	return func(c *realContainerCLI) {
+		c.startOrder = f
+	}
+}
+
+func WithFormatCleanedResources(f container_utils.FormatCleanedResourcesFunc) Option {
+	return func(c *realContainerCLI) {
+		c.formatCleanedResources = f
+	}
+}
+
+func WithFormatAffectedContainers(f container_utils.FormatAffectedContainersFunc) Option {
+	return func(c *realContainerCLI) {
+		c.formatAffectedContainers = f
+	}
+}
+
+func WithConfirm(f container_utils.ConfirmFunc) Option {
+	return func(c *realContainerCLI) {
+		c.confirm = f
+	}
+}
+
+func WithIsTerminal(f container_utils.IsTerminalFunc) Option {
+	return func(c *realContainerCLI) {
+		c.isTerminal = f
+	}
+}
+
+func WithFormatVolumes(f container_utils.FormatVolumesFunc) Option {
+	return func(c *realContainerCLI) {
+		c.formatVolumes = f
+	}
+}
+
+func WithMkdirAll(f container_utils.MkdirAllFunc) Option {
+	return func(c *realContainerCLI) {
+		c.mkdirAll = f
+	}
+}
+
+func WithCreateTemp(f container_utils.CreateTempFunc) Option {
+	return func(c *realContainerCLI) {
+		c.createTemp = f
+	}
+}
+
+func WithRename(f container_utils.RenameFunc) Option {
+	return func(c *realContainerCLI) {
+		c.rename = f
+	}
+}
+
+func WithRemove(f container_utils.RemoveFunc) Option {
+	return func(c *realContainerCLI) {
+		c.remove = f
+	}
+}
+
+func WithStat(f container_utils.StatFunc) Option {
+	return func(c *realContainerCLI) {
+		c.stat = f
+	}
+}
+
+func WithOpen(f container_utils.OpenFunc) Option {
+	return func(c *realContainerCLI) {
+		c.open = f
+	}
+}
+
+func WithFormatSnapshots(f container_utils.FormatSnapshotsFunc) Option {
+	return func(c *realContainerCLI) {
+		c.formatSnapshots = f
+	}
+}
+
+func WithMkdirTemp(f container_utils.MkdirTempFunc) Option {
+	return func(c *realContainerCLI) {
+		c.mkdirTemp = f
+	}
+}
+
+func WithRemoveAll(f container_utils.RemoveAllFunc) Option {
+	return func(c *realContainerCLI) {
+		c.removeAll = f
+	}
+}
+
+func WithWriteFile(f container_utils.WriteFileFunc) Option {
+	return func(c *realContainerCLI) {
+		c.writeFile = f
+	}
+}
+
+func WithFormatServiceStatus(f container_utils.FormatServiceStatusFunc) Option {
+	return func(c *realContainerCLI) {
+		c.formatServiceStatus = f
+	}
+}
+
+func WithNow(f func() time.Time) Option {
+	return func(c *realContainerCLI) {
+		c.now = f
+	}
+}
@@ -1,34 +1,209 @@
 package container
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/Brennon-Oliveira/dev-cli/internal/container/container_utils"
+	"github.com/Brennon-Oliveira/dev-cli/internal/engine"
 	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
+	"github.com/Brennon-Oliveira/dev-cli/internal/output"
 )
 
-func (c *realContainerCLI) ListContainersOfActiveDevcontainers() error {
-	tool := c.config.Load().Core.Tool
-	format := "table {{.ID}}\t{{.Names}}\t{{.Status}}\t{{.Label \"devcontainer.local_folder\"}}"
-	output, err := c.executor.Output(tool, "ps", "--filter", "name=devcontainer", "--format", format)
+// getEngine returns the injected engine or the one selected by `core.tool`.
+func (c *realContainerCLI) getEngine() (engine.Engine, error) {
+	if c.engine != nil {
+		return c.engine, nil
+	}
+
+	cfg, err := c.config.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	return engine.NewEngine(cfg.Core.Tool, engine.WithExecutor(c.executor))
+}
+
+// ListDevcontainers lists the devcontainers and their compose services,
+// including the stopped ones when all is set.
+func (c *realContainerCLI) ListDevcontainers(all bool) error {
+	eng, err := c.getEngine()
+	if err != nil {
+		return err
+	}
+
+	containers, err := c.findDevcontainers(eng, all)
 
 	if err != nil {
 		logger.Error("Houve um erro ao ler os DevContainers ativos!")
 		return err
 	}
 
-	containers := c.parseContainerOutput(string(output))
-	formatted := c.formatGroupedContainers(containers)
+	grouped := c.groupContainers(containers)
 
-	logger.Info(formatted)
-	return nil
+	return c.renderer.Render(container_utils.WorkspaceContainers(grouped), func() string {
+		return c.formatGroupedContainers(grouped)
+	})
 }
 
-func (c *realContainerCLI) CleanResources() error {
-	tool := c.config.Load().Core.Tool
+// ListDevcontainerStats shows the resource usage of each devcontainer
+// workspace, summed over the devcontainer and its compose services.
+func (c *realContainerCLI) ListDevcontainerStats(all bool) error {
+	eng, err := c.getEngine()
+	if err != nil {
+		return err
+	}
+
+	containers, err := c.findDevcontainers(eng, all)
+
+	if err != nil {
+		logger.Error("Houve um erro ao ler os DevContainers ativos!")
+		return err
+	}
+
+	stats, err := c.sampleStats(eng, containers)
+	if err != nil {
+		return err
+	}
+
+	workspaces := c.groupStats(c.groupContainers(containers), stats)
+
+	return c.renderer.Render(workspaces, func() string {
+		return c.formatWorkspaceStats(workspaces)
+	})
+}
+
+// GetWorkspaces returns the devcontainer workspaces with their containers
+// and resource usage, for views that refresh them like `dev top`.
+func (c *realContainerCLI) GetWorkspaces(all bool) ([]container_utils.Workspace, error) {
+	eng, err := c.getEngine()
+	if err != nil {
+		return nil, err
+	}
+
+	containers, err := c.findDevcontainers(eng, all)
+	if err != nil {
+		logger.Error("Houve um erro ao ler os DevContainers ativos!")
+		return nil, err
+	}
+
+	stats, err := c.sampleStats(eng, containers)
+	if err != nil {
+		return nil, err
+	}
+
+	return container_utils.GroupWorkspaces(c.groupContainers(containers), stats), nil
+}
+
+// sampleStats samples the running containers only, as engines fail or
+// print nothing for the stopped ones.
+func (c *realContainerCLI) sampleStats(eng engine.Engine, containers []*engine.Container) ([]*engine.ContainerStats, error) {
+	var running []string
+	for _, container := range containers {
+		if container.State == "running" {
+			running = append(running, container.ID)
+		}
+	}
+
+	if len(running) == 0 {
+		return nil, nil
+	}
+
+	stats, err := eng.Stats(running...)
+	if err != nil {
+		logger.Error("Não foi possível obter o uso de recursos dos containers.")
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// CleanResources removes the resources left by stopped dev containers:
+// their containers, anonymous volumes, compose networks and unused vsc-
+// images. With opts.Global it prunes every stopped container, unused
+// network and the dangling build cache of the engine instead, since the
+// build cache can't be attributed to a workspace.
+func (c *realContainerCLI) CleanResources(opts CleanOptions) error {
+	eng, err := c.getEngine()
+	if err != nil {
+		return err
+	}
+
+	if opts.Global {
+		if opts.DryRun {
+			logger.Error("O --dry-run não está disponível com --global, que usa o prune do motor de containers.")
+			return errors.New("--dry-run não é suportado com --global")
+		}
 
+		if !opts.Yes {
+			confirmed, err := c.confirmAction("Remover todos os containers parados, redes não utilizadas e o cache de build sem referência do motor, inclusive os que não são de dev containers?")
+			if err != nil || !confirmed {
+				return err
+			}
+		}
+
+		return c.pruneResources(eng)
+	}
+
+	plan, err := c.planClean(eng)
+	if err != nil {
+		return err
+	}
+
+	resources := plan.resources()
+	if len(resources) == 0 {
+		logger.Info("Nada a remover.")
+		return c.renderer.Render(resources, func() string {
+			return c.formatCleanedResources(resources, opts.DryRun)
+		})
+	}
+
+	if opts.DryRun {
+		logger.Info("Recursos que seriam removidos (nada foi alterado):")
+		return c.renderer.Render(resources, func() string {
+			return c.formatCleanedResources(resources, true)
+		})
+	}
+
+	if !opts.Yes {
+		logger.Info("Os seguintes recursos serão removidos:\n%s", c.formatCleanedResources(resources, true))
+
+		confirmed, err := c.confirmAction("Remover os recursos listados?")
+		if err != nil || !confirmed {
+			return err
+		}
+	}
+
+	removed, err := c.applyClean(eng, plan)
+	if err != nil {
+		return err
+	}
+
+	if err := c.renderer.Render(removed, func() string {
+		return c.formatCleanedResources(removed, false)
+	}); err != nil {
+		return err
+	}
+
+	logger.Success("Limpeza concluída.")
+	return nil
+}
+
+// pruneResources prunes every stopped container, unused network and the
+// dangling build cache of the engine, dev containers or not.
+func (c *realContainerCLI) pruneResources(eng engine.Engine) error {
 	logger.Info("Removendo containers parados...")
-	err := c.executor.Run(tool, "container", "prune", "-f")
+	err := eng.Prune(engine.ResourceContainer)
 
 	if err != nil {
 		logger.Error("Houve um erro ao remover os containers parados.")
@@ -37,7 +212,7 @@ func (c *realContainerCLI) CleanResources() error {
 	}
 
 	logger.Info("Removendo redes não utilizadas...")
-	err = c.executor.Run(tool, "network", "prune", "-f")
+	err = eng.Prune(engine.ResourceNetwork)
 
 	if err != nil {
 		logger.Error("Houve um erro ao remover as redes não utilizadas.")
@@ -45,29 +220,191 @@ func (c *realContainerCLI) CleanResources() error {
 		return err
 	}
 
+	logger.Info("Removendo cache de build sem referência...")
+	reclaimed, err := eng.PruneBuildCache()
+	if err != nil {
+		logger.Verbose("O cache de build não foi limpo: %v", err)
+	} else if reclaimed > 0 {
+		logger.Info("Cache de build: %s liberados", container_utils.FormatBytes(reclaimed))
+	}
+
 	logger.Success("Limpeza concluída.")
 	return nil
 }
 
-func (c *realContainerCLI) GetAllRelatedContainers(path string) ([]string, error) {
-	logger.Info("Procurando containers relacionados ao projeto")
-	tool := c.config.Load().Core.Tool
+// cleanPlan holds the dev container resources removed by `dev clean`.
+type cleanPlan struct {
+	containers []*engine.Container
+	volumes    []string
+	networks   []string
+	images     []*engine.Image
+}
+
+func (p *cleanPlan) resources() []container_utils.CleanedResource {
+	resources := []container_utils.CleanedResource{}
+	for _, container := range p.containers {
+		resources = append(resources, containerResource(container))
+	}
+	for _, volume := range p.volumes {
+		resources = append(resources, container_utils.CleanedResource{Type: container_utils.CleanVolume, ID: volume, Name: volume})
+	}
+	for _, network := range p.networks {
+		resources = append(resources, container_utils.CleanedResource{Type: container_utils.CleanNetwork, ID: network, Name: network})
+	}
+	for _, image := range p.images {
+		resources = append(resources, imageResource(image))
+	}
+
+	return resources
+}
+
+func containerResource(container *engine.Container) container_utils.CleanedResource {
+	return container_utils.CleanedResource{Type: container_utils.CleanContainer, ID: container.ID, Name: container.Names}
+}
+
+func imageResource(image *engine.Image) container_utils.CleanedResource {
+	return container_utils.CleanedResource{Type: container_utils.CleanImage, ID: image.ID, Name: image.Repository + ":" + image.Tag, Size: image.Size}
+}
+
+// planClean finds the stopped dev container workspaces and the volumes,
+// networks and images only they use.
+func (c *realContainerCLI) planClean(eng engine.Engine) (*cleanPlan, error) {
+	logger.Info("Buscando recursos de dev containers parados...")
 
-	pathsToTry := c.tryPaths(path, c.pather)
+	devcontainers, err := c.findDevcontainers(eng, true)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &cleanPlan{containers: container_utils.StoppedWorkspaceContainers(devcontainers)}
 
-	var mainIDs []string
-	for _, p := range pathsToTry {
-		ids, err := c.findMainContainersForPath(tool, p, c.executor)
+	removed := make(map[string]bool)
+	var projects []string
+	for _, container := range plan.containers {
+		removed[container.ID] = true
+
+		if container.ComposeProject != "" && !slices.Contains(projects, container.ComposeProject) {
+			projects = append(projects, container.ComposeProject)
+		}
+
+		details, err := eng.Inspect(container.ID)
 		if err != nil {
+			logger.Error("Não foi possível inspecionar o container %s.", container.ID)
 			return nil, err
 		}
 
-		if len(ids) > 0 {
-			mainIDs = ids
-			break
+		for _, volume := range details.Volumes {
+			if container_utils.IsAnonymousVolume(volume) && !slices.Contains(plan.volumes, volume) {
+				plan.volumes = append(plan.volumes, volume)
+			}
 		}
 	}
 
+	for _, project := range projects {
+		for _, label := range eng.ComposeProjectLabels() {
+			networks, err := eng.ListNetworks("label=" + label + "=" + project)
+			if err != nil {
+				logger.Error("Não foi possível listar as redes do projeto %s.", project)
+				return nil, err
+			}
+
+			for _, network := range networks {
+				if !slices.Contains(plan.networks, network) {
+					plan.networks = append(plan.networks, network)
+				}
+			}
+		}
+	}
+
+	all, err := eng.ListContainers(engine.ListOptions{All: true})
+	if err != nil {
+		logger.Error("Não foi possível listar os containers.")
+		return nil, err
+	}
+
+	var remaining []*engine.Container
+	for _, container := range all {
+		if !removed[container.ID] {
+			remaining = append(remaining, container)
+		}
+	}
+
+	images, err := eng.ListImages()
+	if err != nil {
+		logger.Error("Não foi possível listar as imagens.")
+		return nil, err
+	}
+
+	for _, image := range images {
+		if container_utils.IsDevcontainerImage(image) && !container_utils.ImageInUse(image, remaining) {
+			plan.images = append(plan.images, image)
+		}
+	}
+
+	return plan, nil
+}
+
+// applyClean removes the resources of plan, returning the removed ones.
+// Containers must be removed first, so a failure there stops the cleanup;
+// the other resources are skipped with a warning when in use.
+func (c *realContainerCLI) applyClean(eng engine.Engine, plan *cleanPlan) ([]container_utils.CleanedResource, error) {
+	removed := []container_utils.CleanedResource{}
+
+	if len(plan.containers) > 0 {
+		ids := make([]string, 0, len(plan.containers))
+		for _, container := range plan.containers {
+			ids = append(ids, container.ID)
+		}
+
+		if err := c.removeContainers(eng, ids); err != nil {
+			return nil, err
+		}
+
+		for _, container := range plan.containers {
+			removed = append(removed, containerResource(container))
+		}
+	}
+
+	for _, volume := range plan.volumes {
+		if err := eng.RemoveVolumes(volume); err != nil {
+			logger.Warn("Não foi possível remover o volume %s: %v", volume, err)
+			continue
+		}
+		removed = append(removed, container_utils.CleanedResource{Type: container_utils.CleanVolume, ID: volume, Name: volume})
+	}
+
+	for _, network := range plan.networks {
+		if err := eng.RemoveNetworks(network); err != nil {
+			logger.Warn("Não foi possível remover a rede %s: %v", network, err)
+			continue
+		}
+		removed = append(removed, container_utils.CleanedResource{Type: container_utils.CleanNetwork, ID: network, Name: network})
+	}
+
+	for _, image := range plan.images {
+		resource := imageResource(image)
+		if err := eng.RemoveImages(resource.Name); err != nil {
+			logger.Warn("Não foi possível remover a imagem %s: %v", resource.Name, err)
+			continue
+		}
+		removed = append(removed, resource)
+	}
+
+	return removed, nil
+}
+
+func (c *realContainerCLI) GetAllRelatedContainers(path string) ([]string, error) {
+	logger.Info("Procurando containers relacionados ao projeto")
+	eng, err := c.getEngine()
+	if err != nil {
+		return nil, err
+	}
+
+	mainIDs, err := c.findMainContainers(eng, path)
+	if err != nil {
+		return nil, err
+	}
+
 	if len(mainIDs) == 0 {
 		err := fmt.Errorf("Nenhum container contrado para o caminho: %s", path)
 		logger.Error(err.Error())
@@ -79,7 +416,7 @@ func (c *realContainerCLI) GetAllRelatedContainers(path string) ([]string, error
 	for _, id := range mainIDs {
 		allIDsMap[id] = true
 
-		project, err := c.extractProjectFromContainer(tool, id, c.executor)
+		project, err := c.extractProjectFromContainer(eng, id)
 		if err != nil {
 			return nil, err
 		}
@@ -88,7 +425,7 @@ func (c *realContainerCLI) GetAllRelatedContainers(path string) ([]string, error
 			continue
 		}
 
-		compIDs, err := c.findComposeContainersForProject(tool, project, c.executor)
+		compIDs, err := c.findComposeContainersForProject(eng, project)
 		if err != nil {
 			return nil, err
 		}
@@ -102,44 +439,188 @@ func (c *realContainerCLI) GetAllRelatedContainers(path string) ([]string, error
 	return finalIDs, nil
 }
 
-func (c *realContainerCLI) DownContainer(path string) error {
-	tool := c.config.Load().Core.Tool
+// findMainContainers returns the devcontainers of path, trying its real
+// path too.
+func (c *realContainerCLI) findMainContainers(eng engine.Engine, path string) ([]string, error) {
+	for _, p := range c.tryPaths(path, c.pather) {
+		ids, err := c.findMainContainersForPath(eng, p)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(ids) > 0 {
+			return ids, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (c *realContainerCLI) DownContainer(path string, opts DownOptions) error {
+	eng, err := c.getEngine()
+	if err != nil {
+		return err
+	}
+
 	ids, err := c.GetAllRelatedContainers(path)
 	if err != nil {
 		return err
 	}
 
+	if opts.DryRun {
+		affected, err := c.describeContainers(eng, ids, false)
+		if err != nil {
+			return err
+		}
+
+		logger.Info("Containers que seriam parados (nada foi alterado):")
+		return c.renderer.Render(affected, func() string {
+			return c.formatAffectedContainers(affected)
+		})
+	}
+
+	if err := c.stopContainers(eng, ids); err != nil {
+		return err
+	}
+
+	logger.Info("%d containers parados com sucesso.", len(ids))
+	return nil
+
+}
+
+// stopContainers stops the containers gracefully, stopping whole pods when
+// the engine groups them.
+func (c *realContainerCLI) stopContainers(eng engine.Engine, ids []string) error {
 	logger.Info("Parando graciosamente (stop) o(s) container(s):\n%s\n", strings.Join(ids, "\n"))
 
-	args := append([]string{"stop"}, ids...)
+	standalone, pods, err := c.groupContainersByPod(eng, ids)
+	if err != nil {
+		return err
+	}
+
+	if len(pods) > 0 {
+		logger.Verbose("Parando pod(s): %s", strings.Join(pods, ", "))
+		if err := eng.StopPods(pods...); err != nil {
+			logger.Error("Não foi possível parar os pods.")
+			return err
+		}
+	}
 
-	err = c.executor.Run(tool, args...)
+	if len(standalone) > 0 {
+		if err := eng.Stop(standalone...); err != nil {
+			logger.Error("Não foi possível parar os containers.")
+			return err
+		}
+	}
 
+	return nil
+}
+
+// StartContainer starts the stopped containers of the workspace, without
+// the full `devcontainer up` flow.
+func (c *realContainerCLI) StartContainer(path string) error {
+	eng, err := c.getEngine()
 	if err != nil {
-		logger.Error("Não foi possível parar os containers.")
 		return err
 	}
 
-	logger.Info("%d containers parados com sucesso.", len(ids))
+	containers, err := c.listRelatedContainers(eng, path)
+	if err != nil {
+		return err
+	}
+
+	if err := c.startContainers(eng, containers); err != nil {
+		return err
+	}
+
+	logger.Success("%d containers iniciados com sucesso.", len(containers))
 	return nil
+}
+
+// RestartContainer stops the containers of the workspace and starts them
+// again in dependency order.
+func (c *realContainerCLI) RestartContainer(path string) error {
+	eng, err := c.getEngine()
+	if err != nil {
+		return err
+	}
+
+	containers, err := c.listRelatedContainers(eng, path)
+	if err != nil {
+		return err
+	}
+
+	ids := make([]string, 0, len(containers))
+	for _, container := range containers {
+		ids = append(ids, container.ID)
+	}
+
+	if err := c.stopContainers(eng, ids); err != nil {
+		return err
+	}
+
+	if err := c.startContainers(eng, containers); err != nil {
+		return err
+	}
 
+	logger.Success("%d containers reiniciados com sucesso.", len(containers))
+	return nil
 }
 
-func (c *realContainerCLI) KillContainer(path string) error {
+// startContainers starts the containers in the batches of startOrder, so
+// each service starts after the ones it depends on.
+func (c *realContainerCLI) startContainers(eng engine.Engine, containers []*engine.Container) error {
+	for _, batch := range c.startOrder(containers) {
+		ids := make([]string, 0, len(batch))
+		names := make([]string, 0, len(batch))
+		for _, container := range batch {
+			ids = append(ids, container.ID)
+			names = append(names, container_utils.ServiceName(container))
+		}
+
+		logger.Info("Iniciando: %s", strings.Join(names, ", "))
+		if err := eng.Start(ids...); err != nil {
+			logger.Error("Não foi possível iniciar os containers.")
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *realContainerCLI) KillContainer(path string, opts KillOptions) error {
+	eng, err := c.getEngine()
+	if err != nil {
+		return err
+	}
 
-	tool := c.config.Load().Core.Tool
 	ids, err := c.GetAllRelatedContainers(path)
 	if err != nil {
 		return err
 	}
 
-	logger.Info("Forçando a parada e excluindo (rm -f) o(s) container(s):\n%s", strings.Join(ids, "\n"))
-	args := append([]string{"rm", "-f"}, ids...)
+	if opts.DryRun || !opts.Yes {
+		affected, err := c.describeContainers(eng, ids, true)
+		if err != nil {
+			return err
+		}
 
-	err = c.executor.Run(tool, args...)
+		if opts.DryRun {
+			logger.Info("Containers que seriam removidos (nada foi alterado):")
+			return c.renderer.Render(affected, func() string {
+				return c.formatAffectedContainers(affected)
+			})
+		}
 
-	if err != nil {
-		logger.Error("Não foi possível forçar a parada e remoção dos containers.")
+		logger.Info("Os seguintes containers serão removidos (rm -f). Os volumes são mantidos:\n%s", c.formatAffectedContainers(affected))
+
+		confirmed, err := c.confirmAction("Remover os containers listados?")
+		if err != nil || !confirmed {
+			return err
+		}
+	}
+
+	if err := c.removeContainers(eng, ids); err != nil {
 		return err
 	}
 
@@ -148,67 +629,626 @@ func (c *realContainerCLI) KillContainer(path string) error {
 	return nil
 }
 
-func (c *realContainerCLI) ShowLogs(path string, follow bool) error {
-	tool := c.config.Load().Core.Tool
-	filter := fmt.Sprintf("label=devcontainer.local_folder=%s", path)
+// describeContainers lists the containers with the given IDs, with their
+// attached volumes when withVolumes is set.
+func (c *realContainerCLI) describeContainers(eng engine.Engine, ids []string, withVolumes bool) ([]container_utils.AffectedContainer, error) {
+	filters := make([]string, 0, len(ids))
+	for _, id := range ids {
+		filters = append(filters, "id="+id)
+	}
+
+	containers, err := eng.ListContainers(engine.ListOptions{All: true, Filters: filters})
+	if err != nil {
+		logger.Error("Não foi possível obter os containers do workspace.")
+		return nil, err
+	}
+
+	affected := make([]container_utils.AffectedContainer, 0, len(containers))
+	for _, container := range containers {
+		name, _, _ := strings.Cut(container.Names, ",")
+		description := container_utils.AffectedContainer{
+			ID:      container.ID,
+			Name:    name,
+			Service: container_utils.ServiceName(container),
+			State:   container.State,
+			Volumes: []string{},
+		}
+
+		if withVolumes {
+			details, err := eng.Inspect(container.ID)
+			if err != nil {
+				logger.Error("Não foi possível obter os volumes do container %s.", container.ID)
+				return nil, err
+			}
+			description.Volumes = append(description.Volumes, details.Volumes...)
+		}
+
+		affected = append(affected, description)
+	}
+
+	return affected, nil
+}
+
+// confirmAction asks whether to go on with a destructive action. Without
+// a terminal nobody can answer, so it fails and points to --yes instead of
+// waiting on the prompt.
+func (c *realContainerCLI) confirmAction(question string) (bool, error) {
+	if !c.isTerminal() {
+		logger.Error("A entrada não é um terminal para confirmar a operação. Use --yes para continuar sem confirmação.")
+		return false, errors.New("confirmação necessária: use --yes quando a entrada não for um terminal")
+	}
+
+	confirmed, err := c.confirm(question)
+	if err != nil {
+		logger.Error("Não foi possível ler a confirmação.")
+		return false, err
+	}
+
+	if !confirmed {
+		logger.Info("Operação cancelada.")
+	}
+
+	return confirmed, nil
+}
+
+// RemoveContainers removes the containers of the workspace before a
+// rebuild. Named volumes are kept unless opts.Volumes is set, in which case
+// the containers and their volumes are listed and confirmed first, since
+// the data is lost even if the rebuild fails. A workspace without
+// containers has nothing to remove.
+func (c *realContainerCLI) RemoveContainers(path string, opts RemoveOptions) error {
+	eng, err := c.getEngine()
+	if err != nil {
+		return err
+	}
 
-	getIdArgs := []string{"ps", "-q", "--filter", filter}
-	out, err := c.executor.Output(tool, getIdArgs...)
+	mainIDs, err := c.findMainContainers(eng, path)
 	if err != nil {
-		logger.Error("Não foi possível obter os containers para mostrar os logs.")
 		return err
 	}
 
-	id := strings.TrimSpace(string(out))
+	if len(mainIDs) == 0 {
+		logger.Info("Nenhum container existente para remover.")
+		return nil
+	}
 
-	if id == "" {
-		logger.Error("Nenhum container encontrado para o caminho especificado.")
-		return fmt.Errorf("nenhum container encontrado para o caminho: %s", path)
+	ids, err := c.GetAllRelatedContainers(path)
+	if err != nil {
+		return err
+	}
+
+	var volumes []string
+	if opts.Volumes {
+		affected, err := c.describeContainers(eng, ids, true)
+		if err != nil {
+			return err
+		}
+
+		for _, container := range affected {
+			for _, volume := range container.Volumes {
+				if !slices.Contains(volumes, volume) {
+					volumes = append(volumes, volume)
+				}
+			}
+		}
+
+		if !opts.Yes {
+			logger.Warn("Os seguintes containers e seus volumes serão removidos. Os dados dos volumes são perdidos mesmo se a reconstrução falhar:\n%s", c.formatAffectedContainers(affected))
+
+			// A cancel must also stop the `devcontainer up` that follows.
+			confirmed, err := c.confirmAction("Remover os containers e volumes listados?")
+			if err != nil {
+				return err
+			}
+			if !confirmed {
+				return errors.New("reconstrução cancelada")
+			}
+		}
+	}
+
+	if err := c.removeContainers(eng, ids); err != nil {
+		return err
+	}
+
+	if len(volumes) > 0 {
+		logger.Info("Removendo o(s) volume(s):\n%s", strings.Join(volumes, "\n"))
+		if err := eng.RemoveVolumes(volumes...); err != nil {
+			logger.Error("Não foi possível remover os volumes.")
+			return err
+		}
+	}
+
+	logger.Info("%d containers removidos com sucesso.", len(ids))
+	return nil
+}
+
+// removeContainers forcefully removes the containers, removing whole pods
+// when the engine groups them.
+func (c *realContainerCLI) removeContainers(eng engine.Engine, ids []string) error {
+	logger.Info("Forçando a parada e excluindo (rm -f) o(s) container(s):\n%s", strings.Join(ids, "\n"))
+
+	standalone, pods, err := c.groupContainersByPod(eng, ids)
+	if err != nil {
+		return err
 	}
 
-	logger.Info("Logs do container %s:", id)
+	if len(pods) > 0 {
+		logger.Verbose("Removendo pod(s): %s", strings.Join(pods, ", "))
+		if err := eng.RemovePods(pods...); err != nil {
+			logger.Error("Não foi possível forçar a remoção dos pods.")
+			return err
+		}
+	}
 
-	args := []string{"logs"}
-	if follow {
-		args = append(args, "-f")
+	if len(standalone) > 0 {
+		if err := eng.Remove(standalone...); err != nil {
+			logger.Error("Não foi possível forçar a parada e remoção dos containers.")
+			return err
+		}
 	}
-	args = append(args, id)
 
-	err = c.executor.Run(tool, args...)
+	return nil
+}
 
+// ShowLogs streams the logs of the devcontainer and its compose services
+// concurrently. With more than one container, table output prefixes each
+// line with its colored service name. Ctrl+C ends the logs cleanly.
+func (c *realContainerCLI) ShowLogs(path string, opts LogsOptions) error {
+	eng, err := c.getEngine()
+	if err != nil {
+		return err
+	}
+
+	var grep *regexp.Regexp
+	if opts.Grep != "" {
+		grep, err = regexp.Compile(opts.Grep)
+		if err != nil {
+			logger.Error("Expressão inválida em --grep.")
+			return fmt.Errorf("expressão inválida em --grep: %w", err)
+		}
+	}
+
+	listed, err := c.listRelatedContainers(eng, path)
 	if err != nil {
-		logger.Error("Não foi possível mostrar os logs do container.")
 		return err
 	}
 
+	containers, err := container_utils.FilterServices(listed, opts.Services)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	tail := opts.Tail
+	if tail <= 0 {
+		cfg, err := c.config.Load()
+		if err != nil {
+			return err
+		}
+		tail = cfg.Logs.Tail
+	}
+
+	var prefixes map[string]string
+	if c.renderer.Format() == output.FormatTable && len(containers) > 1 {
+		prefixes = container_utils.LogPrefixes(containers)
+	}
+
+	names := make([]string, 0, len(containers))
+	for _, container := range containers {
+		names = append(names, container_utils.ServiceName(container))
+	}
+	logger.Info("Logs de: %s", strings.Join(names, ", "))
+
+	mux := container_utils.NewLogMultiplexer(grep)
+	errs := make(chan error, len(containers))
+
+	for _, container := range containers {
+		service := container_utils.ServiceName(container)
+		dst := c.renderer.LineWriter(func(line string) any {
+			return container_utils.LogLine{Container: container.ID, Service: service, Line: line}
+		})
+		// The engine replays stderr of the container on its own stderr. A
+		// stream each keeps partial lines of the two apart.
+		stream := mux.Stream(dst, prefixes[container.ID])
+		errStream := mux.Stream(dst, prefixes[container.ID])
+
+		go func() {
+			err := eng.Logs(container.ID, engine.LogsOptions{
+				Follow:     opts.Follow,
+				Tail:       tail,
+				Since:      opts.Since,
+				Timestamps: opts.Timestamps,
+				Output:     stream,
+				ErrOutput:  errStream,
+			})
+			stream.Close()
+			errStream.Close()
+
+			if err != nil {
+				err = fmt.Errorf("logs de %s: %w", service, err)
+			}
+			errs <- err
+		}()
+	}
+
+	interrupts := make(chan os.Signal, 1)
+	c.notifyInterrupt(interrupts, os.Interrupt)
+	defer signal.Stop(interrupts)
+
+	var failures []error
+	for range containers {
+		select {
+		case err := <-errs:
+			if err != nil {
+				failures = append(failures, err)
+			}
+		case <-interrupts:
+			mux.Close()
+			return nil
+		}
+	}
+
+	// Ctrl+C also ends the engine processes, which then fail.
+	select {
+	case <-interrupts:
+		return nil
+	default:
+	}
+
+	if len(failures) > 0 {
+		logger.Error("Não foi possível mostrar os logs do container.")
+		return errors.Join(failures...)
+	}
+
 	return nil
 }
 
+// ListPorts lists the ports published by the containers of the workspace
+// and checks the ones declared in devcontainer.json.
 func (c *realContainerCLI) ListPorts(path string) error {
-	tool := c.config.Load().Core.Tool
+	eng, err := c.getEngine()
+	if err != nil {
+		return err
+	}
 
-	filter := fmt.Sprintf("label=devcontainer.local_folder=%s", path)
-	out, err := c.executor.Output(tool, "ps", "-q", "--filter", filter)
+	containers, err := c.listRelatedContainers(eng, path)
+	if err != nil {
+		return err
+	}
 
+	containers, err = container_utils.FilterServices(containers, nil)
 	if err != nil {
-		logger.Error("Não foi possível obter os containers para listar as portas.")
 		return err
 	}
 
-	id := strings.TrimSpace(string(out))
-	if id == "" {
-		logger.Error("Nenhum container encontrado para o caminho especificado.")
-		return fmt.Errorf("nenhum container encontrado para o caminho: %s", path)
+	mappings := []container_utils.PortMapping{}
+	for _, container := range containers {
+		if container.State != "running" {
+			continue
+		}
+
+		out, err := eng.Ports(container.ID)
+		if err != nil {
+			logger.Error("Não foi possível obter as portas mapeadas do container %s.", container_utils.ServiceName(container))
+			return err
+		}
+
+		for _, mapping := range c.parsePorts(out) {
+			mapping.Service = container_utils.ServiceName(container)
+			mapping.Container = container.ID
+			mapping.Status = container_utils.PortPublished
+			mappings = append(mappings, mapping)
+		}
+	}
+
+	if c.devcontainer != nil {
+		config, err := c.devcontainer.ReadConfiguration(path)
+		if err != nil {
+			logger.Warn("Não foi possível ler o devcontainer.json, as portas declaradas não serão verificadas.")
+			logger.Verbose(err.Error())
+		} else {
+			mappings = c.checkDeclaredPorts(mappings, config.DeclaredPorts(), container_utils.ServiceName(containers[0]), c.probePort)
+		}
+	}
+
+	logger.Info("Portas do workspace %s:", path)
+
+	return c.renderer.Render(mappings, func() string {
+		return c.formatPorts(mappings)
+	})
+}
+
+// ForwardPorts tunnels host ports into the devcontainer until Ctrl+C. Each
+// connection runs a relay in the container through the engine, so the
+// ports do not need to be published.
+func (c *realContainerCLI) ForwardPorts(path string, forwards []container_utils.PortForward) error {
+	eng, err := c.getEngine()
+	if err != nil {
+		return err
 	}
 
-	out, err = c.executor.Output(tool, "port", id)
+	containers, err := c.listRelatedContainers(eng, path)
+	if err != nil {
+		return err
+	}
 
+	containers, err = container_utils.FilterServices(containers, nil)
 	if err != nil {
-		logger.Error("Não foi possível obter as portas mapeadas do container.")
 		return err
 	}
 
-	logger.Info("Portas mapeadas para o container %s:\n%s", id, string(out))
+	devcontainer := containers[0]
+	if devcontainer.State != "running" {
+		logger.Error("O devcontainer não está em execução. Rode `dev up` antes de encaminhar portas.")
+		return fmt.Errorf("o devcontainer não está em execução: %s", path)
+	}
+
+	listeners := make([]net.Listener, 0, len(forwards))
+	defer func() {
+		for _, listener := range listeners {
+			listener.Close()
+		}
+	}()
+
+	active := make([]container_utils.PortForward, 0, len(forwards))
+	for _, forward := range forwards {
+		listener, err := c.listen("tcp", net.JoinHostPort(forward.HostIP, strconv.Itoa(forward.HostPort)))
+		if err != nil {
+			logger.Error("Não foi possível escutar na porta %d do host.", forward.HostPort)
+			return err
+		}
+		listeners = append(listeners, listener)
+
+		if addr, ok := listener.Addr().(*net.TCPAddr); ok {
+			forward.HostPort = addr.Port
+		}
+		active = append(active, forward)
+	}
+
+	logger.Info("Encaminhando portas para %s:", container_utils.ServiceName(devcontainer))
+	if err := c.renderer.Render(active, func() string {
+		return c.formatForwards(active)
+	}); err != nil {
+		return err
+	}
+	logger.Info("Pressione Ctrl+C para encerrar os encaminhamentos.")
+
+	interrupts := make(chan os.Signal, 1)
+	c.notifyInterrupt(interrupts, os.Interrupt)
+	defer signal.Stop(interrupts)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for i, listener := range listeners {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c.acceptForwards(ctx, &wg, eng, devcontainer.ID, active[i], listener)
+		}()
+	}
+
+	<-interrupts
+
+	// Closing the listeners ends the accept loops, and the context kills
+	// the relays of open connections.
+	cancel()
+	for _, listener := range listeners {
+		listener.Close()
+	}
+	wg.Wait()
 
+	logger.Success("Encaminhamentos encerrados.")
 	return nil
 }
+
+// acceptForwards relays each connection of listener to the container port
+// of forward, until listener is closed.
+func (c *realContainerCLI) acceptForwards(ctx context.Context, wg *sync.WaitGroup, eng engine.Engine, id string, forward container_utils.PortForward, listener net.Listener) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() == nil {
+				logger.Error("O encaminhamento da porta %d foi interrompido: %v", forward.HostPort, err)
+			}
+			return
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer conn.Close()
+
+			logger.Verbose("Conexão de %s na porta %d", conn.RemoteAddr(), forward.HostPort)
+
+			err := eng.Exec(ctx, id, engine.ExecOptions{
+				Command: container_utils.RelayCommand(forward.ContainerPort),
+				Stdin:   conn,
+				Stdout:  conn,
+			})
+			if err != nil && ctx.Err() == nil {
+				logger.Warn("Não foi possível conectar à porta %d do container: %v", forward.ContainerPort, err)
+			}
+		}()
+	}
+}
+
+// listRelatedContainers lists the devcontainer of path and its compose
+// services, stopped ones included.
+func (c *realContainerCLI) listRelatedContainers(eng engine.Engine, path string) ([]*engine.Container, error) {
+	ids, err := c.GetAllRelatedContainers(path)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := make([]string, 0, len(ids))
+	for _, id := range ids {
+		filters = append(filters, "id="+id)
+	}
+
+	containers, err := eng.ListContainers(engine.ListOptions{All: true, Filters: filters})
+	if err != nil {
+		logger.Error("Não foi possível obter os containers do workspace.")
+		return nil, err
+	}
+
+	if len(containers) == 0 {
+		logger.Error("Nenhum container encontrado para o caminho especificado.")
+		return nil, fmt.Errorf("nenhum container encontrado para o caminho: %s", path)
+	}
+
+	return containers, nil
+}
+
+// ShowStats shows the resource usage of the containers of the workspace and
+// their total.
+func (c *realContainerCLI) ShowStats(path string) error {
+	eng, err := c.getEngine()
+	if err != nil {
+		return err
+	}
+
+	containers, err := c.listRelatedContainers(eng, path)
+	if err != nil {
+		return err
+	}
+
+	stats, err := c.sampleStats(eng, containers)
+	if err != nil {
+		return err
+	}
+
+	if len(stats) == 0 {
+		logger.Error("Nenhum container em execução para o caminho especificado.")
+		return fmt.Errorf("nenhum container em execução para o caminho: %s", path)
+	}
+
+	workspace := container_utils.AggregateStats(path, stats)
+
+	return c.renderer.Render(workspace, func() string {
+		return c.formatContainerStats(workspace)
+	})
+}
+
+// WaitContainers blocks until every container of the workspace is running
+// and, when it has a healthcheck, healthy. A status line is logged each
+// time a service changes, and the services still pending are reported
+// when the timeout expires. A container that exited with an error, died or
+// became unhealthy fails the wait right away instead of running out the
+// timeout.
+func (c *realContainerCLI) WaitContainers(path string, opts WaitOptions) error {
+	eng, err := c.getEngine()
+	if err != nil {
+		return err
+	}
+
+	containers, err := c.listRelatedContainers(eng, path)
+	if err != nil {
+		return err
+	}
+
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = time.Second
+	}
+
+	var deadline <-chan time.Time
+	if opts.Timeout > 0 {
+		timer := time.NewTimer(opts.Timeout)
+		defer timer.Stop()
+		deadline = timer.C
+	}
+
+	logger.Info("Aguardando %d containers ficarem prontos", len(containers))
+
+	reported := make(map[string]string, len(containers))
+	for {
+		statuses, err := c.serviceStatuses(eng, containers)
+		if err != nil {
+			return err
+		}
+
+		pending := make([]string, 0, len(statuses))
+		var failed []string
+		for _, status := range statuses {
+			line := container_utils.DescribeServiceStatus(status)
+			if reported[status.Container] != line {
+				logger.Info(line)
+				reported[status.Container] = line
+			}
+
+			if container_utils.IsFailed(status.State, status.Health, status.ExitCode) {
+				failed = append(failed, line)
+			} else if !status.Ready {
+				pending = append(pending, status.Service)
+			}
+		}
+
+		render := func() error {
+			return c.renderer.Render(statuses, func() string {
+				return c.formatServiceStatus(statuses)
+			})
+		}
+
+		if len(failed) > 0 {
+			if err := render(); err != nil {
+				return err
+			}
+
+			logger.Error("Serviços com falha: %s. Veja os logs com dev logs", strings.Join(failed, ", "))
+			return fmt.Errorf("serviços falharam: %s", strings.Join(failed, ", "))
+		}
+
+		if len(pending) == 0 {
+			if err := render(); err != nil {
+				return err
+			}
+
+			logger.Success("%d containers prontos.", len(statuses))
+			return nil
+		}
+
+		select {
+		case <-deadline:
+			if err := render(); err != nil {
+				return err
+			}
+
+			logger.Error("Tempo esgotado aguardando: %s", strings.Join(pending, ", "))
+			return fmt.Errorf("serviços não ficaram prontos em %s: %s", opts.Timeout, strings.Join(pending, ", "))
+		case <-time.After(interval):
+		}
+	}
+}
+
+// serviceStatuses inspects the containers for their state and health.
+// Compose services that exited with code 0 are one-shot jobs that are
+// done; the devcontainer itself must keep running.
+func (c *realContainerCLI) serviceStatuses(eng engine.Engine, containers []*engine.Container) ([]container_utils.ServiceStatus, error) {
+	statuses := make([]container_utils.ServiceStatus, 0, len(containers))
+	for _, container := range containers {
+		details, err := eng.Inspect(container.ID)
+		if err != nil {
+			logger.Error("Não foi possível obter o estado do container %s.", container.ID)
+			return nil, err
+		}
+
+		ready := container_utils.IsReady(details.State, details.Health)
+		if container.LocalFolder == "" && container_utils.IsDone(details.State, details.ExitCode) {
+			ready = true
+		}
+
+		name, _, _ := strings.Cut(container.Names, ",")
+		statuses = append(statuses, container_utils.ServiceStatus{
+			Service:   container_utils.ServiceName(container),
+			Container: name,
+			State:     details.State,
+			Health:    details.Health,
+			ExitCode:  details.ExitCode,
+			Ready:     ready,
+		})
+	}
+
+	return statuses, nil
+}
@@ -5,6 +5,8 @@
 package container
 
 import (
+	"github.com/Brennon-Oliveira/dev-cli/internal/container/container_utils"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -35,17 +37,74 @@ func (_m *MockContainerCLI) EXPECT() *MockContainerCLI_Expecter {
 	return &MockContainerCLI_Expecter{mock: &_m.Mock}
 }
 
+// BackupVolumes provides a mock function for the type MockContainerCLI
+func (_mock *MockContainerCLI) BackupVolumes(path string, dir string) error {
+	ret := _mock.Called(path, dir)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BackupVolumes")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = returnFunc(path, dir)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockContainerCLI_BackupVolumes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BackupVolumes'
+type MockContainerCLI_BackupVolumes_Call struct {
+	*mock.Call
+}
+
+// BackupVolumes is a helper method to define mock.On call
+//   - path string
+//   - dir string
+func (_e *MockContainerCLI_Expecter) BackupVolumes(path interface{}, dir interface{}) *MockContainerCLI_BackupVolumes_Call {
+	return &MockContainerCLI_BackupVolumes_Call{Call: _e.mock.On("BackupVolumes", path, dir)}
+}
+
+func (_c *MockContainerCLI_BackupVolumes_Call) Run(run func(path string, dir string)) *MockContainerCLI_BackupVolumes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockContainerCLI_BackupVolumes_Call) Return(err error) *MockContainerCLI_BackupVolumes_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockContainerCLI_BackupVolumes_Call) RunAndReturn(run func(path string, dir string) error) *MockContainerCLI_BackupVolumes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CleanResources provides a mock function for the type MockContainerCLI
-func (_mock *MockContainerCLI) CleanResources() error {
-	ret := _mock.Called()
+func (_mock *MockContainerCLI) CleanResources(opts CleanOptions) error {
+	ret := _mock.Called(opts)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CleanResources")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func() error); ok {
-		r0 = returnFunc()
+	if returnFunc, ok := ret.Get(0).(func(CleanOptions) error); ok {
+		r0 = returnFunc(opts)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -58,13 +117,20 @@ type MockContainerCLI_CleanResources_Call struct {
 }
 
 // CleanResources is a helper method to define mock.On call
-func (_e *MockContainerCLI_Expecter) CleanResources() *MockContainerCLI_CleanResources_Call {
-	return &MockContainerCLI_CleanResources_Call{Call: _e.mock.On("CleanResources")}
+//   - opts CleanOptions
+func (_e *MockContainerCLI_Expecter) CleanResources(opts interface{}) *MockContainerCLI_CleanResources_Call {
+	return &MockContainerCLI_CleanResources_Call{Call: _e.mock.On("CleanResources", opts)}
 }
 
-func (_c *MockContainerCLI_CleanResources_Call) Run(run func()) *MockContainerCLI_CleanResources_Call {
+func (_c *MockContainerCLI_CleanResources_Call) Run(run func(opts CleanOptions)) *MockContainerCLI_CleanResources_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		var arg0 CleanOptions
+		if args[0] != nil {
+			arg0 = args[0].(CleanOptions)
+		}
+		run(
+			arg0,
+		)
 	})
 	return _c
 }
@@ -74,22 +140,22 @@ func (_c *MockContainerCLI_CleanResources_Call) Return(err error) *MockContainer
 	return _c
 }
 
-func (_c *MockContainerCLI_CleanResources_Call) RunAndReturn(run func() error) *MockContainerCLI_CleanResources_Call {
+func (_c *MockContainerCLI_CleanResources_Call) RunAndReturn(run func(opts CleanOptions) error) *MockContainerCLI_CleanResources_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // DownContainer provides a mock function for the type MockContainerCLI
-func (_mock *MockContainerCLI) DownContainer(path string) error {
-	ret := _mock.Called(path)
+func (_mock *MockContainerCLI) DownContainer(path string, opts DownOptions) error {
+	ret := _mock.Called(path, opts)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DownContainer")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
-		r0 = returnFunc(path)
+	if returnFunc, ok := ret.Get(0).(func(string, DownOptions) error); ok {
+		r0 = returnFunc(path, opts)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -103,18 +169,24 @@ type MockContainerCLI_DownContainer_Call struct {
 
 // DownContainer is a helper method to define mock.On call
 //   - path string
-func (_e *MockContainerCLI_Expecter) DownContainer(path interface{}) *MockContainerCLI_DownContainer_Call {
-	return &MockContainerCLI_DownContainer_Call{Call: _e.mock.On("DownContainer", path)}
+//   - opts DownOptions
+func (_e *MockContainerCLI_Expecter) DownContainer(path interface{}, opts interface{}) *MockContainerCLI_DownContainer_Call {
+	return &MockContainerCLI_DownContainer_Call{Call: _e.mock.On("DownContainer", path, opts)}
 }
 
-func (_c *MockContainerCLI_DownContainer_Call) Run(run func(path string)) *MockContainerCLI_DownContainer_Call {
+func (_c *MockContainerCLI_DownContainer_Call) Run(run func(path string, opts DownOptions)) *MockContainerCLI_DownContainer_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 string
 		if args[0] != nil {
 			arg0 = args[0].(string)
 		}
+		var arg1 DownOptions
+		if args[1] != nil {
+			arg1 = args[1].(DownOptions)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -125,7 +197,64 @@ func (_c *MockContainerCLI_DownContainer_Call) Return(err error) *MockContainerC
 	return _c
 }
 
-func (_c *MockContainerCLI_DownContainer_Call) RunAndReturn(run func(path string) error) *MockContainerCLI_DownContainer_Call {
+func (_c *MockContainerCLI_DownContainer_Call) RunAndReturn(run func(path string, opts DownOptions) error) *MockContainerCLI_DownContainer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ForwardPorts provides a mock function for the type MockContainerCLI
+func (_mock *MockContainerCLI) ForwardPorts(path string, forwards []container_utils.PortForward) error {
+	ret := _mock.Called(path, forwards)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ForwardPorts")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, []container_utils.PortForward) error); ok {
+		r0 = returnFunc(path, forwards)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockContainerCLI_ForwardPorts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForwardPorts'
+type MockContainerCLI_ForwardPorts_Call struct {
+	*mock.Call
+}
+
+// ForwardPorts is a helper method to define mock.On call
+//   - path string
+//   - forwards []container_utils.PortForward
+func (_e *MockContainerCLI_Expecter) ForwardPorts(path interface{}, forwards interface{}) *MockContainerCLI_ForwardPorts_Call {
+	return &MockContainerCLI_ForwardPorts_Call{Call: _e.mock.On("ForwardPorts", path, forwards)}
+}
+
+func (_c *MockContainerCLI_ForwardPorts_Call) Run(run func(path string, forwards []container_utils.PortForward)) *MockContainerCLI_ForwardPorts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 []container_utils.PortForward
+		if args[1] != nil {
+			arg1 = args[1].([]container_utils.PortForward)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockContainerCLI_ForwardPorts_Call) Return(err error) *MockContainerCLI_ForwardPorts_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockContainerCLI_ForwardPorts_Call) RunAndReturn(run func(path string, forwards []container_utils.PortForward) error) *MockContainerCLI_ForwardPorts_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -192,17 +321,79 @@ func (_c *MockContainerCLI_GetAllRelatedContainers_Call) RunAndReturn(run func(p
 	return _c
 }
 
+// GetWorkspaces provides a mock function for the type MockContainerCLI
+func (_mock *MockContainerCLI) GetWorkspaces(all bool) ([]container_utils.Workspace, error) {
+	ret := _mock.Called(all)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkspaces")
+	}
+
+	var r0 []container_utils.Workspace
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(bool) ([]container_utils.Workspace, error)); ok {
+		return returnFunc(all)
+	}
+	if returnFunc, ok := ret.Get(0).(func(bool) []container_utils.Workspace); ok {
+		r0 = returnFunc(all)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]container_utils.Workspace)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(bool) error); ok {
+		r1 = returnFunc(all)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockContainerCLI_GetWorkspaces_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaces'
+type MockContainerCLI_GetWorkspaces_Call struct {
+	*mock.Call
+}
+
+// GetWorkspaces is a helper method to define mock.On call
+//   - all bool
+func (_e *MockContainerCLI_Expecter) GetWorkspaces(all interface{}) *MockContainerCLI_GetWorkspaces_Call {
+	return &MockContainerCLI_GetWorkspaces_Call{Call: _e.mock.On("GetWorkspaces", all)}
+}
+
+func (_c *MockContainerCLI_GetWorkspaces_Call) Run(run func(all bool)) *MockContainerCLI_GetWorkspaces_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 bool
+		if args[0] != nil {
+			arg0 = args[0].(bool)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockContainerCLI_GetWorkspaces_Call) Return(workspaces []container_utils.Workspace, err error) *MockContainerCLI_GetWorkspaces_Call {
+	_c.Call.Return(workspaces, err)
+	return _c
+}
+
+func (_c *MockContainerCLI_GetWorkspaces_Call) RunAndReturn(run func(all bool) ([]container_utils.Workspace, error)) *MockContainerCLI_GetWorkspaces_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // KillContainer provides a mock function for the type MockContainerCLI
-func (_mock *MockContainerCLI) KillContainer(path string) error {
-	ret := _mock.Called(path)
+func (_mock *MockContainerCLI) KillContainer(path string, opts KillOptions) error {
+	ret := _mock.Called(path, opts)
 
 	if len(ret) == 0 {
 		panic("no return value specified for KillContainer")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
-		r0 = returnFunc(path)
+	if returnFunc, ok := ret.Get(0).(func(string, KillOptions) error); ok {
+		r0 = returnFunc(path, opts)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -216,18 +407,24 @@ type MockContainerCLI_KillContainer_Call struct {
 
 // KillContainer is a helper method to define mock.On call
 //   - path string
-func (_e *MockContainerCLI_Expecter) KillContainer(path interface{}) *MockContainerCLI_KillContainer_Call {
-	return &MockContainerCLI_KillContainer_Call{Call: _e.mock.On("KillContainer", path)}
+//   - opts KillOptions
+func (_e *MockContainerCLI_Expecter) KillContainer(path interface{}, opts interface{}) *MockContainerCLI_KillContainer_Call {
+	return &MockContainerCLI_KillContainer_Call{Call: _e.mock.On("KillContainer", path, opts)}
 }
 
-func (_c *MockContainerCLI_KillContainer_Call) Run(run func(path string)) *MockContainerCLI_KillContainer_Call {
+func (_c *MockContainerCLI_KillContainer_Call) Run(run func(path string, opts KillOptions)) *MockContainerCLI_KillContainer_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 string
 		if args[0] != nil {
 			arg0 = args[0].(string)
 		}
+		var arg1 KillOptions
+		if args[1] != nil {
+			arg1 = args[1].(KillOptions)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -238,51 +435,109 @@ func (_c *MockContainerCLI_KillContainer_Call) Return(err error) *MockContainerC
 	return _c
 }
 
-func (_c *MockContainerCLI_KillContainer_Call) RunAndReturn(run func(path string) error) *MockContainerCLI_KillContainer_Call {
+func (_c *MockContainerCLI_KillContainer_Call) RunAndReturn(run func(path string, opts KillOptions) error) *MockContainerCLI_KillContainer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDevcontainerStats provides a mock function for the type MockContainerCLI
+func (_mock *MockContainerCLI) ListDevcontainerStats(all bool) error {
+	ret := _mock.Called(all)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDevcontainerStats")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(bool) error); ok {
+		r0 = returnFunc(all)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockContainerCLI_ListDevcontainerStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDevcontainerStats'
+type MockContainerCLI_ListDevcontainerStats_Call struct {
+	*mock.Call
+}
+
+// ListDevcontainerStats is a helper method to define mock.On call
+//   - all bool
+func (_e *MockContainerCLI_Expecter) ListDevcontainerStats(all interface{}) *MockContainerCLI_ListDevcontainerStats_Call {
+	return &MockContainerCLI_ListDevcontainerStats_Call{Call: _e.mock.On("ListDevcontainerStats", all)}
+}
+
+func (_c *MockContainerCLI_ListDevcontainerStats_Call) Run(run func(all bool)) *MockContainerCLI_ListDevcontainerStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 bool
+		if args[0] != nil {
+			arg0 = args[0].(bool)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockContainerCLI_ListDevcontainerStats_Call) Return(err error) *MockContainerCLI_ListDevcontainerStats_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockContainerCLI_ListDevcontainerStats_Call) RunAndReturn(run func(all bool) error) *MockContainerCLI_ListDevcontainerStats_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListContainersOfActiveDevcontainers provides a mock function for the type MockContainerCLI
-func (_mock *MockContainerCLI) ListContainersOfActiveDevcontainers() error {
-	ret := _mock.Called()
+// ListDevcontainers provides a mock function for the type MockContainerCLI
+func (_mock *MockContainerCLI) ListDevcontainers(all bool) error {
+	ret := _mock.Called(all)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListContainersOfActiveDevcontainers")
+		panic("no return value specified for ListDevcontainers")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func() error); ok {
-		r0 = returnFunc()
+	if returnFunc, ok := ret.Get(0).(func(bool) error); ok {
+		r0 = returnFunc(all)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockContainerCLI_ListContainersOfActiveDevcontainers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListContainersOfActiveDevcontainers'
-type MockContainerCLI_ListContainersOfActiveDevcontainers_Call struct {
+// MockContainerCLI_ListDevcontainers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDevcontainers'
+type MockContainerCLI_ListDevcontainers_Call struct {
 	*mock.Call
 }
 
-// ListContainersOfActiveDevcontainers is a helper method to define mock.On call
-func (_e *MockContainerCLI_Expecter) ListContainersOfActiveDevcontainers() *MockContainerCLI_ListContainersOfActiveDevcontainers_Call {
-	return &MockContainerCLI_ListContainersOfActiveDevcontainers_Call{Call: _e.mock.On("ListContainersOfActiveDevcontainers")}
+// ListDevcontainers is a helper method to define mock.On call
+//   - all bool
+func (_e *MockContainerCLI_Expecter) ListDevcontainers(all interface{}) *MockContainerCLI_ListDevcontainers_Call {
+	return &MockContainerCLI_ListDevcontainers_Call{Call: _e.mock.On("ListDevcontainers", all)}
 }
 
-func (_c *MockContainerCLI_ListContainersOfActiveDevcontainers_Call) Run(run func()) *MockContainerCLI_ListContainersOfActiveDevcontainers_Call {
+func (_c *MockContainerCLI_ListDevcontainers_Call) Run(run func(all bool)) *MockContainerCLI_ListDevcontainers_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		var arg0 bool
+		if args[0] != nil {
+			arg0 = args[0].(bool)
+		}
+		run(
+			arg0,
+		)
 	})
 	return _c
 }
 
-func (_c *MockContainerCLI_ListContainersOfActiveDevcontainers_Call) Return(err error) *MockContainerCLI_ListContainersOfActiveDevcontainers_Call {
+func (_c *MockContainerCLI_ListDevcontainers_Call) Return(err error) *MockContainerCLI_ListDevcontainers_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockContainerCLI_ListContainersOfActiveDevcontainers_Call) RunAndReturn(run func() error) *MockContainerCLI_ListContainersOfActiveDevcontainers_Call {
+func (_c *MockContainerCLI_ListDevcontainers_Call) RunAndReturn(run func(all bool) error) *MockContainerCLI_ListDevcontainers_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -338,59 +593,611 @@ func (_c *MockContainerCLI_ListPorts_Call) RunAndReturn(run func(path string) er
 	return _c
 }
 
-// ShowLogs provides a mock function for the type MockContainerCLI
-func (_mock *MockContainerCLI) ShowLogs(path string, follow bool) error {
-	ret := _mock.Called(path, follow)
+// ListSnapshots provides a mock function for the type MockContainerCLI
+func (_mock *MockContainerCLI) ListSnapshots(path string) error {
+	ret := _mock.Called(path)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ShowLogs")
+		panic("no return value specified for ListSnapshots")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(string, bool) error); ok {
-		r0 = returnFunc(path, follow)
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(path)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockContainerCLI_ShowLogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ShowLogs'
-type MockContainerCLI_ShowLogs_Call struct {
+// MockContainerCLI_ListSnapshots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSnapshots'
+type MockContainerCLI_ListSnapshots_Call struct {
 	*mock.Call
 }
 
-// ShowLogs is a helper method to define mock.On call
+// ListSnapshots is a helper method to define mock.On call
 //   - path string
-//   - follow bool
-func (_e *MockContainerCLI_Expecter) ShowLogs(path interface{}, follow interface{}) *MockContainerCLI_ShowLogs_Call {
-	return &MockContainerCLI_ShowLogs_Call{Call: _e.mock.On("ShowLogs", path, follow)}
+func (_e *MockContainerCLI_Expecter) ListSnapshots(path interface{}) *MockContainerCLI_ListSnapshots_Call {
+	return &MockContainerCLI_ListSnapshots_Call{Call: _e.mock.On("ListSnapshots", path)}
 }
 
-func (_c *MockContainerCLI_ShowLogs_Call) Run(run func(path string, follow bool)) *MockContainerCLI_ShowLogs_Call {
+func (_c *MockContainerCLI_ListSnapshots_Call) Run(run func(path string)) *MockContainerCLI_ListSnapshots_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 string
 		if args[0] != nil {
 			arg0 = args[0].(string)
 		}
-		var arg1 bool
-		if args[1] != nil {
-			arg1 = args[1].(bool)
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockContainerCLI_ListSnapshots_Call) Return(err error) *MockContainerCLI_ListSnapshots_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockContainerCLI_ListSnapshots_Call) RunAndReturn(run func(path string) error) *MockContainerCLI_ListSnapshots_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListVolumes provides a mock function for the type MockContainerCLI
+func (_mock *MockContainerCLI) ListVolumes(path string) error {
+	ret := _mock.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListVolumes")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(path)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockContainerCLI_ListVolumes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListVolumes'
+type MockContainerCLI_ListVolumes_Call struct {
+	*mock.Call
+}
+
+// ListVolumes is a helper method to define mock.On call
+//   - path string
+func (_e *MockContainerCLI_Expecter) ListVolumes(path interface{}) *MockContainerCLI_ListVolumes_Call {
+	return &MockContainerCLI_ListVolumes_Call{Call: _e.mock.On("ListVolumes", path)}
+}
+
+func (_c *MockContainerCLI_ListVolumes_Call) Run(run func(path string)) *MockContainerCLI_ListVolumes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
 		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockContainerCLI_ShowLogs_Call) Return(err error) *MockContainerCLI_ShowLogs_Call {
+func (_c *MockContainerCLI_ListVolumes_Call) Return(err error) *MockContainerCLI_ListVolumes_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockContainerCLI_ListVolumes_Call) RunAndReturn(run func(path string) error) *MockContainerCLI_ListVolumes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveContainers provides a mock function for the type MockContainerCLI
+func (_mock *MockContainerCLI) RemoveContainers(path string, opts RemoveOptions) error {
+	ret := _mock.Called(path, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveContainers")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, RemoveOptions) error); ok {
+		r0 = returnFunc(path, opts)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockContainerCLI_RemoveContainers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveContainers'
+type MockContainerCLI_RemoveContainers_Call struct {
+	*mock.Call
+}
+
+// RemoveContainers is a helper method to define mock.On call
+//   - path string
+//   - opts RemoveOptions
+func (_e *MockContainerCLI_Expecter) RemoveContainers(path interface{}, opts interface{}) *MockContainerCLI_RemoveContainers_Call {
+	return &MockContainerCLI_RemoveContainers_Call{Call: _e.mock.On("RemoveContainers", path, opts)}
+}
+
+func (_c *MockContainerCLI_RemoveContainers_Call) Run(run func(path string, opts RemoveOptions)) *MockContainerCLI_RemoveContainers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 RemoveOptions
+		if args[1] != nil {
+			arg1 = args[1].(RemoveOptions)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockContainerCLI_RemoveContainers_Call) Return(err error) *MockContainerCLI_RemoveContainers_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockContainerCLI_RemoveContainers_Call) RunAndReturn(run func(path string, opts RemoveOptions) error) *MockContainerCLI_RemoveContainers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestartContainer provides a mock function for the type MockContainerCLI
+func (_mock *MockContainerCLI) RestartContainer(path string) error {
+	ret := _mock.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestartContainer")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(path)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockContainerCLI_RestartContainer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestartContainer'
+type MockContainerCLI_RestartContainer_Call struct {
+	*mock.Call
+}
+
+// RestartContainer is a helper method to define mock.On call
+//   - path string
+func (_e *MockContainerCLI_Expecter) RestartContainer(path interface{}) *MockContainerCLI_RestartContainer_Call {
+	return &MockContainerCLI_RestartContainer_Call{Call: _e.mock.On("RestartContainer", path)}
+}
+
+func (_c *MockContainerCLI_RestartContainer_Call) Run(run func(path string)) *MockContainerCLI_RestartContainer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockContainerCLI_RestartContainer_Call) Return(err error) *MockContainerCLI_RestartContainer_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockContainerCLI_RestartContainer_Call) RunAndReturn(run func(path string) error) *MockContainerCLI_RestartContainer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreSnapshot provides a mock function for the type MockContainerCLI
+func (_mock *MockContainerCLI) RestoreSnapshot(path string, name string, yes bool) error {
+	ret := _mock.Called(path, name, yes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreSnapshot")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, string, bool) error); ok {
+		r0 = returnFunc(path, name, yes)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockContainerCLI_RestoreSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreSnapshot'
+type MockContainerCLI_RestoreSnapshot_Call struct {
+	*mock.Call
+}
+
+// RestoreSnapshot is a helper method to define mock.On call
+//   - path string
+//   - name string
+//   - yes bool
+func (_e *MockContainerCLI_Expecter) RestoreSnapshot(path interface{}, name interface{}, yes interface{}) *MockContainerCLI_RestoreSnapshot_Call {
+	return &MockContainerCLI_RestoreSnapshot_Call{Call: _e.mock.On("RestoreSnapshot", path, name, yes)}
+}
+
+func (_c *MockContainerCLI_RestoreSnapshot_Call) Run(run func(path string, name string, yes bool)) *MockContainerCLI_RestoreSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockContainerCLI_RestoreSnapshot_Call) Return(err error) *MockContainerCLI_RestoreSnapshot_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockContainerCLI_RestoreSnapshot_Call) RunAndReturn(run func(path string, name string, yes bool) error) *MockContainerCLI_RestoreSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreVolumes provides a mock function for the type MockContainerCLI
+func (_mock *MockContainerCLI) RestoreVolumes(path string, dir string, yes bool) error {
+	ret := _mock.Called(path, dir, yes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreVolumes")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, string, bool) error); ok {
+		r0 = returnFunc(path, dir, yes)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockContainerCLI_RestoreVolumes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreVolumes'
+type MockContainerCLI_RestoreVolumes_Call struct {
+	*mock.Call
+}
+
+// RestoreVolumes is a helper method to define mock.On call
+//   - path string
+//   - dir string
+//   - yes bool
+func (_e *MockContainerCLI_Expecter) RestoreVolumes(path interface{}, dir interface{}, yes interface{}) *MockContainerCLI_RestoreVolumes_Call {
+	return &MockContainerCLI_RestoreVolumes_Call{Call: _e.mock.On("RestoreVolumes", path, dir, yes)}
+}
+
+func (_c *MockContainerCLI_RestoreVolumes_Call) Run(run func(path string, dir string, yes bool)) *MockContainerCLI_RestoreVolumes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockContainerCLI_RestoreVolumes_Call) Return(err error) *MockContainerCLI_RestoreVolumes_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockContainerCLI_RestoreVolumes_Call) RunAndReturn(run func(path string, dir string, yes bool) error) *MockContainerCLI_RestoreVolumes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveSnapshot provides a mock function for the type MockContainerCLI
+func (_mock *MockContainerCLI) SaveSnapshot(path string, name string) error {
+	ret := _mock.Called(path, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveSnapshot")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = returnFunc(path, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockContainerCLI_SaveSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveSnapshot'
+type MockContainerCLI_SaveSnapshot_Call struct {
+	*mock.Call
+}
+
+// SaveSnapshot is a helper method to define mock.On call
+//   - path string
+//   - name string
+func (_e *MockContainerCLI_Expecter) SaveSnapshot(path interface{}, name interface{}) *MockContainerCLI_SaveSnapshot_Call {
+	return &MockContainerCLI_SaveSnapshot_Call{Call: _e.mock.On("SaveSnapshot", path, name)}
+}
+
+func (_c *MockContainerCLI_SaveSnapshot_Call) Run(run func(path string, name string)) *MockContainerCLI_SaveSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockContainerCLI_SaveSnapshot_Call) Return(err error) *MockContainerCLI_SaveSnapshot_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockContainerCLI_SaveSnapshot_Call) RunAndReturn(run func(path string, name string) error) *MockContainerCLI_SaveSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ShowLogs provides a mock function for the type MockContainerCLI
+func (_mock *MockContainerCLI) ShowLogs(path string, opts LogsOptions) error {
+	ret := _mock.Called(path, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ShowLogs")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, LogsOptions) error); ok {
+		r0 = returnFunc(path, opts)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockContainerCLI_ShowLogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ShowLogs'
+type MockContainerCLI_ShowLogs_Call struct {
+	*mock.Call
+}
+
+// ShowLogs is a helper method to define mock.On call
+//   - path string
+//   - opts LogsOptions
+func (_e *MockContainerCLI_Expecter) ShowLogs(path interface{}, opts interface{}) *MockContainerCLI_ShowLogs_Call {
+	return &MockContainerCLI_ShowLogs_Call{Call: _e.mock.On("ShowLogs", path, opts)}
+}
+
+func (_c *MockContainerCLI_ShowLogs_Call) Run(run func(path string, opts LogsOptions)) *MockContainerCLI_ShowLogs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 LogsOptions
+		if args[1] != nil {
+			arg1 = args[1].(LogsOptions)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockContainerCLI_ShowLogs_Call) Return(err error) *MockContainerCLI_ShowLogs_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockContainerCLI_ShowLogs_Call) RunAndReturn(run func(path string, opts LogsOptions) error) *MockContainerCLI_ShowLogs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ShowStats provides a mock function for the type MockContainerCLI
+func (_mock *MockContainerCLI) ShowStats(path string) error {
+	ret := _mock.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ShowStats")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(path)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockContainerCLI_ShowStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ShowStats'
+type MockContainerCLI_ShowStats_Call struct {
+	*mock.Call
+}
+
+// ShowStats is a helper method to define mock.On call
+//   - path string
+func (_e *MockContainerCLI_Expecter) ShowStats(path interface{}) *MockContainerCLI_ShowStats_Call {
+	return &MockContainerCLI_ShowStats_Call{Call: _e.mock.On("ShowStats", path)}
+}
+
+func (_c *MockContainerCLI_ShowStats_Call) Run(run func(path string)) *MockContainerCLI_ShowStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockContainerCLI_ShowStats_Call) Return(err error) *MockContainerCLI_ShowStats_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockContainerCLI_ShowStats_Call) RunAndReturn(run func(path string) error) *MockContainerCLI_ShowStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartContainer provides a mock function for the type MockContainerCLI
+func (_mock *MockContainerCLI) StartContainer(path string) error {
+	ret := _mock.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartContainer")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(path)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockContainerCLI_StartContainer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartContainer'
+type MockContainerCLI_StartContainer_Call struct {
+	*mock.Call
+}
+
+// StartContainer is a helper method to define mock.On call
+//   - path string
+func (_e *MockContainerCLI_Expecter) StartContainer(path interface{}) *MockContainerCLI_StartContainer_Call {
+	return &MockContainerCLI_StartContainer_Call{Call: _e.mock.On("StartContainer", path)}
+}
+
+func (_c *MockContainerCLI_StartContainer_Call) Run(run func(path string)) *MockContainerCLI_StartContainer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockContainerCLI_StartContainer_Call) Return(err error) *MockContainerCLI_StartContainer_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockContainerCLI_StartContainer_Call) RunAndReturn(run func(path string) error) *MockContainerCLI_StartContainer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WaitContainers provides a mock function for the type MockContainerCLI
+func (_mock *MockContainerCLI) WaitContainers(path string, opts WaitOptions) error {
+	ret := _mock.Called(path, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WaitContainers")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, WaitOptions) error); ok {
+		r0 = returnFunc(path, opts)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockContainerCLI_WaitContainers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WaitContainers'
+type MockContainerCLI_WaitContainers_Call struct {
+	*mock.Call
+}
+
+// WaitContainers is a helper method to define mock.On call
+//   - path string
+//   - opts WaitOptions
+func (_e *MockContainerCLI_Expecter) WaitContainers(path interface{}, opts interface{}) *MockContainerCLI_WaitContainers_Call {
+	return &MockContainerCLI_WaitContainers_Call{Call: _e.mock.On("WaitContainers", path, opts)}
+}
+
+func (_c *MockContainerCLI_WaitContainers_Call) Run(run func(path string, opts WaitOptions)) *MockContainerCLI_WaitContainers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 WaitOptions
+		if args[1] != nil {
+			arg1 = args[1].(WaitOptions)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockContainerCLI_WaitContainers_Call) Return(err error) *MockContainerCLI_WaitContainers_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockContainerCLI_ShowLogs_Call) RunAndReturn(run func(path string, follow bool) error) *MockContainerCLI_ShowLogs_Call {
+func (_c *MockContainerCLI_WaitContainers_Call) RunAndReturn(run func(path string, opts WaitOptions) error) *MockContainerCLI_WaitContainers_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,151 @@
+package container
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/Brennon-Oliveira/dev-cli/internal/container/container_utils"
+	"github.com/Brennon-Oliveira/dev-cli/internal/devcontainer"
+	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
+)
+
+// SaveSnapshot commits the devcontainer of the workspace to an image of
+// its snapshot repository, tagged with name and the current time.
+func (c *realContainerCLI) SaveSnapshot(path string, name string) error {
+	if err := container_utils.ValidateSnapshotName(name); err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	eng, err := c.getEngine()
+	if err != nil {
+		return err
+	}
+
+	mainIDs, err := c.findMainContainers(eng, path)
+	if err != nil {
+		return err
+	}
+
+	if len(mainIDs) == 0 {
+		logger.Error("Nenhum dev container encontrado para o caminho especificado.")
+		return fmt.Errorf("nenhum dev container encontrado para o caminho: %s", path)
+	}
+
+	image := container_utils.SnapshotImage(path, name, c.now())
+	logger.Info("Salvando o container %s na imagem %s...", mainIDs[0], image)
+
+	if err := eng.Commit(mainIDs[0], image); err != nil {
+		logger.Error("Não foi possível salvar o snapshot.")
+		return err
+	}
+
+	logger.Success("Snapshot %s salvo. Para voltar a ele: dev snapshot restore %s", name, name)
+	return nil
+}
+
+// ListSnapshots lists the snapshots of the workspace, newest first.
+func (c *realContainerCLI) ListSnapshots(path string) error {
+	snapshots, err := c.workspaceSnapshots(path)
+	if err != nil {
+		return err
+	}
+
+	return c.renderer.Render(snapshots, func() string {
+		return c.formatSnapshots(snapshots)
+	})
+}
+
+func (c *realContainerCLI) workspaceSnapshots(path string) ([]container_utils.Snapshot, error) {
+	eng, err := c.getEngine()
+	if err != nil {
+		return nil, err
+	}
+
+	images, err := eng.ListImages()
+	if err != nil {
+		logger.Error("Não foi possível listar as imagens.")
+		return nil, err
+	}
+
+	return container_utils.WorkspaceSnapshots(path, images), nil
+}
+
+// RestoreSnapshot recreates the devcontainer of the workspace from a
+// snapshot, through `devcontainer up` with an override configuration that
+// runs the snapshot image instead of building one.
+func (c *realContainerCLI) RestoreSnapshot(path string, name string, yes bool) error {
+	snapshots, err := c.workspaceSnapshots(path)
+	if err != nil {
+		return err
+	}
+
+	snapshot, ok := container_utils.FindSnapshot(snapshots, name)
+	if !ok {
+		logger.Error("Snapshot %s não encontrado. Veja os disponíveis com dev snapshot list.", name)
+		return fmt.Errorf("snapshot não encontrado: %s", name)
+	}
+
+	config, err := c.devcontainer.ReadConfiguration(path)
+	if err != nil {
+		logger.Error("Não foi possível ler a configuração do devcontainer.")
+		return err
+	}
+
+	dir, err := c.mkdirTemp("", "dev-snapshot-")
+	if err != nil {
+		return err
+	}
+	defer c.removeAll(dir)
+
+	configDir := filepath.Join(path, ".devcontainer")
+	if configFile, ok := config.Configuration.Raw["configFilePath"].(map[string]any); ok {
+		if fsPath, ok := configFile["fsPath"].(string); ok {
+			configDir = filepath.Dir(fsPath)
+		}
+	}
+
+	composeFile := filepath.Join(dir, "docker-compose.snapshot.yml")
+	override, compose, err := container_utils.SnapshotOverride(config.Configuration.Raw, configDir, snapshot.Image, composeFile)
+	if err != nil {
+		logger.Error("Não foi possível gerar a configuração do snapshot.")
+		return err
+	}
+
+	if !yes {
+		logger.Info("O dev container será recriado a partir de %s (%s). As alterações feitas no container depois do snapshot serão perdidas.", snapshot.Image, snapshot.Created.Format("2006-01-02 15:04:05"))
+
+		confirmed, err := c.confirmAction("Restaurar o snapshot?")
+		if err != nil || !confirmed {
+			return err
+		}
+	}
+
+	if compose != "" {
+		if err := c.writeFile(composeFile, []byte(compose), 0o644); err != nil {
+			return err
+		}
+	}
+
+	data, err := json.Marshal(override)
+	if err != nil {
+		return err
+	}
+
+	overrideFile := filepath.Join(dir, "devcontainer.json")
+	if err := c.writeFile(overrideFile, data, 0o644); err != nil {
+		return err
+	}
+
+	logger.Info("Restaurando o snapshot %s...", snapshot.Name)
+	if err := c.devcontainer.Up(path, devcontainer.UpOptions{
+		RemoveExistingContainer: true,
+		OverrideConfig:          overrideFile,
+	}); err != nil {
+		return err
+	}
+
+	logger.Success("Snapshot %s restaurado.", snapshot.Name)
+	return nil
+}
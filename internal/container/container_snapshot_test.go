@@ -0,0 +1,207 @@
+package container
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Brennon-Oliveira/dev-cli/internal/container/container_utils"
+	"github.com/Brennon-Oliveira/dev-cli/internal/devcontainer"
+	"github.com/Brennon-Oliveira/dev-cli/internal/engine"
+	"github.com/Brennon-Oliveira/dev-cli/internal/output"
+	"github.com/Brennon-Oliveira/dev-cli/internal/pather"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================================
+// Tests for SaveSnapshot, ListSnapshots and RestoreSnapshot
+// ============================================================================
+
+var snapshotTime = time.Date(2026, 3, 1, 9, 0, 0, 0, time.Local)
+
+func TestSaveSnapshot_CommitsTheDevcontainer(t *testing.T) {
+	r := require.New(t)
+
+	path := "/home/user/project"
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().Commit("app", container_utils.SnapshotRepository(path)+":before-20260301-090000").Return(nil).Once()
+
+	err := newWorkspaceContainerCLI(eng, stackIDs, "", nil, WithNow(func() time.Time { return snapshotTime })).SaveSnapshot(path, "before")
+
+	r.NoError(err)
+}
+
+func TestSaveSnapshot_InvalidName_CommitsNothing(t *testing.T) {
+	r := require.New(t)
+
+	eng := engine.NewMockEngine(t)
+
+	err := newWorkspaceContainerCLI(eng, stackIDs, "", nil).SaveSnapshot("/home/user/project", "no spaces")
+
+	r.ErrorContains(err, "inválido")
+	eng.AssertNotCalled(t, "Commit", mock.Anything, mock.Anything)
+}
+
+func TestSaveSnapshot_NoDevcontainer_ReturnsError(t *testing.T) {
+	r := require.New(t)
+
+	eng := engine.NewMockEngine(t)
+
+	err := NewContainerCLI(
+		WithEngine(eng),
+		WithTryPaths(func(p string, pather pather.Pather) []string { return []string{p} }),
+		WithFindMainContainersForPath(func(eng engine.Engine, p string) ([]string, error) { return nil, nil }),
+	).SaveSnapshot("/home/user/project", "before")
+
+	r.ErrorContains(err, "nenhum dev container")
+	eng.AssertNotCalled(t, "Commit", mock.Anything, mock.Anything)
+}
+
+func TestListSnapshots_ListsSnapshotsOfTheWorkspace(t *testing.T) {
+	r := require.New(t)
+
+	path := "/home/user/project"
+	buf := &bytes.Buffer{}
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListImages().Return([]*engine.Image{
+		{Repository: container_utils.SnapshotRepository(path), Tag: "before-20260301-090000", Size: 10},
+		{Repository: "postgres", Tag: "16"},
+	}, nil)
+
+	err := NewContainerCLI(
+		WithEngine(eng),
+		WithRenderer(output.NewRenderer(output.WithFormat(output.FormatJSON), output.WithWriter(buf))),
+	).ListSnapshots(path)
+	r.NoError(err)
+
+	var snapshots []container_utils.Snapshot
+	r.NoError(json.Unmarshal(buf.Bytes(), &snapshots))
+	r.Len(snapshots, 1)
+	r.Equal("before", snapshots[0].Name)
+}
+
+func TestRestoreSnapshot_UpsWithOverrideConfig(t *testing.T) {
+	r := require.New(t)
+
+	path := "/home/user/project"
+	image := container_utils.SnapshotRepository(path) + ":before-20260301-090000"
+
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListImages().Return([]*engine.Image{{Repository: container_utils.SnapshotRepository(path), Tag: "before-20260301-090000"}}, nil)
+
+	dc := devcontainer.NewMockDevContainerCLI(t)
+	dc.EXPECT().ReadConfiguration(path).Return(&devcontainer.DevContainerConfiguration{
+		Configuration: devcontainer.DevContainerConfiguration_Configuration{
+			Raw: map[string]any{"build": map[string]any{"dockerfile": "Dockerfile"}, "remoteUser": "vscode"},
+		},
+	}, nil)
+	fs := newFakeFS(map[string]string{})
+	dc.EXPECT().Up(path, mock.Anything).RunAndReturn(func(workspace string, opts devcontainer.UpOptions) error {
+		r.True(opts.RemoveExistingContainer)
+		r.Equal("/tmp/dev-snapshot-1/devcontainer.json", opts.OverrideConfig)
+		r.JSONEq(`{"image":"`+image+`","remoteUser":"vscode"}`, fs.files[opts.OverrideConfig])
+		return nil
+	}).Once()
+
+	err := NewContainerCLI(append(fs.options(), WithEngine(eng), WithDevContainerCLI(dc))...).RestoreSnapshot(path, "before", true)
+
+	r.NoError(err)
+	r.Empty(fs.files, "the override files must be removed")
+	r.Empty(fs.dirs)
+}
+
+func TestRestoreSnapshot_Compose_WritesOverrideComposeFile(t *testing.T) {
+	r := require.New(t)
+
+	path := "/home/user/project"
+	image := container_utils.SnapshotRepository(path) + ":before-20260301-090000"
+
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListImages().Return([]*engine.Image{{Repository: container_utils.SnapshotRepository(path), Tag: "before-20260301-090000"}}, nil)
+
+	dc := devcontainer.NewMockDevContainerCLI(t)
+	dc.EXPECT().ReadConfiguration(path).Return(&devcontainer.DevContainerConfiguration{
+		Configuration: devcontainer.DevContainerConfiguration_Configuration{
+			Raw: map[string]any{
+				"dockerComposeFile": "docker-compose.yml",
+				"service":           "app",
+				"configFilePath":    map[string]any{"fsPath": path + "/.devcontainer/devcontainer.json"},
+			},
+		},
+	}, nil)
+
+	fs := newFakeFS(map[string]string{})
+	dc.EXPECT().Up(path, mock.Anything).RunAndReturn(func(workspace string, opts devcontainer.UpOptions) error {
+		compose := fs.files["/tmp/dev-snapshot-1/docker-compose.snapshot.yml"]
+		r.Contains(compose, `"app":`)
+		r.Contains(compose, image)
+		r.Contains(fs.files[opts.OverrideConfig], `"/tmp/dev-snapshot-1/docker-compose.snapshot.yml"`)
+		r.Contains(fs.files[opts.OverrideConfig], `"`+path+`/.devcontainer/docker-compose.yml"`)
+		return nil
+	}).Once()
+
+	err := NewContainerCLI(append(fs.options(), WithEngine(eng), WithDevContainerCLI(dc))...).RestoreSnapshot(path, "before", true)
+
+	r.NoError(err)
+	r.Empty(fs.files)
+}
+
+func TestRestoreSnapshot_WriteFails_DoesNotRecreate(t *testing.T) {
+	r := require.New(t)
+
+	path := "/home/user/project"
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListImages().Return([]*engine.Image{{Repository: container_utils.SnapshotRepository(path), Tag: "before-20260301-090000"}}, nil)
+
+	dc := devcontainer.NewMockDevContainerCLI(t)
+	dc.EXPECT().ReadConfiguration(path).Return(&devcontainer.DevContainerConfiguration{}, nil)
+
+	err := NewContainerCLI(append(newFakeFS(map[string]string{}).options(),
+		WithEngine(eng),
+		WithDevContainerCLI(dc),
+		WithWriteFile(func(name string, data []byte, perm os.FileMode) error {
+			return fmt.Errorf("no space left on device")
+		}),
+	)...).RestoreSnapshot(path, "before", true)
+
+	r.ErrorContains(err, "no space left on device")
+	dc.AssertNotCalled(t, "Up", mock.Anything, mock.Anything)
+}
+
+func TestRestoreSnapshot_UnknownName_ReturnsError(t *testing.T) {
+	r := require.New(t)
+
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListImages().Return(nil, nil)
+	dc := devcontainer.NewMockDevContainerCLI(t)
+
+	err := NewContainerCLI(WithEngine(eng), WithDevContainerCLI(dc)).RestoreSnapshot("/home/user/project", "before", true)
+
+	r.ErrorContains(err, "snapshot não encontrado")
+	dc.AssertNotCalled(t, "Up", mock.Anything, mock.Anything)
+}
+
+func TestRestoreSnapshot_Declined_KeepsTheContainer(t *testing.T) {
+	r := require.New(t)
+
+	path := "/home/user/project"
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListImages().Return([]*engine.Image{{Repository: container_utils.SnapshotRepository(path), Tag: "before-20260301-090000"}}, nil)
+
+	dc := devcontainer.NewMockDevContainerCLI(t)
+	dc.EXPECT().ReadConfiguration(path).Return(&devcontainer.DevContainerConfiguration{}, nil)
+
+	err := NewContainerCLI(append(newFakeFS(map[string]string{}).options(),
+		WithEngine(eng),
+		WithDevContainerCLI(dc),
+		WithIsTerminal(func() bool { return true }),
+		WithConfirm(func(string) (bool, error) { return false, nil }),
+	)...).RestoreSnapshot(path, "before", false)
+
+	r.NoError(err)
+	dc.AssertNotCalled(t, "Up", mock.Anything, mock.Anything)
+}
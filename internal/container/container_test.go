@@ -1,14 +1,28 @@
 package container
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"maps"
+	"net"
 	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/Brennon-Oliveira/dev-cli/internal/config"
 	"github.com/Brennon-Oliveira/dev-cli/internal/container/container_utils"
+	"github.com/Brennon-Oliveira/dev-cli/internal/devcontainer"
+	"github.com/Brennon-Oliveira/dev-cli/internal/engine"
 	"github.com/Brennon-Oliveira/dev-cli/internal/exec"
 	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
+	"github.com/Brennon-Oliveira/dev-cli/internal/output"
 	"github.com/Brennon-Oliveira/dev-cli/internal/pather"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -33,26 +47,132 @@ func createMockConfigWithTool(t *testing.T, tool string) *config.MockConfig {
 			Tool string `json:"tool"`
 		}{Tool: tool},
 	}
-	mockCfg.EXPECT().Load().Return(globalCfg)
+	mockCfg.EXPECT().Load().Return(globalCfg, nil)
 	return mockCfg
 }
 
+// psJSONLine builds a line of `docker ps --format '{{json .}}'`
+func psJSONLine(id, names, status, localFolder string) string {
+	labels := "com.docker.compose.project=" + strings.Split(names, "_devcontainer")[0] + "_devcontainer"
+	if localFolder != "" {
+		labels += ",devcontainer.local_folder=" + localFolder
+	}
+
+	return fmt.Sprintf(`{"ID":%q,"Names":%q,"State":"running","Status":%q,"Labels":%q}`, id, names, status, labels) + "\n"
+}
+
 // createEmptyMockConfig creates a mock config without setting any expectations (for builder tests)
 func createEmptyMockConfig(t *testing.T) *config.MockConfig {
 	return config.NewMockConfig(t)
 }
 
+// fakeFS is an in-memory filesystem for the volume archives and the
+// snapshot override files.
+type fakeFS struct {
+	files map[string]string
+	dirs  []string
+}
+
+func newFakeFS(files map[string]string) *fakeFS {
+	return &fakeFS{files: files}
+}
+
+// options injects the filesystem into a ContainerCLI.
+func (f *fakeFS) options() []Option {
+	return []Option{
+		WithMkdirAll(func(path string, perm os.FileMode) error {
+			f.dirs = append(f.dirs, path)
+			return nil
+		}),
+		WithCreateTemp(func(dir string, pattern string) (container_utils.TempFile, error) {
+			name := dir + "/" + strings.Replace(pattern, "*", "tmp", 1)
+			f.files[name] = ""
+			return &fakeFile{fs: f, name: name}, nil
+		}),
+		WithRename(func(oldpath string, newpath string) error {
+			data, ok := f.files[oldpath]
+			if !ok {
+				return os.ErrNotExist
+			}
+			delete(f.files, oldpath)
+			f.files[newpath] = data
+			return nil
+		}),
+		WithRemove(func(name string) error {
+			if _, ok := f.files[name]; !ok {
+				return os.ErrNotExist
+			}
+			delete(f.files, name)
+			return nil
+		}),
+		WithStat(func(name string) (os.FileInfo, error) {
+			if _, ok := f.files[name]; !ok {
+				return nil, os.ErrNotExist
+			}
+			return nil, nil
+		}),
+		WithOpen(func(name string) (io.ReadCloser, error) {
+			data, ok := f.files[name]
+			if !ok {
+				return nil, os.ErrNotExist
+			}
+			return io.NopCloser(strings.NewReader(data)), nil
+		}),
+		WithMkdirTemp(func(dir string, pattern string) (string, error) {
+			if dir == "" {
+				dir = "/tmp"
+			}
+			path := dir + "/" + pattern + "1"
+			f.dirs = append(f.dirs, path)
+			return path, nil
+		}),
+		WithRemoveAll(func(path string) error {
+			f.dirs = slices.DeleteFunc(f.dirs, func(dir string) bool {
+				return dir == path
+			})
+			maps.DeleteFunc(f.files, func(name string, data string) bool {
+				return strings.HasPrefix(name, path+"/")
+			})
+			return nil
+		}),
+		WithWriteFile(func(name string, data []byte, perm os.FileMode) error {
+			f.files[name] = string(data)
+			return nil
+		}),
+	}
+}
+
+// fakeFile saves what was written to it in its filesystem on Close.
+type fakeFile struct {
+	fs   *fakeFS
+	name string
+	buf  bytes.Buffer
+}
+
+func (f *fakeFile) Write(p []byte) (int, error) {
+	return f.buf.Write(p)
+}
+
+func (f *fakeFile) Close() error {
+	f.fs.files[f.name] = f.buf.String()
+	return nil
+}
+
+func (f *fakeFile) Name() string {
+	return f.name
+}
+
 // ============================================================================
-// Tests for ListContainersOfActiveDevcontainers
+// Tests for ListDevcontainers
 // ============================================================================
 
-func TestListContainersOfActiveDevcontainers_SuccessfulExecutionWithContainers(t *testing.T) {
+func TestListDevcontainers_SuccessfulExecutionWithContainers(t *testing.T) {
 	r := require.New(t)
 
 	// Setup mock output
-	mockOutput := "f7bc76eda682\tsimple-financial-app_devcontainer-sfa.app-1\tUp 5 minutes\t/home/brennon/projects/sfa/simple-financial-app\n" +
-		"9c88be57f94f\tsimple-financial-app_devcontainer-sfa.smtp-1\tUp 5 minutes\t\n" +
-		"10103216832d\tsimple-financial-app_devcontainer-sfa.db-1\tUp 10 minutes\t"
+	mockOutput := psJSONLine("f7bc76eda682", "simple-financial-app_devcontainer-sfa.app-1", "Up 5 minutes", "/home/brennon/projects/sfa/simple-financial-app") +
+		psJSONLine("9c88be57f94f", "simple-financial-app_devcontainer-sfa.smtp-1", "Up 5 minutes", "") +
+		psJSONLine("10103216832d", "simple-financial-app_devcontainer-sfa.db-1", "Up 10 minutes", "")
 
 	executor := exec.NewMockExecutor(t)
 	executor.EXPECT().Output(mock.Anything, mock.Anything, mock.Anything).Return([]byte(mockOutput), nil)
@@ -64,9 +184,28 @@ func TestListContainersOfActiveDevcontainers_SuccessfulExecutionWithContainers(t
 		WithConfig(configMock),
 	)
 
-	err := containerCLI.ListContainersOfActiveDevcontainers()
+	err := containerCLI.ListDevcontainers(false)
+
+	r.Nil(err)
+}
+
+func TestListDevcontainers_PassesAllToFindDevcontainers(t *testing.T) {
+	r := require.New(t)
+
+	var gotAll bool
+	containerCLI := NewContainerCLI(
+		WithEngine(engine.NewMockEngine(t)),
+		WithRenderer(output.NewRenderer(output.WithWriter(io.Discard))),
+		WithFindDevcontainers(func(eng engine.Engine, all bool) ([]*container_utils.Container, error) {
+			gotAll = all
+			return nil, nil
+		}),
+	)
+
+	err := containerCLI.ListDevcontainers(true)
 
 	r.Nil(err)
+	r.True(gotAll)
 }
 
 // ============================================================================
@@ -79,6 +218,8 @@ func TestCleanResources_BothPrunesSucceed_ReturnsNil(t *testing.T) {
 	executor := exec.NewMockExecutor(t)
 	executor.EXPECT().Run(mock.Anything, mock.Anything).Return(nil).Times(2)
 
+	executor.EXPECT().Output("docker", []string{"builder", "prune", "-f"}).Return([]byte("Total reclaimed space: 0B\n"), nil)
+
 	configMock := createMockConfigWithTool(t, "docker")
 
 	containerCLI := NewContainerCLI(
@@ -86,7 +227,7 @@ func TestCleanResources_BothPrunesSucceed_ReturnsNil(t *testing.T) {
 		WithConfig(configMock),
 	)
 
-	err := containerCLI.CleanResources()
+	err := containerCLI.CleanResources(CleanOptions{Global: true, Yes: true})
 
 	r.Nil(err)
 	executor.AssertExpectations(t)
@@ -107,7 +248,7 @@ func TestCleanResources_ContainerPruneFailsReturnsNilAndStops(t *testing.T) {
 		WithConfig(configMock),
 	)
 
-	err := containerCLI.CleanResources()
+	err := containerCLI.CleanResources(CleanOptions{Global: true, Yes: true})
 
 	// Error in container prune returns nil (per the implementation)
 	r.Nil(err)
@@ -130,7 +271,7 @@ func TestCleanResources_ContainerPruneFailsDoesNotContinue(t *testing.T) {
 		WithConfig(configMock),
 	)
 
-	err := containerCLI.CleanResources()
+	err := containerCLI.CleanResources(CleanOptions{Global: true, Yes: true})
 
 	// Returns nil when container prune fails
 	r.Nil(err)
@@ -153,7 +294,7 @@ func TestCleanResources_NetworkPruneFailsAfterContainerPruneSucceeds(t *testing.
 		WithConfig(configMock),
 	)
 
-	err := containerCLI.CleanResources()
+	err := containerCLI.CleanResources(CleanOptions{Global: true, Yes: true})
 
 	r.NotNil(err)
 	assert.ErrorContains(t, err, "network prune failed")
@@ -175,7 +316,7 @@ func TestCleanResources_NetworkPruneFailsWithSpecificError(t *testing.T) {
 		WithConfig(configMock),
 	)
 
-	err := containerCLI.CleanResources()
+	err := containerCLI.CleanResources(CleanOptions{Global: true, Yes: true})
 
 	r.NotNil(err)
 	assert.ErrorContains(t, err, "network not found")
@@ -188,6 +329,8 @@ func TestCleanResources_CallsExecutorWithCorrectArgs_ContainerPrune(t *testing.T
 
 	executor.EXPECT().Run(mock.Anything, mock.Anything).Return(nil).Times(2)
 
+	executor.EXPECT().Output("docker", []string{"builder", "prune", "-f"}).Return([]byte("Total reclaimed space: 0B\n"), nil)
+
 	configMock := createMockConfigWithTool(t, "docker")
 
 	containerCLI := NewContainerCLI(
@@ -195,7 +338,7 @@ func TestCleanResources_CallsExecutorWithCorrectArgs_ContainerPrune(t *testing.T
 		WithConfig(configMock),
 	)
 
-	err := containerCLI.CleanResources()
+	err := containerCLI.CleanResources(CleanOptions{Global: true, Yes: true})
 
 	r.Nil(err)
 	executor.AssertCalled(t, "Run", "docker", []string{"container", "prune", "-f"})
@@ -208,6 +351,8 @@ func TestCleanResources_CallsExecutorWithCorrectArgs_NetworkPrune(t *testing.T)
 
 	executor.EXPECT().Run(mock.Anything, mock.Anything).Return(nil).Times(2)
 
+	executor.EXPECT().Output("docker", []string{"builder", "prune", "-f"}).Return([]byte("Total reclaimed space: 0B\n"), nil)
+
 	configMock := createMockConfigWithTool(t, "docker")
 
 	containerCLI := NewContainerCLI(
@@ -215,7 +360,7 @@ func TestCleanResources_CallsExecutorWithCorrectArgs_NetworkPrune(t *testing.T)
 		WithConfig(configMock),
 	)
 
-	err := containerCLI.CleanResources()
+	err := containerCLI.CleanResources(CleanOptions{Global: true, Yes: true})
 
 	r.Nil(err)
 	executor.AssertCalled(t, "Run", "docker", []string{"network", "prune", "-f"})
@@ -236,7 +381,7 @@ func TestCleanResources_UsesConfigToolCorrectly(t *testing.T) {
 		WithConfig(configMock),
 	)
 
-	err := containerCLI.CleanResources()
+	err := containerCLI.CleanResources(CleanOptions{Global: true, Yes: true})
 
 	r.Nil(err)
 	executor.AssertExpectations(t)
@@ -257,7 +402,7 @@ func TestCleanResources_NetworkPruneReturnsErrorWhenContainerSucceeds(t *testing
 		WithConfig(configMock),
 	)
 
-	err := containerCLI.CleanResources()
+	err := containerCLI.CleanResources(CleanOptions{Global: true, Yes: true})
 
 	// Network error is returned
 	r.NotNil(err)
@@ -283,6 +428,8 @@ func TestCleanResources_SequenceOfOperations_BothSucceed(t *testing.T) {
 		}
 	}).Return(nil).Once()
 
+	executor.EXPECT().Output("docker", []string{"builder", "prune", "-f"}).Return([]byte("Total reclaimed space: 0B\n"), nil)
+
 	configMock := createMockConfigWithTool(t, "docker")
 
 	containerCLI := NewContainerCLI(
@@ -290,7 +437,7 @@ func TestCleanResources_SequenceOfOperations_BothSucceed(t *testing.T) {
 		WithConfig(configMock),
 	)
 
-	err := containerCLI.CleanResources()
+	err := containerCLI.CleanResources(CleanOptions{Global: true, Yes: true})
 
 	r.Nil(err)
 	// Verify sequence: container first, then network
@@ -298,7 +445,176 @@ func TestCleanResources_SequenceOfOperations_BothSucceed(t *testing.T) {
 	executor.AssertExpectations(t)
 }
 
-func TestListContainersOfActiveDevcontainers_ExecutorReturnsError(t *testing.T) {
+func TestCleanResources_GlobalDryRun_ReturnsError(t *testing.T) {
+	r := require.New(t)
+
+	containerCLI := NewContainerCLI(WithEngine(engine.NewMockEngine(t)))
+
+	err := containerCLI.CleanResources(CleanOptions{Global: true, DryRun: true})
+
+	r.ErrorContains(err, "--dry-run")
+}
+
+func TestCleanResources_NotATerminal_RequiresYes(t *testing.T) {
+	r := require.New(t)
+
+	eng := engine.NewMockEngine(t)
+
+	err := NewContainerCLI(
+		WithEngine(eng),
+		WithIsTerminal(func() bool { return false }),
+	).CleanResources(CleanOptions{Global: true})
+
+	r.ErrorContains(err, "--yes")
+	eng.AssertNotCalled(t, "Prune", mock.Anything)
+}
+
+const anonymousVolume = "3f2a9c1b7d4e8f60a1b2c3d4e5f60718293a4b5c6d7e8f9012a3b4c5d6e7f809"
+
+var cleanStack = []*engine.Container{
+	{ID: "old-app", Names: "old_app_1", Image: "vsc-old-1a2b-uid", ComposeProject: "old", LocalFolder: "/home/user/old", State: "exited"},
+	{ID: "old-db", Names: "old_db_1", Image: "postgres:16", ComposeProject: "old", State: "exited"},
+	{ID: "live-app", Names: "live_app_1", Image: "vsc-live-3c4d-uid", ComposeProject: "live", LocalFolder: "/home/user/live", State: "running"},
+	{ID: "live-db", Names: "live_db_1", Image: "postgres:16", ComposeProject: "live", State: "exited"},
+}
+
+var cleanImages = []*engine.Image{
+	{ID: "aaa111", Repository: "vsc-old-1a2b-uid", Tag: "latest", Size: 1000},
+	{ID: "bbb222", Repository: "vsc-live-3c4d-uid", Tag: "latest", Size: 2000},
+	{ID: "ccc333", Repository: "postgres", Tag: "16", Size: 3000},
+}
+
+func newCleanContainerCLI(t *testing.T, eng *engine.MockEngine, buf *bytes.Buffer) ContainerCLI {
+	t.Helper()
+
+	eng.EXPECT().ComposeProjectLabels().Return([]string{"com.docker.compose.project"})
+	eng.EXPECT().Inspect("old-app").Return(&engine.ContainerDetails{Volumes: []string{anonymousVolume, "old_data"}}, nil)
+	eng.EXPECT().Inspect("old-db").Return(&engine.ContainerDetails{Volumes: []string{anonymousVolume}}, nil)
+	eng.EXPECT().ListNetworks([]string{"label=com.docker.compose.project=old"}).Return([]string{"old_default"}, nil)
+	eng.EXPECT().ListContainers(engine.ListOptions{All: true}).Return(cleanStack, nil)
+	eng.EXPECT().ListImages().Return(cleanImages, nil)
+
+	return NewContainerCLI(
+		WithEngine(eng),
+		WithRenderer(output.NewRenderer(output.WithFormat(output.FormatJSON), output.WithWriter(buf))),
+		WithFindDevcontainers(func(eng engine.Engine, all bool) ([]*container_utils.Container, error) {
+			return cleanStack, nil
+		}),
+		WithGroupContainersByPod(func(eng engine.Engine, ids []string) ([]string, []string, error) {
+			return ids, nil, nil
+		}),
+	)
+}
+
+func TestCleanResources_RemovesOnlyStoppedWorkspaces(t *testing.T) {
+	r := require.New(t)
+
+	buf := &bytes.Buffer{}
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().Remove([]string{"old-app", "old-db"}).Return(nil).Once()
+	eng.EXPECT().RemoveVolumes([]string{anonymousVolume}).Return(nil).Once()
+	eng.EXPECT().RemoveNetworks([]string{"old_default"}).Return(nil).Once()
+	eng.EXPECT().RemoveImages([]string{"vsc-old-1a2b-uid:latest"}).Return(nil).Once()
+
+	err := newCleanContainerCLI(t, eng, buf).CleanResources(CleanOptions{Yes: true})
+	r.NoError(err)
+
+	var removed []container_utils.CleanedResource
+	r.NoError(json.Unmarshal(buf.Bytes(), &removed))
+	r.Equal([]container_utils.CleanedResource{
+		{Type: container_utils.CleanContainer, ID: "old-app", Name: "old_app_1"},
+		{Type: container_utils.CleanContainer, ID: "old-db", Name: "old_db_1"},
+		{Type: container_utils.CleanVolume, ID: anonymousVolume, Name: anonymousVolume},
+		{Type: container_utils.CleanNetwork, ID: "old_default", Name: "old_default"},
+		{Type: container_utils.CleanImage, ID: "aaa111", Name: "vsc-old-1a2b-uid:latest", Size: 1000},
+	}, removed)
+	eng.AssertNotCalled(t, "PruneBuildCache")
+}
+
+func TestCleanResources_SkipsResourcesThatFailToBeRemoved(t *testing.T) {
+	r := require.New(t)
+
+	buf := &bytes.Buffer{}
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().Remove([]string{"old-app", "old-db"}).Return(nil).Once()
+	eng.EXPECT().RemoveVolumes([]string{anonymousVolume}).Return(fmt.Errorf("volume is in use")).Once()
+	eng.EXPECT().RemoveNetworks([]string{"old_default"}).Return(nil).Once()
+	eng.EXPECT().RemoveImages([]string{"vsc-old-1a2b-uid:latest"}).Return(nil).Once()
+
+	err := newCleanContainerCLI(t, eng, buf).CleanResources(CleanOptions{Yes: true})
+	r.NoError(err)
+
+	var removed []container_utils.CleanedResource
+	r.NoError(json.Unmarshal(buf.Bytes(), &removed))
+	r.Len(removed, 4)
+	r.Equal(container_utils.CleanNetwork, removed[2].Type)
+	r.Equal(container_utils.CleanImage, removed[3].Type)
+}
+
+func TestCleanResources_RemoveContainersFails_RemovesNothingElse(t *testing.T) {
+	r := require.New(t)
+
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().Remove([]string{"old-app", "old-db"}).Return(fmt.Errorf("permission denied")).Once()
+
+	err := newCleanContainerCLI(t, eng, &bytes.Buffer{}).CleanResources(CleanOptions{Yes: true})
+
+	r.ErrorContains(err, "permission denied")
+}
+
+func TestCleanResources_DryRun_ListsWithoutRemoving(t *testing.T) {
+	r := require.New(t)
+
+	buf := &bytes.Buffer{}
+	eng := engine.NewMockEngine(t)
+
+	err := newCleanContainerCLI(t, eng, buf).CleanResources(CleanOptions{DryRun: true})
+	r.NoError(err)
+
+	var planned []container_utils.CleanedResource
+	r.NoError(json.Unmarshal(buf.Bytes(), &planned))
+	r.Len(planned, 5)
+	r.Equal("old-app", planned[0].ID)
+	r.Equal(container_utils.CleanImage, planned[4].Type)
+	eng.AssertNotCalled(t, "Remove", mock.Anything)
+	eng.AssertNotCalled(t, "PruneBuildCache")
+}
+
+func TestCleanResources_NothingToRemove_ReturnsWithoutConfirming(t *testing.T) {
+	r := require.New(t)
+
+	buf := &bytes.Buffer{}
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(engine.ListOptions{All: true}).Return(nil, nil)
+	eng.EXPECT().ListImages().Return(nil, nil)
+
+	err := NewContainerCLI(
+		WithEngine(eng),
+		WithRenderer(output.NewRenderer(output.WithFormat(output.FormatJSON), output.WithWriter(buf))),
+		WithFindDevcontainers(func(eng engine.Engine, all bool) ([]*container_utils.Container, error) {
+			return nil, nil
+		}),
+		WithIsTerminal(func() bool { return false }),
+	).CleanResources(CleanOptions{})
+
+	r.NoError(err)
+	r.JSONEq(`[]`, buf.String())
+}
+
+func TestCleanResources_Global_PrunesBuildCache(t *testing.T) {
+	r := require.New(t)
+
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().Prune(engine.ResourceContainer).Return(nil).Once()
+	eng.EXPECT().Prune(engine.ResourceNetwork).Return(nil).Once()
+	eng.EXPECT().PruneBuildCache().Return(4096, nil).Once()
+
+	err := NewContainerCLI(WithEngine(eng)).CleanResources(CleanOptions{Global: true, Yes: true})
+
+	r.NoError(err)
+}
+
+func TestListDevcontainers_ExecutorReturnsError(t *testing.T) {
 	r := require.New(t)
 
 	executor := exec.NewMockExecutor(t)
@@ -311,13 +627,13 @@ func TestListContainersOfActiveDevcontainers_ExecutorReturnsError(t *testing.T)
 		WithConfig(configMock),
 	)
 
-	err := containerCLI.ListContainersOfActiveDevcontainers()
+	err := containerCLI.ListDevcontainers(false)
 
 	r.NotNil(err)
 	assert.ErrorContains(t, err, "docker not running")
 }
 
-func TestListContainersOfActiveDevcontainers_EmptyOutput(t *testing.T) {
+func TestListDevcontainers_EmptyOutput(t *testing.T) {
 	r := require.New(t)
 
 	executor := exec.NewMockExecutor(t)
@@ -330,20 +646,20 @@ func TestListContainersOfActiveDevcontainers_EmptyOutput(t *testing.T) {
 		WithConfig(configMock),
 	)
 
-	err := containerCLI.ListContainersOfActiveDevcontainers()
+	err := containerCLI.ListDevcontainers(false)
 
 	r.Nil(err)
 }
 
-func TestListContainersOfActiveDevcontainers_UsesInjectedParseFunction(t *testing.T) {
+func TestListDevcontainers_UsesInjectedGroupFunction(t *testing.T) {
 	r := require.New(t)
 
-	mockOutput := "id1\tname1\tUp\t/path"
-	mockParseWasCalled := false
+	mockOutput := psJSONLine("id1", "name1", "Up", "/path")
+	mockGroupWasCalled := false
 
-	customParseFunc := func(output string) map[string][]*container_utils.Container {
-		mockParseWasCalled = true
-		return container_utils.ParseContainerOutput(output)
+	customGroupFunc := func(containers []*container_utils.Container) map[string][]*container_utils.Container {
+		mockGroupWasCalled = true
+		return container_utils.GroupContainers(containers)
 	}
 
 	executor := exec.NewMockExecutor(t)
@@ -354,19 +670,19 @@ func TestListContainersOfActiveDevcontainers_UsesInjectedParseFunction(t *testin
 	containerCLI := NewContainerCLI(
 		WithExecutor(executor),
 		WithConfig(configMock),
-		WithParseContainerOutput(customParseFunc),
+		WithGroupContainers(customGroupFunc),
 	)
 
-	err := containerCLI.ListContainersOfActiveDevcontainers()
+	err := containerCLI.ListDevcontainers(false)
 
 	r.Nil(err)
-	r.True(mockParseWasCalled, "custom parse function was not called")
+	r.True(mockGroupWasCalled, "custom group function was not called")
 }
 
-func TestListContainersOfActiveDevcontainers_UsesInjectedFormatFunction(t *testing.T) {
+func TestListDevcontainers_UsesInjectedFormatFunction(t *testing.T) {
 	r := require.New(t)
 
-	mockOutput := "id1\tname1\tUp\t/path"
+	mockOutput := psJSONLine("id1", "name1", "Up", "/path")
 	mockFormatWasCalled := false
 
 	customFormatFunc := func(grouped map[string][]*container_utils.Container) string {
@@ -385,7 +701,7 @@ func TestListContainersOfActiveDevcontainers_UsesInjectedFormatFunction(t *testi
 		WithFormatGroupedContainers(customFormatFunc),
 	)
 
-	err := containerCLI.ListContainersOfActiveDevcontainers()
+	err := containerCLI.ListDevcontainers(false)
 
 	r.Nil(err)
 	r.True(mockFormatWasCalled, "custom format function was not called")
@@ -437,7 +753,7 @@ func TestNewContainerCLI_DefaultFunctionsAreSet(t *testing.T) {
 	containerCLI := NewContainerCLI()
 
 	r.NotNil(containerCLI)
-	r.NotNil(containerCLI.parseContainerOutput)
+	r.NotNil(containerCLI.groupContainers)
 	r.NotNil(containerCLI.formatGroupedContainers)
 }
 
@@ -463,17 +779,17 @@ func TestNewContainerCLI_WithConfig_OptionApplied(t *testing.T) {
 	r.Equal(configMock, containerCLI.config)
 }
 
-func TestNewContainerCLI_WithParseContainerOutput_OptionApplied(t *testing.T) {
+func TestNewContainerCLI_WithGroupContainers_OptionApplied(t *testing.T) {
 	r := require.New(t)
 
-	customParseFunc := func(output string) map[string][]*container_utils.Container {
+	customGroupFunc := func(containers []*container_utils.Container) map[string][]*container_utils.Container {
 		return make(map[string][]*container_utils.Container)
 	}
 
-	containerCLI := NewContainerCLI(WithParseContainerOutput(customParseFunc))
+	containerCLI := NewContainerCLI(WithGroupContainers(customGroupFunc))
 
 	r.NotNil(containerCLI)
-	r.NotNil(containerCLI.parseContainerOutput)
+	r.NotNil(containerCLI.groupContainers)
 }
 
 func TestNewContainerCLI_WithFormatGroupedContainers_OptionApplied(t *testing.T) {
@@ -495,7 +811,7 @@ func TestNewContainerCLI_MultipleOptions_AllApplied(t *testing.T) {
 	executor := exec.NewMockExecutor(t)
 	configMock := createEmptyMockConfig(t)
 
-	customParseFunc := func(output string) map[string][]*container_utils.Container {
+	customGroupFunc := func(containers []*container_utils.Container) map[string][]*container_utils.Container {
 		return make(map[string][]*container_utils.Container)
 	}
 
@@ -506,14 +822,14 @@ func TestNewContainerCLI_MultipleOptions_AllApplied(t *testing.T) {
 	containerCLI := NewContainerCLI(
 		WithExecutor(executor),
 		WithConfig(configMock),
-		WithParseContainerOutput(customParseFunc),
+		WithGroupContainers(customGroupFunc),
 		WithFormatGroupedContainers(customFormatFunc),
 	)
 
 	r.NotNil(containerCLI)
 	r.Equal(executor, containerCLI.executor)
 	r.Equal(configMock, containerCLI.config)
-	r.NotNil(containerCLI.parseContainerOutput)
+	r.NotNil(containerCLI.groupContainers)
 	r.NotNil(containerCLI.formatGroupedContainers)
 }
 
@@ -524,8 +840,8 @@ func TestNewContainerCLI_MultipleOptions_AllApplied(t *testing.T) {
 func TestListContainersIntegration_FlowWithRealParsing(t *testing.T) {
 	r := require.New(t)
 
-	mockOutput := "f7bc76eda682\tapp_devcontainer-sfa.app-1\tUp 5 minutes\t/home/user/app\n" +
-		"9c88be57f94f\tapp_devcontainer-sfa.db-1\tUp 5 minutes\t"
+	mockOutput := psJSONLine("f7bc76eda682", "app_devcontainer-sfa.app-1", "Up 5 minutes", "/home/user/app") +
+		psJSONLine("9c88be57f94f", "app_devcontainer-sfa.db-1", "Up 5 minutes", "")
 
 	executor := exec.NewMockExecutor(t)
 	executor.EXPECT().Output(mock.Anything, mock.Anything, mock.Anything).Return([]byte(mockOutput), nil)
@@ -537,7 +853,7 @@ func TestListContainersIntegration_FlowWithRealParsing(t *testing.T) {
 		WithConfig(configMock),
 	)
 
-	err := containerCLI.ListContainersOfActiveDevcontainers()
+	err := containerCLI.ListDevcontainers(false)
 
 	r.Nil(err)
 }
@@ -556,13 +872,13 @@ func TestKillContainer_SuccessfulKillSingleContainer(t *testing.T) {
 	tryPathsFunc := func(p string, pather pather.Pather) []string {
 		return []string{p}
 	}
-	findMainFunc := func(tool, p string, executor exec.Executor) ([]string, error) {
+	findMainFunc := func(eng engine.Engine, p string) ([]string, error) {
 		return []string{"container123"}, nil
 	}
-	extractProjectFunc := func(tool, id string, executor exec.Executor) (string, error) {
+	extractProjectFunc := func(eng engine.Engine, id string) (string, error) {
 		return "", nil
 	}
-	findComposeFunc := func(tool, project string, executor exec.Executor) ([]string, error) {
+	findComposeFunc := func(eng engine.Engine, project string) ([]string, error) {
 		return []string{}, nil
 	}
 	dedupeFunc := func(m map[string]bool) []string {
@@ -588,7 +904,7 @@ func TestKillContainer_SuccessfulKillSingleContainer(t *testing.T) {
 		WithDeduplicateAndFilterContainerIDs(dedupeFunc),
 	)
 
-	err := containerCLI.KillContainer(path)
+	err := containerCLI.KillContainer(path, KillOptions{Yes: true})
 
 	r.Nil(err)
 	executor.AssertExpectations(t)
@@ -603,13 +919,13 @@ func TestKillContainer_SuccessfulKillMultipleContainers(t *testing.T) {
 	tryPathsFunc := func(p string, pather pather.Pather) []string {
 		return []string{p}
 	}
-	findMainFunc := func(tool, p string, executor exec.Executor) ([]string, error) {
+	findMainFunc := func(eng engine.Engine, p string) ([]string, error) {
 		return []string{"container1", "container2", "container3"}, nil
 	}
-	extractProjectFunc := func(tool, id string, executor exec.Executor) (string, error) {
+	extractProjectFunc := func(eng engine.Engine, id string) (string, error) {
 		return "", nil
 	}
-	findComposeFunc := func(tool, project string, executor exec.Executor) ([]string, error) {
+	findComposeFunc := func(eng engine.Engine, project string) ([]string, error) {
 		return []string{}, nil
 	}
 	dedupeFunc := func(m map[string]bool) []string {
@@ -634,7 +950,7 @@ func TestKillContainer_SuccessfulKillMultipleContainers(t *testing.T) {
 		WithDeduplicateAndFilterContainerIDs(dedupeFunc),
 	)
 
-	err := containerCLI.KillContainer(path)
+	err := containerCLI.KillContainer(path, KillOptions{Yes: true})
 
 	r.Nil(err)
 	executor.AssertExpectations(t)
@@ -649,13 +965,13 @@ func TestKillContainer_GetAllRelatedContainersReturnsError(t *testing.T) {
 	tryPathsFunc := func(p string, pather pather.Pather) []string {
 		return []string{p}
 	}
-	findMainFunc := func(tool, p string, executor exec.Executor) ([]string, error) {
+	findMainFunc := func(eng engine.Engine, p string) ([]string, error) {
 		return nil, fmt.Errorf("container not found")
 	}
-	extractProjectFunc := func(tool, id string, executor exec.Executor) (string, error) {
+	extractProjectFunc := func(eng engine.Engine, id string) (string, error) {
 		return "", nil
 	}
-	findComposeFunc := func(tool, project string, executor exec.Executor) ([]string, error) {
+	findComposeFunc := func(eng engine.Engine, project string) ([]string, error) {
 		return []string{}, nil
 	}
 	dedupeFunc := func(m map[string]bool) []string {
@@ -680,7 +996,7 @@ func TestKillContainer_GetAllRelatedContainersReturnsError(t *testing.T) {
 		WithDeduplicateAndFilterContainerIDs(dedupeFunc),
 	)
 
-	err := containerCLI.KillContainer(path)
+	err := containerCLI.KillContainer(path, KillOptions{Yes: true})
 
 	r.NotNil(err)
 	assert.ErrorContains(t, err, "container not found")
@@ -695,13 +1011,13 @@ func TestKillContainer_ExecutorRunReturnsError(t *testing.T) {
 	tryPathsFunc := func(p string, pather pather.Pather) []string {
 		return []string{p}
 	}
-	findMainFunc := func(tool, p string, executor exec.Executor) ([]string, error) {
+	findMainFunc := func(eng engine.Engine, p string) ([]string, error) {
 		return []string{"container123"}, nil
 	}
-	extractProjectFunc := func(tool, id string, executor exec.Executor) (string, error) {
+	extractProjectFunc := func(eng engine.Engine, id string) (string, error) {
 		return "", nil
 	}
-	findComposeFunc := func(tool, project string, executor exec.Executor) ([]string, error) {
+	findComposeFunc := func(eng engine.Engine, project string) ([]string, error) {
 		return []string{}, nil
 	}
 	dedupeFunc := func(m map[string]bool) []string {
@@ -726,7 +1042,7 @@ func TestKillContainer_ExecutorRunReturnsError(t *testing.T) {
 		WithDeduplicateAndFilterContainerIDs(dedupeFunc),
 	)
 
-	err := containerCLI.KillContainer(path)
+	err := containerCLI.KillContainer(path, KillOptions{Yes: true})
 
 	r.NotNil(err)
 	assert.ErrorContains(t, err, "docker error")
@@ -741,13 +1057,13 @@ func TestKillContainer_UsesCorrectToolFromConfig(t *testing.T) {
 	tryPathsFunc := func(p string, pather pather.Pather) []string {
 		return []string{p}
 	}
-	findMainFunc := func(tool, p string, executor exec.Executor) ([]string, error) {
+	findMainFunc := func(eng engine.Engine, p string) ([]string, error) {
 		return []string{"container123"}, nil
 	}
-	extractProjectFunc := func(tool, id string, executor exec.Executor) (string, error) {
+	extractProjectFunc := func(eng engine.Engine, id string) (string, error) {
 		return "", nil
 	}
-	findComposeFunc := func(tool, project string, executor exec.Executor) ([]string, error) {
+	findComposeFunc := func(eng engine.Engine, project string) ([]string, error) {
 		return []string{}, nil
 	}
 	dedupeFunc := func(m map[string]bool) []string {
@@ -758,6 +1074,7 @@ func TestKillContainer_UsesCorrectToolFromConfig(t *testing.T) {
 		return result
 	}
 
+	executor.EXPECT().Output("podman", []string{"container", "inspect", "--format", "{{.Pod}}", "container123"}).Return([]byte("\n"), nil)
 	executor.EXPECT().Run("podman", mock.Anything).Return(nil)
 
 	configMock := createMockConfigWithTool(t, "podman")
@@ -772,7 +1089,7 @@ func TestKillContainer_UsesCorrectToolFromConfig(t *testing.T) {
 		WithDeduplicateAndFilterContainerIDs(dedupeFunc),
 	)
 
-	err := containerCLI.KillContainer(path)
+	err := containerCLI.KillContainer(path, KillOptions{Yes: true})
 
 	r.Nil(err)
 	executor.AssertExpectations(t)
@@ -788,13 +1105,13 @@ func TestKillContainer_PassesCorrectArgsToExecutor(t *testing.T) {
 	tryPathsFunc := func(p string, pather pather.Pather) []string {
 		return []string{p}
 	}
-	findMainFunc := func(tool, p string, executor exec.Executor) ([]string, error) {
+	findMainFunc := func(eng engine.Engine, p string) ([]string, error) {
 		return []string{"abc123"}, nil
 	}
-	extractProjectFunc := func(tool, id string, executor exec.Executor) (string, error) {
+	extractProjectFunc := func(eng engine.Engine, id string) (string, error) {
 		return "", nil
 	}
-	findComposeFunc := func(tool, project string, executor exec.Executor) ([]string, error) {
+	findComposeFunc := func(eng engine.Engine, project string) ([]string, error) {
 		return []string{}, nil
 	}
 	dedupeFunc := func(m map[string]bool) []string {
@@ -821,7 +1138,7 @@ func TestKillContainer_PassesCorrectArgsToExecutor(t *testing.T) {
 		WithDeduplicateAndFilterContainerIDs(dedupeFunc),
 	)
 
-	err := containerCLI.KillContainer(path)
+	err := containerCLI.KillContainer(path, KillOptions{Yes: true})
 
 	r.Nil(err)
 	r.GreaterOrEqual(len(capturedArgs), 3)
@@ -830,509 +1147,1396 @@ func TestKillContainer_PassesCorrectArgsToExecutor(t *testing.T) {
 	r.Equal("-f", capturedArgs[2])
 }
 
-// ============================================================================
-// Tests for ShowLogs
-// ============================================================================
-
-func TestShowLogs_SuccessfulShowLogsWithoutFollow(t *testing.T) {
+func TestKillContainer_PodmanComposeStack_RemovesPod(t *testing.T) {
 	r := require.New(t)
 	path := "/home/user/project"
 
-	executor := exec.NewMockExecutor(t)
-
-	containerID := "container123"
-	executor.EXPECT().Output("docker", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]byte(containerID), nil)
-
-	executor.EXPECT().Run("docker", mock.Anything, mock.Anything).Return(nil)
-
-	configMock := createMockConfigWithTool(t, "docker")
+	executor := newPodmanComposeStackExecutor(t, path)
+	executor.EXPECT().Run("podman", []string{"pod", "rm", "-f", "pod123"}).Return(nil)
 
 	containerCLI := NewContainerCLI(
 		WithExecutor(executor),
-		WithConfig(configMock),
+		WithConfig(createMockConfigWithTool(t, "podman")),
+		WithTryPaths(func(p string, pather pather.Pather) []string {
+			return []string{p}
+		}),
 	)
 
-	err := containerCLI.ShowLogs(path, false)
+	err := containerCLI.KillContainer(path, KillOptions{Yes: true})
 
 	r.Nil(err)
-	executor.AssertExpectations(t)
 }
 
-func TestShowLogs_SuccessfulShowLogsWithFollow(t *testing.T) {
+func TestKillContainer_ContainersOutsidePod_RemovesContainersAndPods(t *testing.T) {
 	r := require.New(t)
 	path := "/home/user/project"
 
 	executor := exec.NewMockExecutor(t)
-
-	containerID := "container456"
-	executor.EXPECT().Output("docker", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]byte(containerID), nil)
-
-	executor.EXPECT().Run("docker", mock.Anything, mock.Anything, mock.Anything).Return(nil)
-
-	configMock := createMockConfigWithTool(t, "docker")
+	executor.EXPECT().Run("podman", []string{"pod", "rm", "-f", "pod123"}).Return(nil)
+	executor.EXPECT().Run("podman", []string{"rm", "-f", "app"}).Return(nil)
 
 	containerCLI := NewContainerCLI(
 		WithExecutor(executor),
-		WithConfig(configMock),
+		WithConfig(createMockConfigWithTool(t, "podman")),
+		WithTryPaths(func(p string, pather pather.Pather) []string {
+			return []string{p}
+		}),
+		WithFindMainContainersForPath(func(eng engine.Engine, p string) ([]string, error) {
+			return []string{"app"}, nil
+		}),
+		WithExtractProjectFromContainer(func(eng engine.Engine, id string) (string, error) {
+			return "", nil
+		}),
+		WithGroupContainersByPod(func(eng engine.Engine, ids []string) ([]string, []string, error) {
+			return []string{"app"}, []string{"pod123"}, nil
+		}),
 	)
 
-	err := containerCLI.ShowLogs(path, true)
+	err := containerCLI.KillContainer(path, KillOptions{Yes: true})
 
 	r.Nil(err)
-	executor.AssertExpectations(t)
 }
 
-func TestShowLogs_NoContainerFoundForPath(t *testing.T) {
+func TestKillContainer_Confirmed_RemovesContainers(t *testing.T) {
 	r := require.New(t)
-	path := "/home/user/project"
 
-	executor := exec.NewMockExecutor(t)
+	var question string
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(engine.ListOptions{All: true, Filters: []string{"id=app", "id=cache", "id=db"}}).Return(stoppedStack, nil)
+	eng.EXPECT().Inspect("app").Return(&engine.ContainerDetails{}, nil)
+	eng.EXPECT().Inspect("cache").Return(&engine.ContainerDetails{}, nil)
+	eng.EXPECT().Inspect("db").Return(&engine.ContainerDetails{Volumes: []string{"project_pgdata"}}, nil)
+	eng.EXPECT().Remove([]string{"app", "cache", "db"}).Return(nil).Once()
+
+	err := newWorkspaceContainerCLI(eng, stackIDs, "", nil,
+		WithIsTerminal(func() bool { return true }),
+		WithConfirm(func(q string) (bool, error) {
+			question = q
+			return true, nil
+		}),
+	).KillContainer("/home/user/project", KillOptions{})
+
+	r.NoError(err)
+	r.NotEmpty(question)
+}
 
-	executor.EXPECT().Output("docker", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]byte(""), nil)
+func TestKillContainer_Declined_RemovesNothing(t *testing.T) {
+	r := require.New(t)
 
-	configMock := createMockConfigWithTool(t, "docker")
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(mock.Anything).Return(stoppedStack, nil)
+	eng.EXPECT().Inspect(mock.Anything).Return(&engine.ContainerDetails{}, nil)
 
-	containerCLI := NewContainerCLI(
-		WithExecutor(executor),
-		WithConfig(configMock),
-	)
+	err := newWorkspaceContainerCLI(eng, stackIDs, "", nil,
+		WithIsTerminal(func() bool { return true }),
+		WithConfirm(func(string) (bool, error) { return false, nil }),
+	).KillContainer("/home/user/project", KillOptions{})
 
-	err := containerCLI.ShowLogs(path, false)
+	r.NoError(err)
+	eng.AssertNotCalled(t, "Remove", mock.Anything)
+}
 
-	r.NotNil(err)
-	assert.ErrorContains(t, err, "nenhum container encontrado")
+func TestKillContainer_NotATerminal_RequiresYes(t *testing.T) {
+	r := require.New(t)
+
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(mock.Anything).Return(stoppedStack, nil)
+	eng.EXPECT().Inspect(mock.Anything).Return(&engine.ContainerDetails{}, nil)
+
+	err := newWorkspaceContainerCLI(eng, stackIDs, "", nil,
+		WithIsTerminal(func() bool { return false }),
+		WithConfirm(func(string) (bool, error) {
+			t.Fatal("must not prompt without a terminal")
+			return false, nil
+		}),
+	).KillContainer("/home/user/project", KillOptions{})
+
+	r.ErrorContains(err, "--yes")
+	eng.AssertNotCalled(t, "Remove", mock.Anything)
 }
 
-func TestShowLogs_OutputCommandReturnsError(t *testing.T) {
+func TestKillContainer_DryRun_ListsContainersAndVolumes(t *testing.T) {
 	r := require.New(t)
-	path := "/home/user/project"
 
-	executor := exec.NewMockExecutor(t)
+	buf := &bytes.Buffer{}
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(mock.Anything).Return(stoppedStack, nil)
+	eng.EXPECT().Inspect("app").Return(&engine.ContainerDetails{}, nil)
+	eng.EXPECT().Inspect("cache").Return(&engine.ContainerDetails{}, nil)
+	eng.EXPECT().Inspect("db").Return(&engine.ContainerDetails{Volumes: []string{"project_pgdata"}}, nil)
+
+	err := newWorkspaceContainerCLI(eng, stackIDs, output.FormatJSON, buf).KillContainer("/home/user/project", KillOptions{DryRun: true, Yes: true})
+	r.NoError(err)
+
+	var affected []container_utils.AffectedContainer
+	r.NoError(json.Unmarshal(buf.Bytes(), &affected))
+	r.Len(affected, 3)
+	r.Equal(container_utils.AffectedContainer{
+		ID: "db", Name: "project_db_1", Service: "db", State: "exited", Volumes: []string{"project_pgdata"},
+	}, affected[2])
+	eng.AssertNotCalled(t, "Remove", mock.Anything)
+}
 
-	executor.EXPECT().Output("docker", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, fmt.Errorf("docker error"))
+// ============================================================================
+// Tests for DownContainer
+// ============================================================================
 
-	configMock := createMockConfigWithTool(t, "docker")
+func TestDownContainer_PodmanComposeStack_StopsPod(t *testing.T) {
+	r := require.New(t)
+	path := "/home/user/project"
+
+	executor := newPodmanComposeStackExecutor(t, path)
+	executor.EXPECT().Run("podman", []string{"pod", "stop", "pod123"}).Return(nil)
 
 	containerCLI := NewContainerCLI(
 		WithExecutor(executor),
-		WithConfig(configMock),
+		WithConfig(createMockConfigWithTool(t, "podman")),
+		WithTryPaths(func(p string, pather pather.Pather) []string {
+			return []string{p}
+		}),
 	)
 
-	err := containerCLI.ShowLogs(path, false)
+	err := containerCLI.DownContainer(path, DownOptions{})
 
-	r.NotNil(err)
-	assert.ErrorContains(t, err, "docker error")
+	r.Nil(err)
 }
 
-func TestShowLogs_RunLogsCommandReturnsError(t *testing.T) {
+func TestDownContainer_DockerCompose_StopsContainers(t *testing.T) {
 	r := require.New(t)
 	path := "/home/user/project"
 
 	executor := exec.NewMockExecutor(t)
-
-	containerID := "container789"
-	executor.EXPECT().Output("docker", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]byte(containerID), nil)
-
-	executor.EXPECT().Run("docker", mock.Anything, mock.Anything).Return(fmt.Errorf("logs error"))
-
-	configMock := createMockConfigWithTool(t, "docker")
+	executor.EXPECT().Run("docker", []string{"stop", "app"}).Return(nil)
 
 	containerCLI := NewContainerCLI(
 		WithExecutor(executor),
-		WithConfig(configMock),
+		WithConfig(createMockConfigWithTool(t, "docker")),
+		WithTryPaths(func(p string, pather pather.Pather) []string {
+			return []string{p}
+		}),
+		WithFindMainContainersForPath(func(eng engine.Engine, p string) ([]string, error) {
+			return []string{"app"}, nil
+		}),
+		WithExtractProjectFromContainer(func(eng engine.Engine, id string) (string, error) {
+			return "", nil
+		}),
 	)
 
-	err := containerCLI.ShowLogs(path, false)
+	err := containerCLI.DownContainer(path, DownOptions{})
 
-	r.NotNil(err)
-	assert.ErrorContains(t, err, "logs error")
+	r.Nil(err)
 }
 
-func TestShowLogs_UsesCorrectToolFromConfig(t *testing.T) {
+func TestDownContainer_GroupContainersByPodReturnsError(t *testing.T) {
 	r := require.New(t)
 	path := "/home/user/project"
 
 	executor := exec.NewMockExecutor(t)
 
-	containerID := "container123"
-	executor.EXPECT().Output("podman", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]byte(containerID), nil)
-
-	executor.EXPECT().Run("podman", mock.Anything, mock.Anything).Return(nil)
-
-	configMock := createMockConfigWithTool(t, "podman")
-
 	containerCLI := NewContainerCLI(
 		WithExecutor(executor),
-		WithConfig(configMock),
+		WithConfig(createMockConfigWithTool(t, "podman")),
+		WithTryPaths(func(p string, pather pather.Pather) []string {
+			return []string{p}
+		}),
+		WithFindMainContainersForPath(func(eng engine.Engine, p string) ([]string, error) {
+			return []string{"app"}, nil
+		}),
+		WithExtractProjectFromContainer(func(eng engine.Engine, id string) (string, error) {
+			return "", nil
+		}),
+		WithGroupContainersByPod(func(eng engine.Engine, ids []string) ([]string, []string, error) {
+			return nil, nil, fmt.Errorf("inspect error")
+		}),
 	)
 
-	err := containerCLI.ShowLogs(path, false)
+	err := containerCLI.DownContainer(path, DownOptions{})
 
-	r.Nil(err)
-	executor.AssertExpectations(t)
+	r.ErrorContains(err, "inspect error")
+	executor.AssertNotCalled(t, "Run")
 }
 
-func TestShowLogs_TrimsWhitespaceFromContainerID(t *testing.T) {
+func TestDownContainer_DryRun_ListsContainersWithoutStopping(t *testing.T) {
 	r := require.New(t)
-	path := "/home/user/project"
-
-	executor := exec.NewMockExecutor(t)
 
-	// Output has leading/trailing whitespace and newline
-	containerIDWithWhitespace := "  container123\n  "
+	buf := &bytes.Buffer{}
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(engine.ListOptions{All: true, Filters: []string{"id=app", "id=cache", "id=db"}}).Return(portsStack, nil)
 
-	executor.EXPECT().Output("docker", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]byte(containerIDWithWhitespace), nil)
+	err := newWorkspaceContainerCLI(eng, stackIDs, output.FormatJSON, buf).DownContainer("/home/user/project", DownOptions{DryRun: true})
+	r.NoError(err)
 
-	// Verify that the Run call uses variadic args (the trimmed ID will be in there)
-	executor.EXPECT().Run("docker", mock.Anything, mock.Anything).Return(nil)
+	var affected []container_utils.AffectedContainer
+	r.NoError(json.Unmarshal(buf.Bytes(), &affected))
+	r.Len(affected, 2)
+	r.Equal("running", affected[0].State)
+	r.Empty(affected[0].Volumes)
+	eng.AssertNotCalled(t, "Stop", mock.Anything)
+	eng.AssertNotCalled(t, "Inspect", mock.Anything)
+}
 
-	configMock := createMockConfigWithTool(t, "docker")
+// ============================================================================
+// Tests for StartContainer and RestartContainer
+// ============================================================================
 
-	containerCLI := NewContainerCLI(
-		WithExecutor(executor),
-		WithConfig(configMock),
+// stackIDs are the related containers of the workspace in most tests, the
+// first one being the devcontainer.
+var stackIDs = []string{"app", "db", "cache"}
+
+// newWorkspaceContainerCLI returns a ContainerCLI whose workspace has ids as
+// related containers, listed by the engine mock, and that renders data as
+// format into buf. Without a format the default renderer is kept.
+func newWorkspaceContainerCLI(eng *engine.MockEngine, ids []string, format output.Format, buf *bytes.Buffer, opts ...Option) ContainerCLI {
+	options := append(relatedContainersOptions(ids...),
+		WithEngine(eng),
+		WithGroupContainersByPod(func(eng engine.Engine, ids []string) ([]string, []string, error) {
+			return ids, nil, nil
+		}),
 	)
+	if format != "" {
+		options = append(options, WithRenderer(output.NewRenderer(output.WithFormat(format), output.WithWriter(buf))))
+	}
 
-	err := containerCLI.ShowLogs(path, false)
+	return NewContainerCLI(append(options, opts...)...)
+}
 
-	r.Nil(err)
-	executor.AssertExpectations(t)
+var stoppedStack = []*engine.Container{
+	{ID: "app", Names: "project_app_1", ComposeService: "app", LocalFolder: "/home/user/project", State: "exited",
+		Labels: map[string]string{"com.docker.compose.depends_on": "db:service_started:false,cache:service_started:false"}},
+	{ID: "cache", Names: "project_cache_1", ComposeService: "cache", State: "exited",
+		Labels: map[string]string{"com.docker.compose.depends_on": "db:service_healthy:false"}},
+	{ID: "db", Names: "project_db_1", ComposeService: "db", State: "exited",
+		Labels: map[string]string{"com.docker.compose.depends_on": ""}},
 }
 
-func TestShowLogs_FollowFlagAddedToArgs(t *testing.T) {
+func TestStartContainer_StartsInDependencyOrder(t *testing.T) {
 	r := require.New(t)
-	path := "/home/user/project"
 
-	executor := exec.NewMockExecutor(t)
-	var capturedArgs []string
+	var started [][]string
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(engine.ListOptions{All: true, Filters: []string{"id=app", "id=cache", "id=db"}}).Return(stoppedStack, nil)
+	eng.EXPECT().Start(mock.Anything).RunAndReturn(func(ids ...string) error {
+		started = append(started, ids)
+		return nil
+	})
 
-	containerID := "container123"
-	executor.EXPECT().Output("docker", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]byte(containerID), nil)
+	err := newWorkspaceContainerCLI(eng, stackIDs, "", nil).StartContainer("/home/user/project")
 
-	executor.EXPECT().Run("docker", mock.Anything, mock.Anything, mock.Anything).Run(func(name string, args ...string) {
-		capturedArgs = append([]string{name}, args...)
-	}).Return(nil)
+	r.Nil(err)
+	r.Equal([][]string{{"db"}, {"cache"}, {"app"}}, started)
+}
 
-	configMock := createMockConfigWithTool(t, "docker")
+func TestStartContainer_StartReturnsError_StopsStarting(t *testing.T) {
+	r := require.New(t)
 
-	containerCLI := NewContainerCLI(
-		WithExecutor(executor),
-		WithConfig(configMock),
-	)
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(mock.Anything).Return(stoppedStack, nil)
+	eng.EXPECT().Start([]string{"db"}).Return(fmt.Errorf("start error")).Once()
 
-	err := containerCLI.ShowLogs(path, true)
+	err := newWorkspaceContainerCLI(eng, stackIDs, "", nil).StartContainer("/home/user/project")
 
-	r.Nil(err)
-	r.GreaterOrEqual(len(capturedArgs), 3)
-	r.Equal("docker", capturedArgs[0])
-	r.Equal("logs", capturedArgs[1])
-	r.Equal("-f", capturedArgs[2])
-	r.Equal(containerID, capturedArgs[3])
+	r.ErrorContains(err, "start error")
 }
 
-func TestShowLogs_FilterConstructedCorrectly(t *testing.T) {
+func TestStartContainer_NoContainers_ReturnsError(t *testing.T) {
 	r := require.New(t)
-	path := "/home/user/myproject"
 
-	executor := exec.NewMockExecutor(t)
-	var capturedArgs []string
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(mock.Anything).Return(nil, nil)
 
-	containerID := "container123"
-	executor.EXPECT().Output("docker", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Run(func(name string, args ...string) {
-		capturedArgs = append([]string{name}, args...)
-	}).Return([]byte(containerID), nil)
+	err := newWorkspaceContainerCLI(eng, stackIDs, "", nil).StartContainer("/home/user/project")
 
-	executor.EXPECT().Run("docker", mock.Anything, mock.Anything).Return(nil)
+	r.ErrorContains(err, "nenhum container encontrado")
+}
 
-	configMock := createMockConfigWithTool(t, "docker")
+func TestRestartContainer_StopsThenStarts(t *testing.T) {
+	r := require.New(t)
 
-	containerCLI := NewContainerCLI(
-		WithExecutor(executor),
-		WithConfig(configMock),
-	)
+	var calls []string
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(mock.Anything).Return(stoppedStack, nil)
+	eng.EXPECT().Stop([]string{"app", "cache", "db"}).RunAndReturn(func(ids ...string) error {
+		calls = append(calls, "stop")
+		return nil
+	})
+	eng.EXPECT().Start(mock.Anything).RunAndReturn(func(ids ...string) error {
+		calls = append(calls, "start "+strings.Join(ids, ","))
+		return nil
+	})
 
-	err := containerCLI.ShowLogs(path, false)
+	err := newWorkspaceContainerCLI(eng, stackIDs, "", nil).RestartContainer("/home/user/project")
 
 	r.Nil(err)
-	expectedFilter := fmt.Sprintf("label=devcontainer.local_folder=%s", path)
-	// The 4th argument should be the filter
-	if len(capturedArgs) >= 5 {
-		r.Equal(expectedFilter, capturedArgs[4])
-	}
+	r.Equal([]string{"stop", "start db", "start cache", "start app"}, calls)
 }
 
-// ============================================================================
-// Tests for ListPorts
-// ============================================================================
-
-func TestListPorts_SuccessfulListPorts(t *testing.T) {
+func TestRestartContainer_StopReturnsError_DoesNotStart(t *testing.T) {
 	r := require.New(t)
-	path := "/home/user/project"
 
-	executor := exec.NewMockExecutor(t)
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(mock.Anything).Return(stoppedStack, nil)
+	eng.EXPECT().Stop(mock.Anything).Return(fmt.Errorf("stop error"))
 
-	containerID := "container123"
-	portsOutput := "8080/tcp -> 0.0.0.0:8080\n3000/tcp -> 0.0.0.0:3000"
+	err := newWorkspaceContainerCLI(eng, stackIDs, "", nil).RestartContainer("/home/user/project")
 
-	// First Output call gets the container ID
-	executor.EXPECT().Output("docker", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]byte(containerID), nil).Once()
+	r.ErrorContains(err, "stop error")
+	eng.AssertNotCalled(t, "Start", mock.Anything)
+}
 
-	// Second Output call gets the ports (uses variadic args)
-	executor.EXPECT().Output("docker", mock.Anything, mock.Anything).Return([]byte(portsOutput), nil).Once()
+// ============================================================================
+// Tests for RemoveContainers
+// ============================================================================
 
-	configMock := createMockConfigWithTool(t, "docker")
+func TestRemoveContainers_NoContainers_RemovesNothing(t *testing.T) {
+	r := require.New(t)
 
+	eng := engine.NewMockEngine(t)
 	containerCLI := NewContainerCLI(
-		WithExecutor(executor),
-		WithConfig(configMock),
+		WithEngine(eng),
+		WithTryPaths(func(p string, pather pather.Pather) []string {
+			return []string{p}
+		}),
+		WithFindMainContainersForPath(func(eng engine.Engine, p string) ([]string, error) {
+			return nil, nil
+		}),
 	)
 
-	err := containerCLI.ListPorts(path)
+	err := containerCLI.RemoveContainers("/home/user/project", RemoveOptions{Volumes: true})
 
 	r.Nil(err)
-	executor.AssertExpectations(t)
 }
 
-func TestListPorts_FirstOutputCommandReturnsError(t *testing.T) {
+func TestRemoveContainers_KeepsVolumesByDefault(t *testing.T) {
 	r := require.New(t)
-	path := "/home/user/project"
 
-	executor := exec.NewMockExecutor(t)
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().Remove([]string{"app", "cache", "db"}).Return(nil)
 
-	// First Output call fails
-	executor.EXPECT().Output("docker", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, fmt.Errorf("docker error")).Once()
+	err := newWorkspaceContainerCLI(eng, stackIDs, "", nil).RemoveContainers("/home/user/project", RemoveOptions{})
 
-	configMock := createMockConfigWithTool(t, "docker")
+	r.Nil(err)
+	eng.AssertNotCalled(t, "Inspect", mock.Anything)
+}
 
-	containerCLI := NewContainerCLI(
-		WithExecutor(executor),
-		WithConfig(configMock),
-	)
+func TestRemoveContainers_RemoveVolumes_RemovesMountedVolumes(t *testing.T) {
+	r := require.New(t)
 
-	err := containerCLI.ListPorts(path)
+	var calls []string
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(mock.Anything).Return(stoppedStack, nil)
+	eng.EXPECT().Inspect("app").Return(&engine.ContainerDetails{Volumes: []string{"project_node_modules"}}, nil)
+	eng.EXPECT().Inspect("cache").Return(&engine.ContainerDetails{}, nil)
+	eng.EXPECT().Inspect("db").Return(&engine.ContainerDetails{Volumes: []string{"project_pgdata", "project_node_modules"}}, nil)
+	eng.EXPECT().Remove([]string{"app", "cache", "db"}).RunAndReturn(func(ids ...string) error {
+		calls = append(calls, "rm")
+		return nil
+	})
+	eng.EXPECT().RemoveVolumes([]string{"project_node_modules", "project_pgdata"}).RunAndReturn(func(names ...string) error {
+		calls = append(calls, "volume rm")
+		return nil
+	})
+
+	var question string
+	err := newWorkspaceContainerCLI(eng, stackIDs, "", nil,
+		WithIsTerminal(func() bool { return true }),
+		WithConfirm(func(q string) (bool, error) {
+			question = q
+			return true, nil
+		}),
+	).RemoveContainers("/home/user/project", RemoveOptions{Volumes: true})
 
-	r.NotNil(err)
-	assert.ErrorContains(t, err, "docker error")
+	r.Nil(err)
+	r.Equal([]string{"rm", "volume rm"}, calls)
+	r.Contains(question, "volumes")
 }
 
-func TestListPorts_NoContainerFoundForPath(t *testing.T) {
+func TestRemoveContainers_RemoveVolumesCancelled_RemovesNothing(t *testing.T) {
 	r := require.New(t)
-	path := "/home/user/project"
 
-	executor := exec.NewMockExecutor(t)
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(mock.Anything).Return(stoppedStack, nil)
+	eng.EXPECT().Inspect(mock.Anything).Return(&engine.ContainerDetails{Volumes: []string{"project_pgdata"}}, nil)
 
-	// Container ID is empty
-	executor.EXPECT().Output("docker", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]byte(""), nil)
+	err := newWorkspaceContainerCLI(eng, stackIDs, "", nil,
+		WithIsTerminal(func() bool { return true }),
+		WithConfirm(func(q string) (bool, error) {
+			return false, nil
+		}),
+	).RemoveContainers("/home/user/project", RemoveOptions{Volumes: true})
 
-	configMock := createMockConfigWithTool(t, "docker")
+	r.ErrorContains(err, "cancelada")
+	eng.AssertNotCalled(t, "Remove", mock.Anything)
+	eng.AssertNotCalled(t, "RemoveVolumes", mock.Anything)
+}
 
-	containerCLI := NewContainerCLI(
-		WithExecutor(executor),
-		WithConfig(configMock),
-	)
+func TestRemoveContainers_RemoveVolumesNotATerminal_RequiresYes(t *testing.T) {
+	r := require.New(t)
 
-	err := containerCLI.ListPorts(path)
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(mock.Anything).Return(stoppedStack, nil)
+	eng.EXPECT().Inspect(mock.Anything).Return(&engine.ContainerDetails{Volumes: []string{"project_pgdata"}}, nil)
 
-	r.NotNil(err)
-	assert.ErrorContains(t, err, "nenhum container encontrado")
+	err := newWorkspaceContainerCLI(eng, stackIDs, "", nil,
+		WithIsTerminal(func() bool { return false }),
+	).RemoveContainers("/home/user/project", RemoveOptions{Volumes: true})
+
+	r.ErrorContains(err, "--yes")
+	eng.AssertNotCalled(t, "Remove", mock.Anything)
 }
 
-func TestListPorts_SecondOutputCommandReturnsError(t *testing.T) {
+func TestRemoveContainers_RemoveReturnsError_KeepsVolumes(t *testing.T) {
 	r := require.New(t)
-	path := "/home/user/project"
 
-	executor := exec.NewMockExecutor(t)
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(mock.Anything).Return(stoppedStack, nil)
+	eng.EXPECT().Inspect(mock.Anything).Return(&engine.ContainerDetails{Volumes: []string{"project_pgdata"}}, nil)
+	eng.EXPECT().Remove(mock.Anything).Return(fmt.Errorf("rm error"))
 
-	containerID := "container123"
+	err := newWorkspaceContainerCLI(eng, stackIDs, "", nil).RemoveContainers("/home/user/project", RemoveOptions{Volumes: true, Yes: true})
 
-	// First Output call succeeds
-	executor.EXPECT().Output("docker", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]byte(containerID), nil).Once()
+	r.ErrorContains(err, "rm error")
+	eng.AssertNotCalled(t, "RemoveVolumes", mock.Anything)
+}
 
-	// Second Output call fails
-	executor.EXPECT().Output("docker", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("port command error")).Once()
+// newPodmanComposeStackExecutor mocks a podman-compose stack where the
+// devcontainer "app" and the service "db" share the pod "pod123" and only
+// carry the podman-compose project label.
+func newPodmanComposeStackExecutor(t *testing.T, path string) *exec.MockExecutor {
+	executor := exec.NewMockExecutor(t)
 
-	configMock := createMockConfigWithTool(t, "docker")
+	executor.EXPECT().Output("podman", []string{"ps", "-a", "-q", "--filter", "label=devcontainer.local_folder=" + path}).Return([]byte("app\n"), nil)
+	executor.EXPECT().Output("podman", []string{"inspect", "app"}).Return([]byte(`[{"Id":"app","Name":"/myapp_app_1","Config":{"Labels":{"io.podman.compose.project":"myapp"}},"State":{"Status":"running"}}]`), nil)
+	executor.EXPECT().Output("podman", []string{"ps", "-a", "-q", "--filter", "label=io.podman.compose.project=myapp"}).Return([]byte("app\ndb\n"), nil)
+	executor.EXPECT().Output("podman", []string{"ps", "-a", "-q", "--filter", "label=com.docker.compose.project=myapp"}).Return([]byte(""), nil)
+	executor.EXPECT().Output("podman", mock.MatchedBy(func(args []string) bool {
+		return len(args) == 6 && args[0] == "container" && args[3] == "{{.Pod}}"
+	})).Return([]byte("pod123\npod123\n"), nil)
 
-	containerCLI := NewContainerCLI(
-		WithExecutor(executor),
-		WithConfig(configMock),
-	)
+	return executor
+}
 
-	err := containerCLI.ListPorts(path)
+// ============================================================================
+// Tests for ShowLogs
+// ============================================================================
 
-	r.NotNil(err)
-	assert.ErrorContains(t, err, "port command error")
-}
+// logsConfig returns a config mock with the default tail of the logs.
+func logsConfig(t *testing.T) *config.MockConfig {
+	t.Helper()
 
-func TestListPorts_UsesCorrectToolFromConfig(t *testing.T) {
-	r := require.New(t)
-	path := "/home/user/project"
+	cfg := config.GlobalConfig{}
+	cfg.Logs.Tail = 50
 
-	executor := exec.NewMockExecutor(t)
+	mockCfg := config.NewMockConfig(t)
+	mockCfg.EXPECT().Load().Return(cfg, nil).Maybe()
+	return mockCfg
+}
 
-	containerID := "container123"
-	portsOutput := "8080/tcp -> 0.0.0.0:8080"
+// relatedContainersOptions makes ids the related containers of any path,
+// the first one being the devcontainer.
+func relatedContainersOptions(ids ...string) []Option {
+	return []Option{
+		WithTryPaths(func(p string, pather pather.Pather) []string {
+			return []string{p}
+		}),
+		WithFindMainContainersForPath(func(eng engine.Engine, p string) ([]string, error) {
+			return ids[:1], nil
+		}),
+		WithExtractProjectFromContainer(func(eng engine.Engine, id string) (string, error) {
+			return "project", nil
+		}),
+		WithFindComposeContainersForProject(func(eng engine.Engine, project string) ([]string, error) {
+			return ids, nil
+		}),
+	}
+}
 
-	// Both calls should use "podman"
-	executor.EXPECT().Output("podman", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]byte(containerID), nil).Once()
-	executor.EXPECT().Output("podman", mock.Anything, mock.Anything).Return([]byte(portsOutput), nil).Once()
+// writeLogs mocks the logs of a container as the given output.
+func writeLogs(eng *engine.MockEngine, id string, out string) {
+	eng.EXPECT().Logs(id, mock.Anything).RunAndReturn(func(id string, opts engine.LogsOptions) error {
+		_, err := io.WriteString(opts.Output, out)
+		return err
+	})
+}
 
-	configMock := createMockConfigWithTool(t, "podman")
+var composeStack = []*engine.Container{
+	{ID: "db", Names: "project_db_1", ComposeService: "db"},
+	{ID: "app", Names: "project_app_1", ComposeService: "app", LocalFolder: "/home/user/project"},
+}
 
-	containerCLI := NewContainerCLI(
-		WithExecutor(executor),
-		WithConfig(configMock),
-	)
+func TestShowLogs_SingleContainer_WritesLinesWithoutPrefix(t *testing.T) {
+	r := require.New(t)
 
-	err := containerCLI.ListPorts(path)
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(engine.ListOptions{All: true, Filters: []string{"id=app"}}).Return(composeStack[1:], nil)
+	writeLogs(eng, "app", "starting\nlistening on 3000")
+
+	var buf bytes.Buffer
+	err := newWorkspaceContainerCLI(eng, []string{"app"}, output.FormatTable, &buf, WithConfig(logsConfig(t))).ShowLogs("/home/user/project", LogsOptions{})
 
 	r.Nil(err)
-	executor.AssertExpectations(t)
+	r.Equal("starting\nlistening on 3000\n", buf.String())
 }
 
-func TestListPorts_TrimsWhitespaceFromContainerID(t *testing.T) {
+func TestShowLogs_ComposeStack_PrefixesEveryService(t *testing.T) {
 	r := require.New(t)
-	path := "/home/user/project"
 
-	executor := exec.NewMockExecutor(t)
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(engine.ListOptions{All: true, Filters: []string{"id=app", "id=db"}}).Return(composeStack, nil)
+	writeLogs(eng, "app", "listening on 3000\n")
+	writeLogs(eng, "db", "ready to accept connections\n")
 
-	// Container ID with whitespace
-	containerIDWithWhitespace := "  container123\n  "
-	portsOutput := "8080/tcp -> 0.0.0.0:8080"
+	var buf bytes.Buffer
+	err := newWorkspaceContainerCLI(eng, []string{"app", "db"}, output.FormatTable, &buf, WithConfig(logsConfig(t))).ShowLogs("/home/user/project", LogsOptions{})
 
-	executor.EXPECT().Output("docker", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]byte(containerIDWithWhitespace), nil).Once()
+	r.Nil(err)
+	r.Contains(buf.String(), "app |\x1b[0m listening on 3000\n")
+	r.Contains(buf.String(), "db  |\x1b[0m ready to accept connections\n")
+}
 
-	// The Output call should use variadic args (the trimmed ID will be in there)
-	executor.EXPECT().Output("docker", mock.Anything, mock.Anything).Return([]byte(portsOutput), nil).Once()
+func TestShowLogs_Stderr_WrittenWithPrefixAndGrep(t *testing.T) {
+	r := require.New(t)
 
-	configMock := createMockConfigWithTool(t, "docker")
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(mock.Anything).Return(composeStack, nil)
+	writeLogs(eng, "app", "listening on 3000\n")
+	eng.EXPECT().Logs("db", mock.Anything).RunAndReturn(func(id string, opts engine.LogsOptions) error {
+		io.WriteString(opts.Output, "LOG: ready to accept connections\n")
+		_, err := io.WriteString(opts.ErrOutput, "ERROR: relation \"users\" does not exist\nLOG: checkpoint")
+		return err
+	})
 
-	containerCLI := NewContainerCLI(
-		WithExecutor(executor),
-		WithConfig(configMock),
-	)
+	var buf bytes.Buffer
+	err := newWorkspaceContainerCLI(eng, []string{"app", "db"}, output.FormatTable, &buf, WithConfig(logsConfig(t))).ShowLogs("/home/user/project", LogsOptions{Grep: "ERROR|LOG"})
 
-	err := containerCLI.ListPorts(path)
+	r.Nil(err)
+	r.Contains(buf.String(), "db  |\x1b[0m ERROR: relation \"users\" does not exist\n")
+	r.Contains(buf.String(), "db  |\x1b[0m LOG: checkpoint\n")
+	r.Contains(buf.String(), "db  |\x1b[0m LOG: ready to accept connections\n")
+	r.NotContains(buf.String(), "listening")
+}
+
+func TestShowLogs_PassesOptionsToEveryContainer(t *testing.T) {
+	r := require.New(t)
+
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(mock.Anything).Return(composeStack, nil)
+
+	var got []engine.LogsOptions
+	var mu sync.Mutex
+	eng.EXPECT().Logs(mock.Anything, mock.Anything).RunAndReturn(func(id string, opts engine.LogsOptions) error {
+		mu.Lock()
+		defer mu.Unlock()
+		got = append(got, opts)
+		return nil
+	}).Times(2)
+
+	var buf bytes.Buffer
+	err := newWorkspaceContainerCLI(eng, []string{"app", "db"}, output.FormatTable, &buf, WithConfig(logsConfig(t))).ShowLogs("/home/user/project", LogsOptions{
+		Follow:     true,
+		Tail:       10,
+		Since:      "10m",
+		Timestamps: true,
+	})
 
 	r.Nil(err)
-	executor.AssertExpectations(t)
+	r.Len(got, 2)
+	for _, opts := range got {
+		r.True(opts.Follow)
+		r.Equal(10, opts.Tail)
+		r.Equal("10m", opts.Since)
+		r.True(opts.Timestamps)
+	}
 }
 
-func TestListPorts_FilterConstructedCorrectly(t *testing.T) {
+func TestShowLogs_ConfiguredTailUsedWithoutFlag(t *testing.T) {
 	r := require.New(t)
-	path := "/home/user/myproject"
 
-	executor := exec.NewMockExecutor(t)
-	var capturedArgs []string
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(mock.Anything).Return(composeStack[1:], nil)
+	eng.EXPECT().Logs("app", mock.MatchedBy(func(opts engine.LogsOptions) bool {
+		return opts.Tail == 50
+	})).Return(nil)
 
-	containerID := "container123"
-	portsOutput := "8080/tcp -> 0.0.0.0:8080"
+	var buf bytes.Buffer
+	err := newWorkspaceContainerCLI(eng, []string{"app"}, output.FormatTable, &buf, WithConfig(logsConfig(t))).ShowLogs("/home/user/project", LogsOptions{})
 
-	executor.EXPECT().Output("docker", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Run(func(name string, args ...string) {
-		capturedArgs = append([]string{name}, args...)
-	}).Return([]byte(containerID), nil).Once()
+	r.Nil(err)
+}
 
-	executor.EXPECT().Output("docker", mock.Anything, mock.Anything).Return([]byte(portsOutput), nil).Once()
+func TestShowLogs_ServiceFilter_StreamsOnlyTheService(t *testing.T) {
+	r := require.New(t)
 
-	configMock := createMockConfigWithTool(t, "docker")
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(mock.Anything).Return(composeStack, nil)
+	writeLogs(eng, "db", "ready\n")
+
+	var buf bytes.Buffer
+	err := newWorkspaceContainerCLI(eng, []string{"app", "db"}, output.FormatTable, &buf, WithConfig(logsConfig(t))).ShowLogs("/home/user/project", LogsOptions{Services: []string{"db"}})
+
+	r.Nil(err)
+	r.Equal("ready\n", buf.String())
+}
+
+func TestShowLogs_UnknownService_ReturnsError(t *testing.T) {
+	r := require.New(t)
+
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(mock.Anything).Return(composeStack, nil)
+
+	var buf bytes.Buffer
+	err := newWorkspaceContainerCLI(eng, []string{"app", "db"}, output.FormatTable, &buf, WithConfig(logsConfig(t))).ShowLogs("/home/user/project", LogsOptions{Services: []string{"redis"}})
+
+	r.ErrorContains(err, `serviço "redis" não encontrado`)
+	r.ErrorContains(err, "app, db")
+	eng.AssertNotCalled(t, "Logs", mock.Anything, mock.Anything)
+}
+
+func TestShowLogs_Grep_KeepsMatchingLines(t *testing.T) {
+	r := require.New(t)
+
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(mock.Anything).Return(composeStack[1:], nil)
+	writeLogs(eng, "app", "GET /health 200\nPOST /login 500\nGET /users 500\n")
+
+	var buf bytes.Buffer
+	err := newWorkspaceContainerCLI(eng, []string{"app"}, output.FormatTable, &buf, WithConfig(logsConfig(t))).ShowLogs("/home/user/project", LogsOptions{Grep: " 5\\d\\d$"})
+
+	r.Nil(err)
+	r.Equal("POST /login 500\nGET /users 500\n", buf.String())
+}
+
+func TestShowLogs_InvalidGrep_ReturnsError(t *testing.T) {
+	r := require.New(t)
+
+	eng := engine.NewMockEngine(t)
+
+	var buf bytes.Buffer
+	err := newWorkspaceContainerCLI(eng, []string{"app"}, output.FormatTable, &buf, WithConfig(logsConfig(t))).ShowLogs("/home/user/project", LogsOptions{Grep: "("})
+
+	r.ErrorContains(err, "--grep")
+}
+
+func TestShowLogs_NoRelatedContainers_ReturnsError(t *testing.T) {
+	r := require.New(t)
 
 	containerCLI := NewContainerCLI(
-		WithExecutor(executor),
-		WithConfig(configMock),
+		WithEngine(engine.NewMockEngine(t)),
+		WithTryPaths(func(p string, pather pather.Pather) []string {
+			return []string{p}
+		}),
+		WithFindMainContainersForPath(func(eng engine.Engine, p string) ([]string, error) {
+			return nil, nil
+		}),
 	)
 
-	err := containerCLI.ListPorts(path)
+	err := containerCLI.ShowLogs("/home/user/project", LogsOptions{})
+
+	r.ErrorContains(err, "Nenhum container")
+}
+
+func TestShowLogs_ListContainersReturnsError(t *testing.T) {
+	r := require.New(t)
+
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(mock.Anything).Return(nil, fmt.Errorf("docker error"))
+
+	var buf bytes.Buffer
+	err := newWorkspaceContainerCLI(eng, []string{"app"}, output.FormatTable, &buf, WithConfig(logsConfig(t))).ShowLogs("/home/user/project", LogsOptions{})
+
+	r.ErrorContains(err, "docker error")
+}
+
+func TestShowLogs_LogsError_ReturnedAfterOtherStreamsEnd(t *testing.T) {
+	r := require.New(t)
+
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(mock.Anything).Return(composeStack, nil)
+	writeLogs(eng, "app", "listening\n")
+	eng.EXPECT().Logs("db", mock.Anything).Return(fmt.Errorf("logs error"))
+
+	var buf bytes.Buffer
+	err := newWorkspaceContainerCLI(eng, []string{"app", "db"}, output.FormatTable, &buf, WithConfig(logsConfig(t))).ShowLogs("/home/user/project", LogsOptions{})
+
+	r.ErrorContains(err, "logs de db: logs error")
+	r.Contains(buf.String(), "listening")
+}
+
+// ============================================================================
+// Tests for ListPorts
+// ============================================================================
+
+var portsStack = []*engine.Container{
+	{ID: "db", Names: "project_db_1", ComposeService: "db", State: "running"},
+	{ID: "app", Names: "project_app_1", ComposeService: "app", LocalFolder: "/home/user/project", State: "running"},
+}
+
+func TestListPorts_ListsPortsOfEveryService(t *testing.T) {
+	r := require.New(t)
+
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(engine.ListOptions{All: true, Filters: []string{"id=app", "id=db"}}).Return(portsStack, nil)
+	eng.EXPECT().Ports("app").Return("3000/tcp -> 0.0.0.0:3000\n", nil)
+	eng.EXPECT().Ports("db").Return("5432/tcp -> 127.0.0.1:15432\n", nil)
+
+	var buf bytes.Buffer
+	err := newWorkspaceContainerCLI(eng, []string{"app", "db"}, output.FormatJSON, &buf).ListPorts("/home/user/project")
 
 	r.Nil(err)
-	expectedFilter := fmt.Sprintf("label=devcontainer.local_folder=%s", path)
-	// The 4th argument should be the filter
-	if len(capturedArgs) >= 5 {
-		r.Equal(expectedFilter, capturedArgs[4])
+	var got []container_utils.PortMapping
+	r.Nil(json.Unmarshal(buf.Bytes(), &got))
+	r.Equal([]container_utils.PortMapping{
+		{Service: "app", Container: "app", ContainerPort: 3000, Protocol: "tcp", HostIP: "0.0.0.0", HostPort: 3000, Status: container_utils.PortPublished},
+		{Service: "db", Container: "db", ContainerPort: 5432, Protocol: "tcp", HostIP: "127.0.0.1", HostPort: 15432, Status: container_utils.PortPublished},
+	}, got)
+}
+
+func TestListPorts_SkipsStoppedContainers(t *testing.T) {
+	r := require.New(t)
+
+	stack := []*engine.Container{
+		{ID: "db", Names: "project_db_1", ComposeService: "db", State: "exited"},
+		portsStack[1],
 	}
+
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(mock.Anything).Return(stack, nil)
+	eng.EXPECT().Ports("app").Return("3000/tcp -> 0.0.0.0:3000\n", nil)
+
+	var buf bytes.Buffer
+	err := newWorkspaceContainerCLI(eng, []string{"app", "db"}, output.FormatTemplate, &buf).ListPorts("/home/user/project")
+
+	r.Nil(err)
+	eng.AssertNotCalled(t, "Ports", "db")
 }
 
-func TestListPorts_PortsOutputReturned(t *testing.T) {
+func TestListPorts_NoContainerFoundForPath(t *testing.T) {
+	r := require.New(t)
+
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(mock.Anything).Return([]*engine.Container{}, nil)
+
+	var buf bytes.Buffer
+	err := newWorkspaceContainerCLI(eng, []string{"app", "db"}, output.FormatTable, &buf).ListPorts("/home/user/project")
+
+	r.ErrorContains(err, "nenhum container encontrado")
+}
+
+func TestListPorts_ListContainersReturnsError(t *testing.T) {
+	r := require.New(t)
+
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(mock.Anything).Return(nil, fmt.Errorf("docker error"))
+
+	var buf bytes.Buffer
+	err := newWorkspaceContainerCLI(eng, []string{"app", "db"}, output.FormatTable, &buf).ListPorts("/home/user/project")
+
+	r.ErrorContains(err, "docker error")
+}
+
+func TestListPorts_PortsReturnsError(t *testing.T) {
+	r := require.New(t)
+
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(mock.Anything).Return(portsStack, nil)
+	eng.EXPECT().Ports("app").Return("", fmt.Errorf("port command error"))
+
+	var buf bytes.Buffer
+	err := newWorkspaceContainerCLI(eng, []string{"app", "db"}, output.FormatTable, &buf).ListPorts("/home/user/project")
+
+	r.ErrorContains(err, "port command error")
+}
+
+func TestListPorts_ChecksDeclaredPorts(t *testing.T) {
 	r := require.New(t)
 	path := "/home/user/project"
 
-	executor := exec.NewMockExecutor(t)
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(mock.Anything).Return(portsStack, nil)
+	eng.EXPECT().Ports("app").Return("3000/tcp -> 0.0.0.0:3000\n", nil)
+	eng.EXPECT().Ports("db").Return("", nil)
 
-	containerID := "container123"
-	portsOutput := "8080/tcp -> 0.0.0.0:8080\n3000/tcp -> 0.0.0.0:3000\n5432/tcp -> 0.0.0.0:5432"
+	cfg := &devcontainer.DevContainerConfiguration{}
+	cfg.Configuration.ForwardPorts = []any{float64(3000), "db:5432", float64(9229)}
 
-	executor.EXPECT().Output("docker", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]byte(containerID), nil).Once()
-	executor.EXPECT().Output("docker", mock.Anything, mock.Anything).Return([]byte(portsOutput), nil).Once()
+	devcontainerCLI := devcontainer.NewMockDevContainerCLI(t)
+	devcontainerCLI.EXPECT().ReadConfiguration(path).Return(cfg, nil)
 
-	configMock := createMockConfigWithTool(t, "docker")
+	var buf bytes.Buffer
+	err := newWorkspaceContainerCLI(eng, []string{"app", "db"}, output.FormatJSON, &buf,
+		WithDevContainerCLI(devcontainerCLI),
+		WithProbePort(func(port int) bool { return port == 5432 }),
+	).ListPorts(path)
+
+	r.Nil(err)
+	var got []container_utils.PortMapping
+	r.Nil(json.Unmarshal(buf.Bytes(), &got))
+	r.Equal([]container_utils.PortMapping{
+		{Service: "app", Container: "app", ContainerPort: 3000, Protocol: "tcp", HostIP: "0.0.0.0", HostPort: 3000, Declared: "forwardPorts", Status: container_utils.PortPublished},
+		{Service: "db", ContainerPort: 5432, Protocol: "tcp", HostIP: "localhost", HostPort: 5432, Declared: "forwardPorts", Status: container_utils.PortForwarded},
+		{Service: "app", ContainerPort: 9229, Protocol: "tcp", HostIP: "localhost", HostPort: 9229, Declared: "forwardPorts", Status: container_utils.PortUnreachable},
+	}, got)
+}
+
+func TestListPorts_ConfigurationError_ListsPublishedPorts(t *testing.T) {
+	r := require.New(t)
+	path := "/home/user/project"
+
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(mock.Anything).Return(portsStack[1:], nil)
+	eng.EXPECT().Ports("app").Return("3000/tcp -> 0.0.0.0:3000\n", nil)
+
+	devcontainerCLI := devcontainer.NewMockDevContainerCLI(t)
+	devcontainerCLI.EXPECT().ReadConfiguration(path).Return(nil, fmt.Errorf("read error"))
+
+	var buf bytes.Buffer
+	err := newWorkspaceContainerCLI(eng, []string{"app", "db"}, output.FormatTable, &buf, WithDevContainerCLI(devcontainerCLI)).ListPorts(path)
+
+	r.Nil(err)
+	r.Contains(buf.String(), "3000")
+}
+
+// ============================================================================
+// Tests for ForwardPorts
+// ============================================================================
+
+// startForward runs ForwardPorts in the background, returning its result
+// channel, the active forwards and a function sending Ctrl+C.
+func startForward(t *testing.T, eng *engine.MockEngine, forwards ...container_utils.PortForward) (<-chan error, []container_utils.PortForward, func()) {
+	notified := make(chan chan<- os.Signal, 1)
+	var buf bytes.Buffer
+
+	containerCLI := newWorkspaceContainerCLI(eng, []string{"app", "db"}, output.FormatJSON, &buf,
+		WithNotifyInterrupt(func(c chan<- os.Signal, sig ...os.Signal) {
+			notified <- c
+		}),
+	)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- containerCLI.ForwardPorts("/home/user/project", forwards)
+	}()
+
+	var interrupts chan<- os.Signal
+	select {
+	case interrupts = <-notified:
+	case err := <-done:
+		t.Fatalf("ForwardPorts returned before forwarding: %v", err)
+	}
+
+	var active []container_utils.PortForward
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &active))
+
+	return done, active, func() { interrupts <- os.Interrupt }
+}
+
+func TestForwardPorts_RelaysConnectionsIntoDevcontainer(t *testing.T) {
+	r := require.New(t)
+
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(mock.Anything).Return(portsStack, nil)
+	eng.EXPECT().Exec(mock.Anything, "app", mock.Anything).RunAndReturn(func(ctx context.Context, id string, opts engine.ExecOptions) error {
+		r.Equal(container_utils.RelayCommand(3000), opts.Command)
+		_, err := io.Copy(opts.Stdout, opts.Stdin)
+		return err
+	})
+
+	done, active, interrupt := startForward(t, eng, container_utils.PortForward{ContainerPort: 3000, HostIP: "127.0.0.1"})
+
+	r.Len(active, 1)
+	r.Equal(3000, active[0].ContainerPort)
+	r.NotZero(active[0].HostPort)
+
+	conn, err := net.Dial("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(active[0].HostPort)))
+	r.NoError(err)
+	_, err = conn.Write([]byte("ping"))
+	r.NoError(err)
+	r.NoError(conn.(*net.TCPConn).CloseWrite())
+
+	reply, err := io.ReadAll(conn)
+	r.NoError(err)
+	r.Equal("ping", string(reply))
+	conn.Close()
+
+	interrupt()
+	r.Nil(<-done)
+}
+
+func TestForwardPorts_Interrupt_StopsOpenConnections(t *testing.T) {
+	r := require.New(t)
+
+	relaying := make(chan struct{})
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(mock.Anything).Return(portsStack, nil)
+	eng.EXPECT().Exec(mock.Anything, "app", mock.Anything).RunAndReturn(func(ctx context.Context, id string, opts engine.ExecOptions) error {
+		close(relaying)
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	done, active, interrupt := startForward(t, eng, container_utils.PortForward{ContainerPort: 3000, HostIP: "127.0.0.1"})
+
+	conn, err := net.Dial("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(active[0].HostPort)))
+	r.NoError(err)
+	defer conn.Close()
+	<-relaying
+
+	interrupt()
+	r.Nil(<-done)
+
+	_, err = io.ReadAll(conn)
+	r.NoError(err, "the connection is closed when forwarding stops")
+}
+
+func TestForwardPorts_DevcontainerNotRunning_ReturnsError(t *testing.T) {
+	r := require.New(t)
+
+	stack := []*engine.Container{portsStack[0], {ID: "app", Names: "project_app_1", ComposeService: "app", LocalFolder: "/home/user/project", State: "exited"}}
+
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(mock.Anything).Return(stack, nil)
+
+	var buf bytes.Buffer
+	err := newWorkspaceContainerCLI(eng, []string{"app", "db"}, output.FormatTable, &buf).ForwardPorts("/home/user/project", []container_utils.PortForward{{ContainerPort: 3000, HostIP: "127.0.0.1", HostPort: 3000}})
+
+	r.ErrorContains(err, "não está em execução")
+}
+
+func TestForwardPorts_HostPortInUse_ReturnsError(t *testing.T) {
+	r := require.New(t)
+
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(mock.Anything).Return(portsStack, nil)
+
+	var addresses []string
+	var buf bytes.Buffer
+	err := newWorkspaceContainerCLI(eng, []string{"app", "db"}, output.FormatTable, &buf,
+		WithListen(func(network, address string) (net.Listener, error) {
+			addresses = append(addresses, address)
+			return nil, fmt.Errorf("address already in use")
+		}),
+	).ForwardPorts("/home/user/project", []container_utils.PortForward{{ContainerPort: 3000, HostIP: "127.0.0.1", HostPort: 13000}})
+
+	r.ErrorContains(err, "address already in use")
+	r.Equal([]string{"127.0.0.1:13000"}, addresses)
+	r.Empty(buf.String())
+}
+
+// ============================================================================
+// Tests for machine readable output
+// ============================================================================
+
+func TestListDevcontainers_JSONOutput_WritesContainers(t *testing.T) {
+	r := require.New(t)
 
+	mockOutput := psJSONLine("f7bc76eda682", "app_devcontainer-sfa.app-1", "Up 5 minutes", "/home/user/app") +
+		psJSONLine("9c88be57f94f", "app_devcontainer-sfa.db-1", "Up 5 minutes", "")
+
+	executor := exec.NewMockExecutor(t)
+	executor.EXPECT().Output("docker", mock.Anything).Return([]byte(mockOutput), nil)
+
+	var buf bytes.Buffer
 	containerCLI := NewContainerCLI(
 		WithExecutor(executor),
-		WithConfig(configMock),
+		WithConfig(createMockConfigWithTool(t, "docker")),
+		WithRenderer(output.NewRenderer(output.WithFormat(output.FormatJSON), output.WithWriter(&buf))),
 	)
 
-	err := containerCLI.ListPorts(path)
+	err := containerCLI.ListDevcontainers(false)
 
 	r.Nil(err)
-	executor.AssertExpectations(t)
+	var got []map[string]any
+	r.Nil(json.Unmarshal(buf.Bytes(), &got))
+	r.Len(got, 2)
+	r.Equal("/home/user/app", got[0]["workspace"])
+	r.Equal("app_devcontainer-sfa.app-1", got[0]["names"])
+	r.Equal("/home/user/app", got[1]["workspace"])
+	r.Equal("app_devcontainer-sfa.db-1", got[1]["names"])
 }
 
-func TestListPorts_NoPortsMappedButContainerExists(t *testing.T) {
+func TestListPorts_TemplateOutput_WritesPorts(t *testing.T) {
 	r := require.New(t)
-	path := "/home/user/project"
 
-	executor := exec.NewMockExecutor(t)
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(mock.Anything).Return(portsStack[1:], nil)
+	eng.EXPECT().Ports("app").Return("3000/tcp -> 0.0.0.0:3000\n8080/tcp -> 0.0.0.0:18080\n", nil)
+
+	var buf bytes.Buffer
+	containerCLI := newWorkspaceContainerCLI(eng, []string{"app"}, "", nil,
+		WithRenderer(output.NewRenderer(
+			output.WithFormat(output.FormatTemplate),
+			output.WithTemplate("{{.HostPort}}:{{.ContainerPort}}"),
+			output.WithWriter(&buf),
+		)),
+	)
 
-	containerID := "container123"
-	emptyPortsOutput := ""
+	err := containerCLI.ListPorts("/home/user/project")
 
-	executor.EXPECT().Output("docker", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]byte(containerID), nil).Once()
+	r.Nil(err)
+	r.Equal("3000:3000\n18080:8080\n", buf.String())
+}
 
-	// Even if no ports are mapped, the command succeeds
-	executor.EXPECT().Output("docker", mock.Anything, mock.Anything).Return([]byte(emptyPortsOutput), nil).Once()
+func TestShowLogs_JSONOutput_WritesOneObjectPerLine(t *testing.T) {
+	r := require.New(t)
 
-	configMock := createMockConfigWithTool(t, "docker")
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(mock.Anything).Return(composeStack[1:], nil)
+	writeLogs(eng, "app", "starting\nlistening on 3000")
+
+	var buf bytes.Buffer
+	err := newWorkspaceContainerCLI(eng, []string{"app"}, output.FormatJSON, &buf, WithConfig(logsConfig(t))).ShowLogs("/home/user/project", LogsOptions{})
+
+	r.Nil(err)
+	r.Equal(`{"container":"app","service":"app","line":"starting"}`+"\n"+
+		`{"container":"app","service":"app","line":"listening on 3000"}`+"\n", buf.String())
+}
+
+// ============================================================================
+// Tests for stats
+// ============================================================================
 
+func TestShowStats_SumsRunningContainersOfTheWorkspace(t *testing.T) {
+	r := require.New(t)
+	path := "/home/user/project"
+
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(engine.ListOptions{All: true, Filters: []string{"id=app", "id=db", "id=stopped"}}).Return([]*engine.Container{
+		{ID: "app", State: "running"},
+		{ID: "db", State: "running"},
+		{ID: "stopped", State: "exited"},
+	}, nil)
+	eng.EXPECT().Stats([]string{"app", "db"}).Return([]*engine.ContainerStats{
+		{ID: "app", Name: "project_app_1", CPUPercent: 10, MemoryUsage: 300 << 20, NetworkRx: 100},
+		{ID: "db", Name: "project_db_1", CPUPercent: 2.5, MemoryUsage: 200 << 20, NetworkRx: 50},
+	}, nil)
+
+	var buf bytes.Buffer
 	containerCLI := NewContainerCLI(
-		WithExecutor(executor),
-		WithConfig(configMock),
+		WithEngine(eng),
+		WithRenderer(output.NewRenderer(output.WithFormat(output.FormatJSON), output.WithWriter(&buf))),
+		WithTryPaths(func(p string, pather pather.Pather) []string {
+			return []string{p}
+		}),
+		WithFindMainContainersForPath(func(eng engine.Engine, p string) ([]string, error) {
+			return []string{"app"}, nil
+		}),
+		WithExtractProjectFromContainer(func(eng engine.Engine, id string) (string, error) {
+			return "project", nil
+		}),
+		WithFindComposeContainersForProject(func(eng engine.Engine, project string) ([]string, error) {
+			return []string{"app", "db", "stopped"}, nil
+		}),
 	)
 
-	err := containerCLI.ListPorts(path)
+	err := containerCLI.ShowStats(path)
 
 	r.Nil(err)
-	executor.AssertExpectations(t)
+	var got container_utils.WorkspaceStats
+	r.Nil(json.Unmarshal(buf.Bytes(), &got))
+	r.Equal(path, got.Workspace)
+	r.Equal(12.5, got.CPUPercent)
+	r.Equal(uint64(500<<20), got.MemoryUsage)
+	r.Equal(uint64(150), got.NetworkRx)
+	r.Len(got.Containers, 2)
 }
 
-func TestListPorts_CallsOutputTwiceInCorrectOrder(t *testing.T) {
+func TestShowStats_NoRunningContainers_ReturnsError(t *testing.T) {
 	r := require.New(t)
 	path := "/home/user/project"
 
-	executor := exec.NewMockExecutor(t)
-	var callOrder []string
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(engine.ListOptions{All: true, Filters: []string{"id=app"}}).Return([]*engine.Container{
+		{ID: "app", State: "exited"},
+	}, nil)
 
-	containerID := "container123"
-	portsOutput := "8080/tcp -> 0.0.0.0:8080"
+	containerCLI := NewContainerCLI(
+		WithEngine(eng),
+		WithTryPaths(func(p string, pather pather.Pather) []string {
+			return []string{p}
+		}),
+		WithFindMainContainersForPath(func(eng engine.Engine, p string) ([]string, error) {
+			return []string{"app"}, nil
+		}),
+		WithExtractProjectFromContainer(func(eng engine.Engine, id string) (string, error) {
+			return "", nil
+		}),
+	)
 
-	// First Output call
-	executor.EXPECT().Output("docker", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Run(func(name string, args ...string) {
-		callOrder = append(callOrder, "ps")
-	}).Return([]byte(containerID), nil).Once()
+	err := containerCLI.ShowStats(path)
 
-	// Second Output call
-	executor.EXPECT().Output("docker", mock.Anything, mock.Anything).Run(func(name string, args ...string) {
-		callOrder = append(callOrder, "port")
-	}).Return([]byte(portsOutput), nil).Once()
+	r.ErrorContains(err, "nenhum container em execução")
+	eng.AssertNotCalled(t, "Stats", mock.Anything)
+}
 
-	configMock := createMockConfigWithTool(t, "docker")
+func TestShowStats_StatsReturnsError(t *testing.T) {
+	r := require.New(t)
+	path := "/home/user/project"
+
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(mock.Anything).Return([]*engine.Container{{ID: "app", State: "running"}}, nil)
+	eng.EXPECT().Stats([]string{"app"}).Return(nil, fmt.Errorf("stats error"))
 
 	containerCLI := NewContainerCLI(
-		WithExecutor(executor),
-		WithConfig(configMock),
+		WithEngine(eng),
+		WithTryPaths(func(p string, pather pather.Pather) []string {
+			return []string{p}
+		}),
+		WithFindMainContainersForPath(func(eng engine.Engine, p string) ([]string, error) {
+			return []string{"app"}, nil
+		}),
+		WithExtractProjectFromContainer(func(eng engine.Engine, id string) (string, error) {
+			return "", nil
+		}),
+	)
+
+	err := containerCLI.ShowStats(path)
+
+	r.ErrorContains(err, "stats error")
+}
+
+func TestListDevcontainerStats_SamplesOnlyRunningContainers(t *testing.T) {
+	r := require.New(t)
+
+	containers := []*engine.Container{
+		{ID: "app", Names: "app_1", State: "running", LocalFolder: "/home/user/app", ComposeProject: "app"},
+		{ID: "db", Names: "app_db_1", State: "exited", ComposeProject: "app"},
+	}
+
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().Stats([]string{"app"}).Return([]*engine.ContainerStats{{ID: "app", MemoryUsage: 1 << 30}}, nil)
+
+	var gotAll bool
+	var buf bytes.Buffer
+	containerCLI := NewContainerCLI(
+		WithEngine(eng),
+		WithRenderer(output.NewRenderer(output.WithFormat(output.FormatTable), output.WithWriter(&buf))),
+		WithFindDevcontainers(func(eng engine.Engine, all bool) ([]*engine.Container, error) {
+			gotAll = all
+			return containers, nil
+		}),
 	)
 
-	err := containerCLI.ListPorts(path)
+	err := containerCLI.ListDevcontainerStats(true)
 
 	r.Nil(err)
-	r.Equal([]string{"ps", "port"}, callOrder)
-	executor.AssertExpectations(t)
+	r.True(gotAll)
+	r.Contains(buf.String(), "1.0GiB")
+	r.Contains(buf.String(), "/home/user/app")
+}
+
+func TestListDevcontainerStats_NothingRunning_SkipsStats(t *testing.T) {
+	r := require.New(t)
+
+	eng := engine.NewMockEngine(t)
+
+	var buf bytes.Buffer
+	containerCLI := NewContainerCLI(
+		WithEngine(eng),
+		WithRenderer(output.NewRenderer(output.WithFormat(output.FormatJSON), output.WithWriter(&buf))),
+		WithFindDevcontainers(func(eng engine.Engine, all bool) ([]*engine.Container, error) {
+			return nil, nil
+		}),
+	)
+
+	err := containerCLI.ListDevcontainerStats(false)
+
+	r.Nil(err)
+	r.Equal("[]\n", buf.String())
+}
+
+func TestListDevcontainerStats_FindDevcontainersReturnsError(t *testing.T) {
+	r := require.New(t)
+
+	containerCLI := NewContainerCLI(
+		WithEngine(engine.NewMockEngine(t)),
+		WithFindDevcontainers(func(eng engine.Engine, all bool) ([]*engine.Container, error) {
+			return nil, fmt.Errorf("ps error")
+		}),
+	)
+
+	err := containerCLI.ListDevcontainerStats(false)
+
+	r.ErrorContains(err, "ps error")
+}
+
+func TestGetWorkspaces_GroupsContainersWithTheirStats(t *testing.T) {
+	r := require.New(t)
+
+	containers := []*engine.Container{
+		{ID: "app", Names: "app_1", State: "running", LocalFolder: "/home/user/app", ComposeProject: "app"},
+		{ID: "db", Names: "app_db_1", State: "exited", ComposeProject: "app"},
+	}
+
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().Stats([]string{"app"}).Return([]*engine.ContainerStats{{ID: "app", MemoryUsage: 100}}, nil)
+
+	containerCLI := NewContainerCLI(
+		WithEngine(eng),
+		WithFindDevcontainers(func(eng engine.Engine, all bool) ([]*engine.Container, error) {
+			return containers, nil
+		}),
+	)
+
+	workspaces, err := containerCLI.GetWorkspaces(true)
+
+	r.Nil(err)
+	r.Len(workspaces, 1)
+	r.Equal("/home/user/app", workspaces[0].Path)
+	r.Len(workspaces[0].Containers, 2)
+	r.Equal(uint64(100), workspaces[0].Stats.MemoryUsage)
+	r.NotNil(workspaces[0].ContainerStats("app"))
+	r.Nil(workspaces[0].ContainerStats("db"))
+}
+
+func TestGetWorkspaces_StatsReturnsError(t *testing.T) {
+	r := require.New(t)
+
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().Stats(mock.Anything).Return(nil, fmt.Errorf("stats error"))
+
+	containerCLI := NewContainerCLI(
+		WithEngine(eng),
+		WithFindDevcontainers(func(eng engine.Engine, all bool) ([]*engine.Container, error) {
+			return []*engine.Container{{ID: "app", State: "running", LocalFolder: "/home/user/app"}}, nil
+		}),
+	)
+
+	_, err := containerCLI.GetWorkspaces(true)
+
+	r.ErrorContains(err, "stats error")
+}
+
+// ============================================================================
+// Tests for WaitContainers
+// ============================================================================
+
+var fastWaitOptions = WaitOptions{Timeout: time.Second, Interval: time.Millisecond}
+
+func TestWaitContainers_HealthyStack_ReportsReady(t *testing.T) {
+	r := require.New(t)
+
+	buf := &bytes.Buffer{}
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(mock.Anything).Return(stoppedStack, nil)
+	eng.EXPECT().Inspect("app").Return(&engine.ContainerDetails{State: "running"}, nil)
+	eng.EXPECT().Inspect("cache").Return(&engine.ContainerDetails{State: "running"}, nil)
+	eng.EXPECT().Inspect("db").Return(&engine.ContainerDetails{State: "running", Health: "healthy"}, nil)
+
+	err := newWorkspaceContainerCLI(eng, stackIDs, output.FormatJSON, buf).WaitContainers("/home/user/project", fastWaitOptions)
+
+	r.NoError(err)
+
+	var statuses []container_utils.ServiceStatus
+	r.NoError(json.Unmarshal(buf.Bytes(), &statuses))
+	r.Equal([]container_utils.ServiceStatus{
+		{Service: "app", Container: "project_app_1", State: "running", Ready: true},
+		{Service: "cache", Container: "project_cache_1", State: "running", Ready: true},
+		{Service: "db", Container: "project_db_1", State: "running", Health: "healthy", Ready: true},
+	}, statuses)
+}
+
+func TestWaitContainers_StartingHealthcheck_WaitsUntilHealthy(t *testing.T) {
+	r := require.New(t)
+
+	checks := 0
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(mock.Anything).Return(stoppedStack, nil)
+	eng.EXPECT().Inspect("app").Return(&engine.ContainerDetails{State: "running"}, nil)
+	eng.EXPECT().Inspect("cache").Return(&engine.ContainerDetails{State: "running"}, nil)
+	eng.EXPECT().Inspect("db").RunAndReturn(func(string) (*engine.ContainerDetails, error) {
+		checks++
+		if checks < 3 {
+			return &engine.ContainerDetails{State: "running", Health: "starting"}, nil
+		}
+		return &engine.ContainerDetails{State: "running", Health: "healthy"}, nil
+	})
+
+	err := newWorkspaceContainerCLI(eng, stackIDs, "", nil,
+		WithRenderer(output.NewRenderer(output.WithWriter(io.Discard))),
+	).WaitContainers("/home/user/project", fastWaitOptions)
+
+	r.NoError(err)
+	r.Equal(3, checks)
+}
+
+func TestWaitContainers_Timeout_ReturnsPendingServices(t *testing.T) {
+	r := require.New(t)
+
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(mock.Anything).Return(stoppedStack, nil)
+	eng.EXPECT().Inspect("app").Return(&engine.ContainerDetails{State: "running"}, nil)
+	eng.EXPECT().Inspect("cache").Return(&engine.ContainerDetails{State: "created"}, nil)
+	eng.EXPECT().Inspect("db").Return(&engine.ContainerDetails{State: "running", Health: "starting"}, nil)
+
+	err := newWorkspaceContainerCLI(eng, stackIDs, "", nil,
+		WithRenderer(output.NewRenderer(output.WithWriter(io.Discard))),
+	).WaitContainers("/home/user/project", WaitOptions{Timeout: 20 * time.Millisecond, Interval: time.Millisecond})
+
+	r.ErrorContains(err, "cache, db")
+}
+
+func TestWaitContainers_FailedServices_ReturnsErrorWithoutWaiting(t *testing.T) {
+	r := require.New(t)
+
+	buf := &bytes.Buffer{}
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(mock.Anything).Return(stoppedStack, nil)
+	eng.EXPECT().Inspect("app").Return(&engine.ContainerDetails{State: "running"}, nil).Once()
+	eng.EXPECT().Inspect("cache").Return(&engine.ContainerDetails{State: "exited", ExitCode: 1}, nil).Once()
+	eng.EXPECT().Inspect("db").Return(&engine.ContainerDetails{State: "running", Health: "unhealthy"}, nil).Once()
+
+	err := newWorkspaceContainerCLI(eng, stackIDs, output.FormatJSON, buf).WaitContainers("/home/user/project", WaitOptions{Timeout: time.Minute, Interval: time.Millisecond})
+
+	r.ErrorContains(err, "cache: exited (código 1), db: running (unhealthy)")
+
+	var statuses []container_utils.ServiceStatus
+	r.NoError(json.Unmarshal(buf.Bytes(), &statuses))
+	r.Len(statuses, 3)
+}
+
+func TestWaitContainers_OneShotServiceExitedSuccessfully_CountsAsDone(t *testing.T) {
+	r := require.New(t)
+
+	buf := &bytes.Buffer{}
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(mock.Anything).Return(stoppedStack, nil)
+	eng.EXPECT().Inspect("app").Return(&engine.ContainerDetails{State: "running"}, nil)
+	eng.EXPECT().Inspect("cache").Return(&engine.ContainerDetails{State: "exited", ExitCode: 0}, nil)
+	eng.EXPECT().Inspect("db").Return(&engine.ContainerDetails{State: "running", Health: "healthy"}, nil)
+
+	err := newWorkspaceContainerCLI(eng, stackIDs, output.FormatJSON, buf).WaitContainers("/home/user/project", fastWaitOptions)
+
+	r.NoError(err)
+
+	var statuses []container_utils.ServiceStatus
+	r.NoError(json.Unmarshal(buf.Bytes(), &statuses))
+	r.Equal(container_utils.ServiceStatus{Service: "cache", Container: "project_cache_1", State: "exited", Ready: true}, statuses[1])
+}
+
+func TestWaitContainers_DevcontainerExited_KeepsWaiting(t *testing.T) {
+	r := require.New(t)
+
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(mock.Anything).Return(stoppedStack, nil)
+	eng.EXPECT().Inspect("app").Return(&engine.ContainerDetails{State: "exited", ExitCode: 0}, nil)
+	eng.EXPECT().Inspect("cache").Return(&engine.ContainerDetails{State: "running"}, nil)
+	eng.EXPECT().Inspect("db").Return(&engine.ContainerDetails{State: "running"}, nil)
+
+	err := newWorkspaceContainerCLI(eng, stackIDs, "", nil,
+		WithRenderer(output.NewRenderer(output.WithWriter(io.Discard))),
+	).WaitContainers("/home/user/project", WaitOptions{Timeout: 20 * time.Millisecond, Interval: time.Millisecond})
+
+	r.ErrorContains(err, "app")
+	r.NotContains(err.Error(), "falharam")
+}
+
+func TestWaitContainers_InspectReturnsError_ReturnsError(t *testing.T) {
+	r := require.New(t)
+
+	eng := engine.NewMockEngine(t)
+	eng.EXPECT().ListContainers(mock.Anything).Return(stoppedStack, nil)
+	eng.EXPECT().Inspect("app").Return(nil, fmt.Errorf("inspect error"))
+
+	err := newWorkspaceContainerCLI(eng, stackIDs, "", nil).WaitContainers("/home/user/project", fastWaitOptions)
+
+	r.ErrorContains(err, "inspect error")
 }
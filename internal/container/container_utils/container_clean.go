@@ -0,0 +1,128 @@
+package container_utils
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Brennon-Oliveira/dev-cli/internal/engine"
+)
+
+// Types of the resources removed by `dev clean`.
+const (
+	CleanContainer = "container"
+	CleanVolume    = "volume"
+	CleanNetwork   = "network"
+	CleanImage     = "image"
+)
+
+var cleanTypeLabels = map[string]string{
+	CleanContainer: "container",
+	CleanVolume:    "volume",
+	CleanNetwork:   "rede",
+	CleanImage:     "imagem",
+}
+
+// devcontainerImagePrefix starts the names of the images built by the
+// devcontainer CLI, such as "vsc-app-3f2a...-uid".
+const devcontainerImagePrefix = "vsc-"
+
+// anonymousVolumePattern matches the generated names of anonymous volumes.
+var anonymousVolumePattern = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+type FormatCleanedResourcesFunc func(resources []CleanedResource, dryRun bool) string
+
+// StoppedWorkspaceContainers returns the containers of the workspaces with
+// no running container. Compose services are grouped by project, so a
+// stack is only cleaned as a whole.
+func StoppedWorkspaceContainers(containers []*Container) []*Container {
+	running := make(map[string]bool)
+	for _, container := range containers {
+		if container.State == "running" || container.State == "paused" {
+			running[workspaceKey(container)] = true
+		}
+	}
+
+	var stopped []*Container
+	for _, container := range containers {
+		if !running[workspaceKey(container)] {
+			stopped = append(stopped, container)
+		}
+	}
+
+	return stopped
+}
+
+func workspaceKey(container *Container) string {
+	if container.ComposeProject != "" {
+		return "project:" + container.ComposeProject
+	}
+
+	return "container:" + container.ID
+}
+
+// IsAnonymousVolume reports whether a volume has a generated name, as the
+// ones created for VOLUME instructions without a name.
+func IsAnonymousVolume(name string) bool {
+	return anonymousVolumePattern.MatchString(name)
+}
+
+// IsDevcontainerImage reports whether the image was built by the
+// devcontainer CLI.
+func IsDevcontainerImage(image *engine.Image) bool {
+	repository := image.Repository[strings.LastIndex(image.Repository, "/")+1:]
+	return strings.HasPrefix(repository, devcontainerImagePrefix)
+}
+
+// ImageInUse reports whether any of the containers was created from image,
+// referenced by name, name and tag or ID.
+func ImageInUse(image *engine.Image, containers []*Container) bool {
+	for _, container := range containers {
+		switch {
+		case container.Image == image.Repository,
+			container.Image == image.Repository+":"+image.Tag,
+			image.ID != "" && strings.HasPrefix(strings.TrimPrefix(container.Image, "sha256:"), image.ID):
+			return true
+		}
+	}
+
+	return false
+}
+
+// FormatCleanedResources renders the resources removed by `dev clean`, or
+// the ones that would be removed with dryRun. The total is the size of the
+// images only, an estimate: it includes layers shared with other images,
+// which stay on disk, and doesn't count containers and volumes.
+func FormatCleanedResources(resources []CleanedResource, dryRun bool) string {
+	if len(resources) == 0 {
+		return "Nenhum recurso de dev container para remover."
+	}
+
+	var output strings.Builder
+	line := "%-15s %-50s %s\n"
+	output.WriteString(fmt.Sprintf(line, "TYPE", "NAME", "IMAGE SIZE"))
+
+	var total uint64
+	for _, resource := range resources {
+		size := "-"
+		if resource.Size > 0 {
+			size = FormatBytes(resource.Size)
+		}
+		total += resource.Size
+
+		output.WriteString(fmt.Sprintf(line, cleanTypeLabels[resource.Type], resource.Name, size))
+	}
+
+	if dryRun {
+		output.WriteString(fmt.Sprintf("\n%d recurso(s) seriam removidos.", len(resources)))
+	} else {
+		output.WriteString(fmt.Sprintf("\n%d recurso(s) removidos.", len(resources)))
+	}
+
+	if total > 0 {
+		output.WriteString(fmt.Sprintf(" Tamanho das imagens: %s (estimativa; camadas compartilhadas com outras imagens continuam em disco).", FormatBytes(total)))
+	}
+	output.WriteString("\n")
+
+	return output.String()
+}
@@ -0,0 +1,105 @@
+package container_utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Brennon-Oliveira/dev-cli/internal/engine"
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================================
+// Tests for StoppedWorkspaceContainers
+// ============================================================================
+
+func TestStoppedWorkspaceContainers_KeepsWorkspacesWithARunningContainer(t *testing.T) {
+	r := require.New(t)
+
+	containers := []*Container{
+		{ID: "old-app", ComposeProject: "old", State: "exited"},
+		{ID: "old-db", ComposeProject: "old", State: "exited"},
+		{ID: "live-app", ComposeProject: "live", State: "exited"},
+		{ID: "live-db", ComposeProject: "live", State: "running"},
+		{ID: "paused", State: "paused"},
+		{ID: "single", State: "created"},
+	}
+
+	stopped := StoppedWorkspaceContainers(containers)
+
+	var ids []string
+	for _, container := range stopped {
+		ids = append(ids, container.ID)
+	}
+	r.Equal([]string{"old-app", "old-db", "single"}, ids)
+}
+
+// ============================================================================
+// Tests for IsAnonymousVolume, IsDevcontainerImage and ImageInUse
+// ============================================================================
+
+func TestIsAnonymousVolume(t *testing.T) {
+	r := require.New(t)
+
+	r.True(IsAnonymousVolume(strings.Repeat("a1", 32)))
+	r.False(IsAnonymousVolume("project_pgdata"))
+	r.False(IsAnonymousVolume(strings.Repeat("A1", 32)))
+}
+
+func TestIsDevcontainerImage(t *testing.T) {
+	r := require.New(t)
+
+	r.True(IsDevcontainerImage(&engine.Image{Repository: "vsc-project-1a2b-uid"}))
+	r.True(IsDevcontainerImage(&engine.Image{Repository: "localhost/vsc-project-1a2b-uid"}))
+	r.False(IsDevcontainerImage(&engine.Image{Repository: "mcr.microsoft.com/devcontainers/go"}))
+}
+
+func TestImageInUse(t *testing.T) {
+	r := require.New(t)
+
+	image := &engine.Image{ID: "1a2b3c4d5e6f", Repository: "vsc-project-1a2b-uid", Tag: "latest"}
+
+	r.True(ImageInUse(image, []*Container{{Image: "vsc-project-1a2b-uid"}}))
+	r.True(ImageInUse(image, []*Container{{Image: "vsc-project-1a2b-uid:latest"}}))
+	r.True(ImageInUse(image, []*Container{{Image: "sha256:1a2b3c4d5e6f7a8b"}}))
+	r.False(ImageInUse(image, []*Container{{Image: "postgres:16"}}))
+	r.False(ImageInUse(image, nil))
+}
+
+// ============================================================================
+// Tests for FormatCleanedResources
+// ============================================================================
+
+func TestFormatCleanedResources_NoResources(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal("Nenhum recurso de dev container para remover.", FormatCleanedResources(nil, false))
+}
+
+func TestFormatCleanedResources_WritesRowsAndReclaimedSpace(t *testing.T) {
+	r := require.New(t)
+
+	resources := []CleanedResource{
+		{Type: CleanContainer, ID: "abc", Name: "project_app_1"},
+		{Type: CleanImage, ID: "def", Name: "vsc-project-1a2b-uid:latest", Size: 1536},
+		{Type: CleanImage, ID: "ghi", Name: "vsc-other-3c4d-uid:latest", Size: 512},
+	}
+
+	lines := strings.Split(strings.TrimSpace(FormatCleanedResources(resources, false)), "\n")
+	r.Len(lines, 6)
+	r.Contains(lines[0], "TYPE")
+	r.Regexp(`^container\s+project_app_1\s+-$`, lines[1])
+	r.Regexp(`^imagem\s+vsc-project-1a2b-uid:latest\s+\S+`, lines[2])
+	r.Equal("3 recurso(s) removidos. Tamanho das imagens: 2.0KiB (estimativa; camadas compartilhadas com outras imagens continuam em disco).", lines[5])
+
+	dryRun := FormatCleanedResources(resources, true)
+	r.Contains(dryRun, "3 recurso(s) seriam removidos. Tamanho das imagens")
+}
+
+func TestFormatCleanedResources_WithoutImages_OmitsSize(t *testing.T) {
+	r := require.New(t)
+
+	out := FormatCleanedResources([]CleanedResource{{Type: CleanContainer, ID: "abc", Name: "project_app_1"}}, false)
+
+	r.Contains(out, "1 recurso(s) removidos.\n")
+	r.NotContains(out, "Tamanho")
+}
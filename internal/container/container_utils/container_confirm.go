@@ -0,0 +1,64 @@
+package container_utils
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/chzyer/readline"
+	"github.com/manifoldco/promptui"
+)
+
+type ConfirmFunc func(question string) (bool, error)
+
+type IsTerminalFunc func() bool
+
+type FormatAffectedContainersFunc func(containers []AffectedContainer) string
+
+// Confirm asks a yes or no question on the terminal. Anything but a yes,
+// including Ctrl+C, is a no.
+func Confirm(question string) (bool, error) {
+	prompt := promptui.Prompt{
+		Label:     question,
+		IsConfirm: true,
+	}
+
+	if _, err := prompt.Run(); err != nil {
+		if errors.Is(err, promptui.ErrAbort) || errors.Is(err, promptui.ErrInterrupt) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// StdinIsTerminal reports whether stdin is a terminal a prompt can be
+// answered on.
+func StdinIsTerminal() bool {
+	return readline.IsTerminal(int(os.Stdin.Fd()))
+}
+
+// FormatAffectedContainers renders the containers a destructive command
+// changes, with the volumes attached to them.
+func FormatAffectedContainers(containers []AffectedContainer) string {
+	if len(containers) == 0 {
+		return "Nenhum container encontrado."
+	}
+
+	var output strings.Builder
+	line := "%-20s %-35s %-10s %s\n"
+	output.WriteString(fmt.Sprintf(line, "SERVICE", "NAME", "STATE", "VOLUMES"))
+
+	for _, container := range containers {
+		volumes := "-"
+		if len(container.Volumes) > 0 {
+			volumes = strings.Join(container.Volumes, ", ")
+		}
+
+		output.WriteString(fmt.Sprintf(line, container.Service, container.Name, container.State, volumes))
+	}
+
+	return output.String()
+}
@@ -0,0 +1,33 @@
+package container_utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================================
+// Tests for FormatAffectedContainers
+// ============================================================================
+
+func TestFormatAffectedContainers_NoContainers(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal("Nenhum container encontrado.", FormatAffectedContainers(nil))
+}
+
+func TestFormatAffectedContainers_WritesServicesAndVolumes(t *testing.T) {
+	r := require.New(t)
+
+	out := FormatAffectedContainers([]AffectedContainer{
+		{ID: "app", Name: "project_app_1", Service: "app", State: "running"},
+		{ID: "db", Name: "project_db_1", Service: "db", State: "running", Volumes: []string{"project_pgdata", "project_logs"}},
+	})
+
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	r.Len(lines, 3)
+	r.Regexp(`^SERVICE\s+NAME\s+STATE\s+VOLUMES$`, lines[0])
+	r.Regexp(`^app\s+project_app_1\s+running\s+-$`, lines[1])
+	r.Regexp(`^db\s+project_db_1\s+running\s+project_pgdata, project_logs$`, lines[2])
+}
@@ -0,0 +1,82 @@
+package container_utils
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// ForwardHostIP is the host address `dev forward` listens on, so forwarded
+// ports are not exposed to the network.
+const ForwardHostIP = "127.0.0.1"
+
+// relayScript connects its stdio to a port of the container with the first
+// tool available in the image.
+const relayScript = `port="$1"
+if command -v socat >/dev/null 2>&1; then exec socat - "TCP:127.0.0.1:$port"; fi
+if command -v nc >/dev/null 2>&1; then exec nc 127.0.0.1 "$port"; fi
+if command -v bash >/dev/null 2>&1; then exec bash -c 'exec 3<>"/dev/tcp/127.0.0.1/$1" || exit 1; cat <&3 & cat >&3; wait' relay "$port"; fi
+echo "socat, nc ou bash não encontrados no container" >&2
+exit 127`
+
+type FormatForwardsFunc func(forwards []PortForward) string
+type ListenFunc func(network, address string) (net.Listener, error)
+type NotifyFunc func(c chan<- os.Signal, sig ...os.Signal)
+
+// ParsePortForward parses a `containerPort[:hostPort]` argument. The host
+// port defaults to the container port.
+func ParsePortForward(spec string) (PortForward, error) {
+	containerSpec, hostSpec, hasHost := strings.Cut(spec, ":")
+
+	containerPort, err := parsePortNumber(containerSpec)
+	if err != nil {
+		return PortForward{}, fmt.Errorf("porta inválida %q: use <porta do container>[:porta do host]", spec)
+	}
+
+	hostPort := containerPort
+	if hasHost {
+		hostPort, err = parsePortNumber(hostSpec)
+		if err != nil {
+			return PortForward{}, fmt.Errorf("porta inválida %q: use <porta do container>[:porta do host]", spec)
+		}
+	}
+
+	return PortForward{ContainerPort: containerPort, HostIP: ForwardHostIP, HostPort: hostPort}, nil
+}
+
+func parsePortNumber(s string) (int, error) {
+	port, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, err
+	}
+
+	if port < 1 || port > 65535 {
+		return 0, fmt.Errorf("porta fora do intervalo: %d", port)
+	}
+
+	return port, nil
+}
+
+// RelayCommand is run in the container for each forwarded connection,
+// relaying its stdio to port.
+func RelayCommand(port int) []string {
+	return []string{"sh", "-c", relayScript, "dev-forward", strconv.Itoa(port)}
+}
+
+// FormatForwards renders the active forwards of `dev forward`.
+func FormatForwards(forwards []PortForward) string {
+	var output strings.Builder
+	line := "%-22s %s\n"
+	output.WriteString(fmt.Sprintf(line, "HOST", "CONTAINER PORT"))
+
+	for _, forward := range forwards {
+		output.WriteString(fmt.Sprintf(line,
+			net.JoinHostPort(forward.HostIP, strconv.Itoa(forward.HostPort)),
+			strconv.Itoa(forward.ContainerPort),
+		))
+	}
+
+	return output.String()
+}
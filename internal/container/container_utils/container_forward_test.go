@@ -0,0 +1,65 @@
+package container_utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================================
+// Tests for ParsePortForward
+// ============================================================================
+
+func TestParsePortForward(t *testing.T) {
+	tests := []struct {
+		spec     string
+		expected PortForward
+		wantErr  bool
+	}{
+		{spec: "3000", expected: PortForward{ContainerPort: 3000, HostIP: ForwardHostIP, HostPort: 3000}},
+		{spec: "5432:15432", expected: PortForward{ContainerPort: 5432, HostIP: ForwardHostIP, HostPort: 15432}},
+		{spec: "abc", wantErr: true},
+		{spec: "3000:", wantErr: true},
+		{spec: "0", wantErr: true},
+		{spec: "3000:70000", wantErr: true},
+		{spec: "./project", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			r := require.New(t)
+
+			forward, err := ParsePortForward(tt.spec)
+
+			if tt.wantErr {
+				r.ErrorContains(err, "porta inválida")
+				return
+			}
+			r.Nil(err)
+			r.Equal(tt.expected, forward)
+		})
+	}
+}
+
+// ============================================================================
+// Tests for RelayCommand and FormatForwards
+// ============================================================================
+
+func TestRelayCommand_PassesPortToScript(t *testing.T) {
+	r := require.New(t)
+
+	command := RelayCommand(5432)
+
+	r.Equal([]string{"sh", "-c", relayScript, "dev-forward", "5432"}, command)
+}
+
+func TestFormatForwards_WritesHostAndContainerPorts(t *testing.T) {
+	r := require.New(t)
+
+	out := FormatForwards([]PortForward{
+		{ContainerPort: 3000, HostIP: "127.0.0.1", HostPort: 3000},
+		{ContainerPort: 5432, HostIP: "127.0.0.1", HostPort: 15432},
+	})
+
+	r.Regexp(`^HOST\s+CONTAINER PORT\n127\.0\.0\.1:3000\s+3000\n127\.0\.0\.1:15432\s+5432\n$`, out)
+}
@@ -0,0 +1,175 @@
+package container_utils
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	loggerutils "github.com/Brennon-Oliveira/dev-cli/internal/logger/logger_utils"
+)
+
+// logColors are the colors of the service prefixes, assigned in order.
+var logColors = []string{
+	loggerutils.RegularCyanColor,
+	loggerutils.RegularYellowColor,
+	loggerutils.RegularGreenColor,
+	loggerutils.RegularPurpleColor,
+	loggerutils.RegularBlueColor,
+	loggerutils.HighIntensityCyanColor,
+	loggerutils.HighIntensityYellowColor,
+	loggerutils.HighIntensityGreenColor,
+	loggerutils.HighIntensityPurpleColor,
+	loggerutils.HighIntensityBlueColor,
+}
+
+// ServiceName names a container in the logs: its compose service or,
+// without one, its first name.
+func ServiceName(container *Container) string {
+	if container.ComposeService != "" {
+		return container.ComposeService
+	}
+
+	name, _, _ := strings.Cut(container.Names, ",")
+	return name
+}
+
+// FilterServices keeps the containers of the given services, matched by
+// compose service or container name, with the devcontainer first. Without
+// services, all containers are kept.
+func FilterServices(containers []*Container, services []string) ([]*Container, error) {
+	containers = sortedGroup(containers)
+	if len(services) == 0 {
+		return containers, nil
+	}
+
+	var filtered []*Container
+	for _, service := range services {
+		found := false
+		for _, container := range containers {
+			if container.ComposeService == service || slices.Contains(strings.Split(container.Names, ","), service) {
+				found = true
+				if !slices.Contains(filtered, container) {
+					filtered = append(filtered, container)
+				}
+			}
+		}
+
+		if !found {
+			var available []string
+			for _, container := range containers {
+				available = append(available, ServiceName(container))
+			}
+
+			return nil, fmt.Errorf("serviço %q não encontrado. Serviços disponíveis: %s", service, strings.Join(available, ", "))
+		}
+	}
+
+	return sortedGroup(filtered), nil
+}
+
+// LogPrefixes returns the colored `service |` prefix of each container,
+// padded to the longest service name.
+func LogPrefixes(containers []*Container) map[string]string {
+	width := 0
+	for _, container := range containers {
+		width = max(width, len(ServiceName(container)))
+	}
+
+	prefixes := make(map[string]string, len(containers))
+	for i, container := range containers {
+		color := logColors[i%len(logColors)]
+		prefixes[container.ID] = fmt.Sprintf("%s%-*s |%s ", color, width, ServiceName(container), loggerutils.ResetColor)
+	}
+
+	return prefixes
+}
+
+// LogMultiplexer merges the log streams of several containers. Lines are
+// written whole, so concurrent streams never interleave within a line.
+type LogMultiplexer struct {
+	mu     sync.Mutex
+	grep   *regexp.Regexp
+	closed bool
+}
+
+// NewLogMultiplexer returns a multiplexer that keeps only the lines
+// matching grep, when set.
+func NewLogMultiplexer(grep *regexp.Regexp) *LogMultiplexer {
+	return &LogMultiplexer{grep: grep}
+}
+
+// Stream returns the writer of a container log stream. Each kept line is
+// written to dst after prefix.
+func (m *LogMultiplexer) Stream(dst io.WriteCloser, prefix string) io.WriteCloser {
+	return &logStream{mux: m, dst: dst, prefix: prefix}
+}
+
+// Close drops whatever the streams write from now on, for streams that
+// may outlive the command, like on Ctrl+C.
+func (m *LogMultiplexer) Close() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.closed = true
+}
+
+type logStream struct {
+	mux    *LogMultiplexer
+	dst    io.WriteCloser
+	prefix string
+	buf    []byte
+}
+
+func (s *logStream) Write(p []byte) (int, error) {
+	s.mux.mu.Lock()
+	defer s.mux.mu.Unlock()
+
+	s.buf = append(s.buf, p...)
+	for {
+		i := bytes.IndexByte(s.buf, '\n')
+		if i < 0 {
+			return len(p), nil
+		}
+
+		line := strings.TrimSuffix(string(s.buf[:i]), "\r")
+		s.buf = s.buf[i+1:]
+
+		if err := s.writeLine(line); err != nil {
+			return len(p), err
+		}
+	}
+}
+
+// Close writes the last line when it has no line break.
+func (s *logStream) Close() error {
+	s.mux.mu.Lock()
+	defer s.mux.mu.Unlock()
+
+	if len(s.buf) > 0 {
+		line := string(s.buf)
+		s.buf = nil
+
+		if err := s.writeLine(line); err != nil {
+			return err
+		}
+	}
+
+	if s.mux.closed {
+		return nil
+	}
+
+	return s.dst.Close()
+}
+
+func (s *logStream) writeLine(line string) error {
+	if s.mux.closed || (s.mux.grep != nil && !s.mux.grep.MatchString(line)) {
+		return nil
+	}
+
+	_, err := io.WriteString(s.dst, s.prefix+line+"\n")
+	return err
+}
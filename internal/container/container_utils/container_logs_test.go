@@ -0,0 +1,156 @@
+package container_utils
+
+import (
+	"bytes"
+	"io"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type nopWriteCloser struct {
+	io.Writer
+	closed bool
+}
+
+func (w *nopWriteCloser) Close() error {
+	w.closed = true
+	return nil
+}
+
+// ============================================================================
+// Tests for ServiceName and FilterServices
+// ============================================================================
+
+func TestServiceName_PrefersComposeService(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal("db", ServiceName(&Container{Names: "project_db_1", ComposeService: "db"}))
+	r.Equal("app", ServiceName(&Container{Names: "app,alias"}))
+}
+
+func TestFilterServices_NoServices_KeepsAllWithDevcontainerFirst(t *testing.T) {
+	r := require.New(t)
+
+	containers := []*Container{
+		{ID: "db", Names: "db"},
+		{ID: "app", Names: "app", LocalFolder: "/home/user/app"},
+	}
+
+	got, err := FilterServices(containers, nil)
+
+	r.Nil(err)
+	r.Equal("app", got[0].ID)
+	r.Equal("db", got[1].ID)
+}
+
+func TestFilterServices_MatchesServiceOrName(t *testing.T) {
+	r := require.New(t)
+
+	containers := []*Container{
+		{ID: "db", Names: "project_db_1", ComposeService: "db"},
+		{ID: "cache", Names: "project_cache_1", ComposeService: "cache"},
+		{ID: "app", Names: "project_app_1", ComposeService: "app"},
+	}
+
+	got, err := FilterServices(containers, []string{"db", "project_app_1", "db"})
+
+	r.Nil(err)
+	r.Len(got, 2)
+	r.Equal("app", got[0].ID)
+	r.Equal("db", got[1].ID)
+}
+
+func TestFilterServices_UnknownService_ListsAvailable(t *testing.T) {
+	r := require.New(t)
+
+	_, err := FilterServices([]*Container{{ID: "db", ComposeService: "db"}}, []string{"redis"})
+
+	r.ErrorContains(err, `serviço "redis" não encontrado. Serviços disponíveis: db`)
+}
+
+func TestLogPrefixes_PaddedAndColoredPerService(t *testing.T) {
+	r := require.New(t)
+
+	prefixes := LogPrefixes([]*Container{
+		{ID: "app", ComposeService: "app"},
+		{ID: "db", ComposeService: "database"},
+	})
+
+	r.Equal(logColors[0]+"app      |\x1b[0m ", prefixes["app"])
+	r.Equal(logColors[1]+"database |\x1b[0m ", prefixes["db"])
+}
+
+// ============================================================================
+// Tests for LogMultiplexer
+// ============================================================================
+
+func TestLogMultiplexer_WritesWholePrefixedLines(t *testing.T) {
+	r := require.New(t)
+
+	var buf bytes.Buffer
+	dst := &nopWriteCloser{Writer: &buf}
+	stream := NewLogMultiplexer(nil).Stream(dst, "app | ")
+
+	io.WriteString(stream, "first li")
+	io.WriteString(stream, "ne\r\nsecond\nthird")
+	r.Equal("app | first line\napp | second\n", buf.String())
+
+	r.Nil(stream.Close())
+	r.Equal("app | first line\napp | second\napp | third\n", buf.String())
+	r.True(dst.closed)
+}
+
+func TestLogMultiplexer_GrepFiltersLines(t *testing.T) {
+	r := require.New(t)
+
+	var buf bytes.Buffer
+	stream := NewLogMultiplexer(regexp.MustCompile("ERROR")).Stream(&nopWriteCloser{Writer: &buf}, "")
+
+	io.WriteString(stream, "INFO ok\nERROR boom\nINFO ok\n")
+	stream.Close()
+
+	r.Equal("ERROR boom\n", buf.String())
+}
+
+func TestLogMultiplexer_ConcurrentStreamsDoNotInterleaveLines(t *testing.T) {
+	r := require.New(t)
+
+	var buf bytes.Buffer
+	mux := NewLogMultiplexer(nil)
+
+	var wg sync.WaitGroup
+	for _, prefix := range []string{"a ", "b "} {
+		stream := mux.Stream(&nopWriteCloser{Writer: &buf}, prefix)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				io.WriteString(stream, "xxxx")
+				io.WriteString(stream, "yyyy\n")
+			}
+			stream.Close()
+		}()
+	}
+	wg.Wait()
+
+	for _, line := range bytes.Split(bytes.TrimSuffix(buf.Bytes(), []byte("\n")), []byte("\n")) {
+		r.Regexp("^[ab] xxxxyyyy$", string(line))
+	}
+}
+
+func TestLogMultiplexer_Close_DropsLaterWrites(t *testing.T) {
+	r := require.New(t)
+
+	var buf bytes.Buffer
+	mux := NewLogMultiplexer(nil)
+	stream := mux.Stream(&nopWriteCloser{Writer: &buf}, "")
+
+	io.WriteString(stream, "before\n")
+	mux.Close()
+	io.WriteString(stream, "after\n")
+
+	r.Equal("before\n", buf.String())
+}
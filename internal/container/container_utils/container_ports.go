@@ -0,0 +1,131 @@
+package container_utils
+
+import (
+	"cmp"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Brennon-Oliveira/dev-cli/internal/devcontainer"
+)
+
+// Status of a port in `dev ports`.
+const (
+	// PortPublished ports are published by the engine on the host.
+	PortPublished = "published"
+	// PortForwarded ports are not published, but answer on localhost, as
+	// when the editor forwards them.
+	PortForwarded = "forwarded"
+	// PortUnreachable ports are declared but do not answer on the host.
+	PortUnreachable = "unreachable"
+)
+
+var portStatusLabels = map[string]string{
+	PortPublished:   "publicada",
+	PortForwarded:   "encaminhada",
+	PortUnreachable: "inacessível",
+}
+
+type ProbePortFunc func(port int) bool
+type CheckDeclaredPortsFunc func(mappings []PortMapping, declared []devcontainer.DeclaredPort, mainService string, probe ProbePortFunc) []PortMapping
+type FormatPortsFunc func(mappings []PortMapping) string
+
+// ProbePort reports whether a TCP port of the host accepts connections.
+func ProbePort(port int) bool {
+	conn, err := net.DialTimeout("tcp", fmt.Sprintf("localhost:%d", port), 300*time.Millisecond)
+	if err != nil {
+		return false
+	}
+
+	conn.Close()
+	return true
+}
+
+// CheckDeclaredPorts marks the published mappings declared in
+// devcontainer.json and adds the declared ports no container publishes,
+// probing the host to tell forwarded ports from unreachable ones. Ports
+// declared without a service belong to mainService.
+func CheckDeclaredPorts(mappings []PortMapping, declared []devcontainer.DeclaredPort, mainService string, probe ProbePortFunc) []PortMapping {
+	checked := slices.Clone(mappings)
+
+	for _, port := range declared {
+		service := port.Service
+		if service == "" {
+			service = mainService
+		}
+
+		published := false
+		for i := range checked {
+			if checked[i].Service == service && checked[i].ContainerPort == port.Port && checked[i].Protocol == "tcp" {
+				checked[i].Declared = port.Source
+				published = true
+			}
+		}
+
+		if published {
+			continue
+		}
+
+		status := PortUnreachable
+		if probe(port.HostPort) {
+			status = PortForwarded
+		}
+
+		checked = append(checked, PortMapping{
+			Service:       service,
+			ContainerPort: port.Port,
+			Protocol:      "tcp",
+			HostIP:        "localhost",
+			HostPort:      port.HostPort,
+			Declared:      port.Source,
+			Status:        status,
+		})
+	}
+
+	return checked
+}
+
+// FormatPorts renders the ports of the workspace, warning about declared
+// ports not reachable from the host.
+func FormatPorts(mappings []PortMapping) string {
+	if len(mappings) == 0 {
+		return "Nenhuma porta publicada ou declarada no devcontainer.json."
+	}
+
+	var output strings.Builder
+	line := "%-20s %-15s %-22s %-13s %s\n"
+	output.WriteString(fmt.Sprintf(line, "SERVICE", "CONTAINER PORT", "HOST", "DECLARED", "STATUS"))
+
+	unreachable := 0
+	for _, mapping := range mappings {
+		host := "-"
+		if mapping.Status != PortUnreachable {
+			host = net.JoinHostPort(mapping.HostIP, fmt.Sprint(mapping.HostPort))
+		}
+
+		declared := mapping.Declared
+		if declared == "" {
+			declared = "-"
+		}
+
+		if mapping.Status == PortUnreachable {
+			unreachable++
+		}
+
+		output.WriteString(fmt.Sprintf(line,
+			mapping.Service,
+			fmt.Sprintf("%d/%s", mapping.ContainerPort, mapping.Protocol),
+			host,
+			declared,
+			cmp.Or(portStatusLabels[mapping.Status], mapping.Status),
+		))
+	}
+
+	if unreachable > 0 {
+		output.WriteString(fmt.Sprintf("\n⚠ %d porta(s) declarada(s) no devcontainer.json não estão acessíveis a partir do host.\n", unreachable))
+	}
+
+	return output.String()
+}
@@ -0,0 +1,103 @@
+package container_utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Brennon-Oliveira/dev-cli/internal/devcontainer"
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================================
+// Tests for CheckDeclaredPorts
+// ============================================================================
+
+func TestCheckDeclaredPorts_MarksPublishedPortsAsDeclared(t *testing.T) {
+	r := require.New(t)
+
+	mappings := []PortMapping{
+		{Service: "app", ContainerPort: 3000, Protocol: "tcp", HostIP: "0.0.0.0", HostPort: 3000, Status: PortPublished},
+		{Service: "db", ContainerPort: 5432, Protocol: "tcp", HostIP: "0.0.0.0", HostPort: 15432, Status: PortPublished},
+	}
+	declared := []devcontainer.DeclaredPort{
+		{Source: "forwardPorts", Port: 3000, HostPort: 3000},
+		{Source: "forwardPorts", Service: "db", Port: 5432, HostPort: 5432},
+	}
+
+	checked := CheckDeclaredPorts(mappings, declared, "app", func(port int) bool {
+		t.Fatalf("published port %d should not be probed", port)
+		return false
+	})
+
+	r.Len(checked, 2)
+	r.Equal("forwardPorts", checked[0].Declared)
+	r.Equal("forwardPorts", checked[1].Declared)
+	r.Empty(mappings[0].Declared, "the given mappings must not be changed")
+}
+
+func TestCheckDeclaredPorts_ProbesUnpublishedPorts(t *testing.T) {
+	r := require.New(t)
+
+	declared := []devcontainer.DeclaredPort{
+		{Source: "forwardPorts", Port: 3000, HostPort: 3000},
+		{Source: "appPort", Port: 8080, HostPort: 18080},
+	}
+
+	var probed []int
+	checked := CheckDeclaredPorts(nil, declared, "app", func(port int) bool {
+		probed = append(probed, port)
+		return port == 3000
+	})
+
+	r.Equal([]int{3000, 18080}, probed)
+	r.Equal([]PortMapping{
+		{Service: "app", ContainerPort: 3000, Protocol: "tcp", HostIP: "localhost", HostPort: 3000, Declared: "forwardPorts", Status: PortForwarded},
+		{Service: "app", ContainerPort: 8080, Protocol: "tcp", HostIP: "localhost", HostPort: 18080, Declared: "appPort", Status: PortUnreachable},
+	}, checked)
+}
+
+func TestCheckDeclaredPorts_PortOfAnotherService_IsNotPublished(t *testing.T) {
+	r := require.New(t)
+
+	mappings := []PortMapping{
+		{Service: "app", ContainerPort: 5432, Protocol: "tcp", HostIP: "0.0.0.0", HostPort: 5432, Status: PortPublished},
+	}
+	declared := []devcontainer.DeclaredPort{
+		{Source: "forwardPorts", Service: "db", Port: 5432, HostPort: 5432},
+	}
+
+	checked := CheckDeclaredPorts(mappings, declared, "app", func(port int) bool { return false })
+
+	r.Len(checked, 2)
+	r.Empty(checked[0].Declared)
+	r.Equal("db", checked[1].Service)
+	r.Equal(PortUnreachable, checked[1].Status)
+}
+
+// ============================================================================
+// Tests for FormatPorts
+// ============================================================================
+
+func TestFormatPorts_NoPorts(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal("Nenhuma porta publicada ou declarada no devcontainer.json.", FormatPorts(nil))
+}
+
+func TestFormatPorts_WritesRowsAndWarnsAboutUnreachablePorts(t *testing.T) {
+	r := require.New(t)
+
+	out := FormatPorts([]PortMapping{
+		{Service: "app", ContainerPort: 3000, Protocol: "tcp", HostIP: "0.0.0.0", HostPort: 3000, Declared: "forwardPorts", Status: PortPublished},
+		{Service: "db", ContainerPort: 5432, Protocol: "tcp", HostIP: "0.0.0.0", HostPort: 15432, Status: PortPublished},
+		{Service: "app", ContainerPort: 9229, Protocol: "tcp", HostIP: "localhost", HostPort: 9229, Declared: "forwardPorts", Status: PortUnreachable},
+	})
+
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	r.Len(lines, 6)
+	r.Contains(lines[0], "SERVICE")
+	r.Regexp(`^app\s+3000/tcp\s+0\.0\.0\.0:3000\s+forwardPorts\s+publicada$`, lines[1])
+	r.Regexp(`^db\s+5432/tcp\s+0\.0\.0\.0:15432\s+-\s+publicada$`, lines[2])
+	r.Regexp(`^app\s+9229/tcp\s+-\s+forwardPorts\s+inacessível$`, lines[3])
+	r.Equal("⚠ 1 porta(s) declarada(s) no devcontainer.json não estão acessíveis a partir do host.", lines[5])
+}
@@ -0,0 +1,175 @@
+package container_utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Brennon-Oliveira/dev-cli/internal/engine"
+)
+
+// snapshotRepositoryPrefix starts the repository of the snapshots of every
+// workspace, followed by the workspace folder name and a hash of its path.
+const snapshotRepositoryPrefix = "dev-snapshot-"
+
+// snapshotTimeLayout is appended to the snapshot name in the image tag.
+const snapshotTimeLayout = "20060102-150405"
+
+var snapshotNamePattern = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,63}$`)
+
+var invalidRepositoryChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// snapshotBuildProperties build the image of the devcontainer. The snapshot
+// already holds their result, features included.
+var snapshotBuildProperties = []string{"build", "dockerFile", "context", "features", "overrideFeatureInstallOrder", "configFilePath"}
+
+type FormatSnapshotsFunc func(snapshots []Snapshot) string
+type MkdirTempFunc func(dir string, pattern string) (string, error)
+type RemoveAllFunc func(path string) error
+type WriteFileFunc func(name string, data []byte, perm os.FileMode) error
+
+// ValidateSnapshotName ensures name can be used in an image tag.
+func ValidateSnapshotName(name string) error {
+	if !snapshotNamePattern.MatchString(name) {
+		return fmt.Errorf("nome de snapshot inválido '%s': use até 64 letras, números, '.', '-' e '_', começando com letra ou número", name)
+	}
+
+	return nil
+}
+
+// SnapshotRepository is the image repository of the snapshots of a
+// workspace, e.g. "dev-snapshot-app-3f2a9c1b".
+func SnapshotRepository(workspace string) string {
+	base := strings.Trim(invalidRepositoryChars.ReplaceAllString(strings.ToLower(filepath.Base(workspace)), "-"), "-")
+	if base == "" {
+		base = "workspace"
+	}
+
+	sum := sha256.Sum256([]byte(workspace))
+	return snapshotRepositoryPrefix + base + "-" + hex.EncodeToString(sum[:4])
+}
+
+// SnapshotImage is the image a snapshot taken at the given time is
+// committed to.
+func SnapshotImage(workspace string, name string, at time.Time) string {
+	return SnapshotRepository(workspace) + ":" + name + "-" + at.Format(snapshotTimeLayout)
+}
+
+// WorkspaceSnapshots returns the snapshots of the workspace among the
+// images, newest first.
+func WorkspaceSnapshots(workspace string, images []*engine.Image) []Snapshot {
+	repository := SnapshotRepository(workspace)
+
+	snapshots := []Snapshot{}
+	for _, image := range images {
+		if image.Repository[strings.LastIndex(image.Repository, "/")+1:] != repository {
+			continue
+		}
+
+		split := len(image.Tag) - len(snapshotTimeLayout) - 1
+		if split < 1 || image.Tag[split] != '-' {
+			continue
+		}
+
+		name := image.Tag[:split]
+		created, err := time.ParseInLocation(snapshotTimeLayout, image.Tag[split+1:], time.Local)
+		if err != nil {
+			continue
+		}
+
+		snapshots = append(snapshots, Snapshot{
+			Name:    name,
+			Image:   image.Repository + ":" + image.Tag,
+			Created: created,
+			Size:    image.Size,
+		})
+	}
+
+	slices.SortStableFunc(snapshots, func(a, b Snapshot) int {
+		return b.Created.Compare(a.Created)
+	})
+
+	return snapshots
+}
+
+// FindSnapshot returns the newest snapshot called name, or the one whose
+// tag is name.
+func FindSnapshot(snapshots []Snapshot, name string) (Snapshot, bool) {
+	for _, snapshot := range snapshots {
+		if snapshot.Name == name || strings.HasSuffix(snapshot.Image, ":"+name) {
+			return snapshot, true
+		}
+	}
+
+	return Snapshot{}, false
+}
+
+// SnapshotOverride turns the merged devcontainer.json of a workspace into
+// one that runs image, without building anything. Relative compose files
+// are resolved from configDir. In compose workspaces the image belongs to
+// the service, so the compose file returned must be written to
+// composeFile, which is added to the configuration.
+func SnapshotOverride(config map[string]any, configDir string, image string, composeFile string) (map[string]any, string, error) {
+	override := make(map[string]any, len(config))
+	maps.Copy(override, config)
+	for _, property := range snapshotBuildProperties {
+		delete(override, property)
+	}
+
+	files, isCompose := config["dockerComposeFile"]
+	if !isCompose {
+		override["image"] = image
+		return override, "", nil
+	}
+
+	service, _ := config["service"].(string)
+	if service == "" {
+		return nil, "", fmt.Errorf("a configuração usa dockerComposeFile sem a propriedade service")
+	}
+
+	var composeFiles []any
+	switch files := files.(type) {
+	case string:
+		composeFiles = append(composeFiles, files)
+	case []any:
+		composeFiles = append(composeFiles, files...)
+	}
+
+	for i, file := range composeFiles {
+		if path, ok := file.(string); ok && !filepath.IsAbs(path) {
+			composeFiles[i] = filepath.Join(configDir, path)
+		}
+	}
+	override["dockerComposeFile"] = append(composeFiles, composeFile)
+
+	// !reset drops the build of the service, which would otherwise rebuild
+	// it and move the snapshot tag to the new image.
+	compose := fmt.Sprintf("services:\n  %s:\n    image: %s\n    build: !reset null\n", strconv.Quote(service), strconv.Quote(image))
+
+	return override, compose, nil
+}
+
+// FormatSnapshots renders the snapshots of a workspace.
+func FormatSnapshots(snapshots []Snapshot) string {
+	if len(snapshots) == 0 {
+		return "Nenhum snapshot encontrado para o workspace."
+	}
+
+	var output strings.Builder
+	line := "%-25s %-20s %-10s %s\n"
+	output.WriteString(fmt.Sprintf(line, "NAME", "CREATED", "SIZE", "IMAGE"))
+
+	for _, snapshot := range snapshots {
+		output.WriteString(fmt.Sprintf(line, snapshot.Name, snapshot.Created.Format("2006-01-02 15:04:05"), FormatBytes(snapshot.Size), snapshot.Image))
+	}
+
+	return output.String()
+}
@@ -0,0 +1,171 @@
+package container_utils
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Brennon-Oliveira/dev-cli/internal/engine"
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================================
+// Tests for ValidateSnapshotName and SnapshotRepository
+// ============================================================================
+
+func TestValidateSnapshotName(t *testing.T) {
+	r := require.New(t)
+
+	r.NoError(ValidateSnapshotName("before-upgrade"))
+	r.NoError(ValidateSnapshotName("v1.2_ok"))
+	r.Error(ValidateSnapshotName("-leading-dash"))
+	r.Error(ValidateSnapshotName("with space"))
+	r.Error(ValidateSnapshotName("with:colon"))
+	r.Error(ValidateSnapshotName(strings.Repeat("a", 65)))
+}
+
+func TestSnapshotRepository_IsAValidAndStableImageName(t *testing.T) {
+	r := require.New(t)
+
+	repository := SnapshotRepository("/home/user/My Project")
+
+	r.Regexp(`^dev-snapshot-my-project-[0-9a-f]{8}$`, repository)
+	r.Equal(repository, SnapshotRepository("/home/user/My Project"))
+	r.NotEqual(repository, SnapshotRepository("/home/other/My Project"))
+	r.Regexp(`^dev-snapshot-workspace-[0-9a-f]{8}$`, SnapshotRepository("/"))
+}
+
+// ============================================================================
+// Tests for WorkspaceSnapshots and FindSnapshot
+// ============================================================================
+
+func TestWorkspaceSnapshots_ParsesTagsNewestFirst(t *testing.T) {
+	r := require.New(t)
+
+	workspace := "/home/user/app"
+	repository := SnapshotRepository(workspace)
+	images := []*engine.Image{
+		{Repository: repository, Tag: "before-upgrade-20260101-120000", Size: 100},
+		{Repository: "localhost/" + repository, Tag: "before-upgrade-20260301-090000", Size: 200},
+		{Repository: repository, Tag: "clean-20260201-080000", Size: 300},
+		{Repository: repository, Tag: "latest"},
+		{Repository: SnapshotRepository("/home/user/other"), Tag: "clean-20260201-080000"},
+		{Repository: "postgres", Tag: "16"},
+	}
+
+	snapshots := WorkspaceSnapshots(workspace, images)
+
+	r.Len(snapshots, 3)
+	r.Equal(Snapshot{
+		Name:    "before-upgrade",
+		Image:   "localhost/" + repository + ":before-upgrade-20260301-090000",
+		Created: time.Date(2026, 3, 1, 9, 0, 0, 0, time.Local),
+		Size:    200,
+	}, snapshots[0])
+	r.Equal("clean", snapshots[1].Name)
+	r.Equal("before-upgrade", snapshots[2].Name)
+}
+
+func TestFindSnapshot_MatchesNewestByNameOrExactTag(t *testing.T) {
+	r := require.New(t)
+
+	snapshots := []Snapshot{
+		{Name: "before", Image: "dev-snapshot-app:before-20260301-090000"},
+		{Name: "before", Image: "dev-snapshot-app:before-20260101-120000"},
+	}
+
+	snapshot, ok := FindSnapshot(snapshots, "before")
+	r.True(ok)
+	r.Equal(snapshots[0], snapshot)
+
+	snapshot, ok = FindSnapshot(snapshots, "before-20260101-120000")
+	r.True(ok)
+	r.Equal(snapshots[1], snapshot)
+
+	_, ok = FindSnapshot(snapshots, "after")
+	r.False(ok)
+}
+
+// ============================================================================
+// Tests for SnapshotOverride
+// ============================================================================
+
+func TestSnapshotOverride_ImageConfig_RunsSnapshotWithoutBuilding(t *testing.T) {
+	r := require.New(t)
+
+	config := map[string]any{
+		"build":          map[string]any{"dockerfile": "Dockerfile"},
+		"features":       map[string]any{"ghcr.io/devcontainers/features/go:1": map[string]any{}},
+		"configFilePath": map[string]any{"fsPath": "/home/user/app/.devcontainer/devcontainer.json"},
+		"remoteUser":     "vscode",
+		"forwardPorts":   []any{float64(3000)},
+	}
+
+	override, compose, err := SnapshotOverride(config, "/home/user/app/.devcontainer", "dev-snapshot-app:before", "/tmp/compose.yml")
+
+	r.NoError(err)
+	r.Empty(compose)
+	r.Equal(map[string]any{
+		"image":        "dev-snapshot-app:before",
+		"remoteUser":   "vscode",
+		"forwardPorts": []any{float64(3000)},
+	}, override)
+	r.Contains(config, "build", "the given configuration must not be changed")
+}
+
+func TestSnapshotOverride_ComposeConfig_OverridesTheServiceImage(t *testing.T) {
+	r := require.New(t)
+
+	config := map[string]any{
+		"dockerComposeFile": []any{"../docker-compose.yml", "/abs/docker-compose.dev.yml"},
+		"service":           "app",
+		"runServices":       []any{"app", "db"},
+	}
+
+	override, compose, err := SnapshotOverride(config, "/home/user/app/.devcontainer", "dev-snapshot-app:before", "/tmp/compose.yml")
+
+	r.NoError(err)
+	r.Equal([]any{"/home/user/app/docker-compose.yml", "/abs/docker-compose.dev.yml", "/tmp/compose.yml"}, override["dockerComposeFile"])
+	r.NotContains(override, "image")
+	r.Equal("services:\n  \"app\":\n    image: \"dev-snapshot-app:before\"\n    build: !reset null\n", compose)
+}
+
+func TestSnapshotOverride_EmptyConfig_SetsImage(t *testing.T) {
+	r := require.New(t)
+
+	override, _, err := SnapshotOverride(nil, "/app/.devcontainer", "snapshot", "/tmp/compose.yml")
+
+	r.NoError(err)
+	r.Equal(map[string]any{"image": "snapshot"}, override)
+}
+
+func TestSnapshotOverride_ComposeConfigWithoutService_ReturnsError(t *testing.T) {
+	r := require.New(t)
+
+	_, _, err := SnapshotOverride(map[string]any{"dockerComposeFile": "docker-compose.yml"}, "/app/.devcontainer", "snapshot", "/tmp/compose.yml")
+
+	r.ErrorContains(err, "service")
+}
+
+// ============================================================================
+// Tests for FormatSnapshots
+// ============================================================================
+
+func TestFormatSnapshots_NoSnapshots(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal("Nenhum snapshot encontrado para o workspace.", FormatSnapshots(nil))
+}
+
+func TestFormatSnapshots_WritesRows(t *testing.T) {
+	r := require.New(t)
+
+	out := FormatSnapshots([]Snapshot{
+		{Name: "before", Image: "dev-snapshot-app:before-20260301-090000", Created: time.Date(2026, 3, 1, 9, 0, 0, 0, time.Local), Size: 2048},
+	})
+
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	r.Len(lines, 2)
+	r.Regexp(`^NAME\s+CREATED\s+SIZE\s+IMAGE$`, lines[0])
+	r.Regexp(`^before\s+2026-03-01 09:00:00\s+2\.0KiB\s+dev-snapshot-app:before-20260301-090000$`, lines[1])
+}
@@ -0,0 +1,104 @@
+package container_utils
+
+import (
+	"slices"
+	"strings"
+)
+
+// composeDependsOnLabel lists the services a compose container depends on,
+// as "db:service_started:false,cache:service_healthy:true".
+const composeDependsOnLabel = "com.docker.compose.depends_on"
+
+type StartOrderFunc func(containers []*Container) [][]*Container
+
+// StartOrder groups the containers of a workspace in batches started one
+// after the other, following the compose depends_on of each service. When
+// no container has this label, as with podman-compose, the services come
+// first and the devcontainer last. Containers in a dependency cycle are
+// started together in the last batch.
+func StartOrder(containers []*Container) [][]*Container {
+	dependencies := composeDependencies(containers)
+
+	started := make(map[string]bool)
+	pending := slices.Clone(containers)
+	var batches [][]*Container
+
+	for len(pending) > 0 {
+		var batch, waiting []*Container
+		for _, container := range pending {
+			ready := true
+			for _, dependency := range dependencies[container.ID] {
+				if !started[dependency] {
+					ready = false
+					break
+				}
+			}
+
+			if ready {
+				batch = append(batch, container)
+			} else {
+				waiting = append(waiting, container)
+			}
+		}
+
+		if len(batch) == 0 {
+			batch, waiting = waiting, nil
+		}
+
+		slices.SortFunc(batch, func(a, b *Container) int {
+			return strings.Compare(ServiceName(a), ServiceName(b))
+		})
+		for _, container := range batch {
+			started[ServiceName(container)] = true
+		}
+
+		batches = append(batches, batch)
+		pending = waiting
+	}
+
+	return batches
+}
+
+// composeDependencies maps each container ID to the services it waits for,
+// ignoring services outside the workspace.
+func composeDependencies(containers []*Container) map[string][]string {
+	services := make(map[string]bool)
+	labeled := false
+	for _, container := range containers {
+		services[ServiceName(container)] = true
+		if _, ok := container.Labels[composeDependsOnLabel]; ok {
+			labeled = true
+		}
+	}
+
+	dependencies := make(map[string][]string)
+	for _, container := range containers {
+		if !labeled {
+			if container.LocalFolder != "" {
+				dependencies[container.ID] = auxiliaryServices(containers)
+			}
+			continue
+		}
+
+		for _, entry := range strings.Split(container.Labels[composeDependsOnLabel], ",") {
+			service, _, _ := strings.Cut(entry, ":")
+			if services[service] && service != ServiceName(container) {
+				dependencies[container.ID] = append(dependencies[container.ID], service)
+			}
+		}
+	}
+
+	return dependencies
+}
+
+// auxiliaryServices names the containers that are not devcontainers.
+func auxiliaryServices(containers []*Container) []string {
+	var names []string
+	for _, container := range containers {
+		if container.LocalFolder == "" {
+			names = append(names, ServiceName(container))
+		}
+	}
+
+	return names
+}
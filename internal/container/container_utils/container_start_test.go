@@ -0,0 +1,83 @@
+package container_utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// batchIDs lists the IDs of each batch of StartOrder.
+func batchIDs(batches [][]*Container) [][]string {
+	var ids [][]string
+	for _, batch := range batches {
+		var batchIDs []string
+		for _, container := range batch {
+			batchIDs = append(batchIDs, container.ID)
+		}
+		ids = append(ids, batchIDs)
+	}
+
+	return ids
+}
+
+func dependsOn(services string) map[string]string {
+	return map[string]string{composeDependsOnLabel: services}
+}
+
+// ============================================================================
+// Tests for StartOrder
+// ============================================================================
+
+func TestStartOrder_FollowsComposeDependsOn(t *testing.T) {
+	r := require.New(t)
+
+	containers := []*Container{
+		{ID: "app", ComposeService: "app", LocalFolder: "/home/user/app", Labels: dependsOn("api:service_started:false")},
+		{ID: "api", ComposeService: "api", Labels: dependsOn("db:service_healthy:false,cache:service_started:false")},
+		{ID: "db", ComposeService: "db", Labels: dependsOn("")},
+		{ID: "cache", ComposeService: "cache", Labels: dependsOn("")},
+	}
+
+	r.Equal([][]string{{"cache", "db"}, {"api"}, {"app"}}, batchIDs(StartOrder(containers)))
+}
+
+func TestStartOrder_WithoutDependsOn_StartsDevcontainerLast(t *testing.T) {
+	r := require.New(t)
+
+	containers := []*Container{
+		{ID: "app", ComposeService: "app", LocalFolder: "/home/user/app"},
+		{ID: "db", ComposeService: "db"},
+		{ID: "cache", ComposeService: "cache"},
+	}
+
+	r.Equal([][]string{{"cache", "db"}, {"app"}}, batchIDs(StartOrder(containers)))
+}
+
+func TestStartOrder_IgnoresServicesOutsideTheWorkspace(t *testing.T) {
+	r := require.New(t)
+
+	containers := []*Container{
+		{ID: "app", ComposeService: "app", LocalFolder: "/home/user/app", Labels: dependsOn("db:service_started:false,queue:service_started:false")},
+		{ID: "db", ComposeService: "db", Labels: dependsOn("")},
+	}
+
+	r.Equal([][]string{{"db"}, {"app"}}, batchIDs(StartOrder(containers)))
+}
+
+func TestStartOrder_Cycle_StartsTogetherLast(t *testing.T) {
+	r := require.New(t)
+
+	containers := []*Container{
+		{ID: "a", ComposeService: "a", Labels: dependsOn("b:service_started:false")},
+		{ID: "b", ComposeService: "b", Labels: dependsOn("a:service_started:false")},
+		{ID: "db", ComposeService: "db", Labels: dependsOn("")},
+	}
+
+	r.Equal([][]string{{"db"}, {"a", "b"}}, batchIDs(StartOrder(containers)))
+}
+
+func TestStartOrder_Empty(t *testing.T) {
+	r := require.New(t)
+
+	r.Empty(StartOrder(nil))
+}
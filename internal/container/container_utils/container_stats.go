@@ -0,0 +1,178 @@
+package container_utils
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+type GroupStatsFunc func(grouped map[string][]*Container, stats []*ContainerStats) []WorkspaceStats
+type FormatWorkspaceStatsFunc func(stats []WorkspaceStats) string
+type FormatContainerStatsFunc func(stats WorkspaceStats) string
+
+// AggregateStats sums the samples of the containers of a workspace.
+func AggregateStats(workspace string, stats []*ContainerStats) WorkspaceStats {
+	aggregated := WorkspaceStats{
+		Workspace:  workspace,
+		Containers: []*ContainerStats{},
+	}
+
+	for _, stat := range stats {
+		aggregated.CPUPercent += stat.CPUPercent
+		aggregated.MemoryUsage += stat.MemoryUsage
+		aggregated.MemoryPercent += stat.MemoryPercent
+		aggregated.NetworkRx += stat.NetworkRx
+		aggregated.NetworkTx += stat.NetworkTx
+		aggregated.BlockRead += stat.BlockRead
+		aggregated.BlockWrite += stat.BlockWrite
+		aggregated.Containers = append(aggregated.Containers, stat)
+	}
+
+	return aggregated
+}
+
+// GroupStats aggregates the samples by the workspace groups of
+// GroupContainers. The heaviest workspaces by memory come first.
+func GroupStats(grouped map[string][]*Container, stats []*ContainerStats) []WorkspaceStats {
+	var workspaces []WorkspaceStats
+	for _, workspace := range GroupWorkspaces(grouped, stats) {
+		workspaces = append(workspaces, workspace.Stats)
+	}
+
+	slices.SortStableFunc(workspaces, func(a, b WorkspaceStats) int {
+		return cmp.Compare(b.MemoryUsage, a.MemoryUsage)
+	})
+
+	return workspaces
+}
+
+// GroupWorkspaces pairs the workspace groups of GroupContainers with the
+// samples of their containers, in the order of `dev info`.
+func GroupWorkspaces(grouped map[string][]*Container, stats []*ContainerStats) []Workspace {
+	byID := make(map[string]*ContainerStats, len(stats))
+	for _, stat := range stats {
+		byID[truncateID(stat.ID)] = stat
+	}
+
+	var workspaces []Workspace
+	for _, folder := range sortedGroupFolders(grouped) {
+		containers := sortedGroup(grouped[folder])
+
+		var samples []*ContainerStats
+		for _, container := range containers {
+			if stat, exists := byID[truncateID(container.ID)]; exists {
+				samples = append(samples, stat)
+			}
+		}
+
+		workspaces = append(workspaces, Workspace{
+			Path:       folder,
+			Containers: containers,
+			Stats:      AggregateStats(folder, samples),
+		})
+	}
+
+	return workspaces
+}
+
+// FormatWorkspaceStats renders one line per workspace for `dev info --stats`.
+func FormatWorkspaceStats(stats []WorkspaceStats) string {
+	if len(stats) == 0 {
+		return "Nenhum DevContainer ativo encontrado."
+	}
+
+	var output strings.Builder
+	output.WriteString("Uso de recursos por workspace:\n\n")
+	output.WriteString(fmt.Sprintf("%-8s %-10s %-8s %-22s %-22s %-10s %s\n",
+		"CPU %", "MEM USAGE", "MEM %", "NET I/O", "BLOCK I/O", "CONTAINERS", "WORKSPACE"))
+
+	for _, ws := range stats {
+		output.WriteString(fmt.Sprintf("%-8s %-10s %-8s %-22s %-22s %-10d %s\n",
+			formatPercent(ws.CPUPercent),
+			FormatBytes(ws.MemoryUsage),
+			formatPercent(ws.MemoryPercent),
+			formatBytesPair(ws.NetworkRx, ws.NetworkTx),
+			formatBytesPair(ws.BlockRead, ws.BlockWrite),
+			len(ws.Containers),
+			ws.Workspace,
+		))
+	}
+
+	return output.String()
+}
+
+// FormatContainerStats renders the containers of a workspace and their
+// total for `dev stats`.
+func FormatContainerStats(stats WorkspaceStats) string {
+	var output strings.Builder
+	output.WriteString(stats.Workspace + "\n")
+	output.WriteString("---\n")
+
+	line := "%-12s %-45s %-8s %-22s %-8s %-22s %-22s %s\n"
+	output.WriteString(fmt.Sprintf(line,
+		"CONTAINER ID", "NAMES", "CPU %", "MEM USAGE / LIMIT", "MEM %", "NET I/O", "BLOCK I/O", "PIDS"))
+
+	for _, stat := range stats.Containers {
+		output.WriteString(fmt.Sprintf(line,
+			truncateID(stat.ID),
+			stat.Name,
+			formatPercent(stat.CPUPercent),
+			formatBytesPair(stat.MemoryUsage, stat.MemoryLimit),
+			formatPercent(stat.MemoryPercent),
+			formatBytesPair(stat.NetworkRx, stat.NetworkTx),
+			formatBytesPair(stat.BlockRead, stat.BlockWrite),
+			fmt.Sprint(stat.PIDs),
+		))
+	}
+
+	output.WriteString(fmt.Sprintf(line,
+		"TOTAL",
+		"",
+		formatPercent(stats.CPUPercent),
+		FormatBytes(stats.MemoryUsage),
+		formatPercent(stats.MemoryPercent),
+		formatBytesPair(stats.NetworkRx, stats.NetworkTx),
+		formatBytesPair(stats.BlockRead, stats.BlockWrite),
+		"",
+	))
+
+	return output.String()
+}
+
+// FormatBytes renders a size with binary units, like "1.5GiB".
+func FormatBytes(size uint64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%dB", size)
+	}
+
+	value := float64(size)
+	units := []string{"KiB", "MiB", "GiB", "TiB", "PiB"}
+
+	i := -1
+	for value >= unit && i < len(units)-1 {
+		value /= unit
+		i++
+	}
+
+	return fmt.Sprintf("%.1f%s", value, units[i])
+}
+
+func formatBytesPair(a, b uint64) string {
+	return FormatBytes(a) + " / " + FormatBytes(b)
+}
+
+func formatPercent(value float64) string {
+	return fmt.Sprintf("%.2f%%", value)
+}
+
+// truncateID shortens an ID to the 12 characters printed by `ps`, so the
+// IDs of ps and stats match.
+func truncateID(id string) string {
+	if len(id) > 12 {
+		return id[:12]
+	}
+
+	return id
+}
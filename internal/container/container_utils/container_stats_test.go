@@ -0,0 +1,160 @@
+package container_utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================================
+// Tests for AggregateStats
+// ============================================================================
+
+func TestAggregateStats_SumsSamples(t *testing.T) {
+	r := require.New(t)
+
+	stats := []*ContainerStats{
+		{ID: "app", CPUPercent: 10, MemoryUsage: 100, MemoryPercent: 1, NetworkRx: 1, NetworkTx: 2, BlockRead: 3, BlockWrite: 4},
+		{ID: "db", CPUPercent: 5, MemoryUsage: 50, MemoryPercent: 0.5, NetworkRx: 10, NetworkTx: 20, BlockRead: 30, BlockWrite: 40},
+	}
+
+	got := AggregateStats("/home/user/app", stats)
+
+	r.Equal("/home/user/app", got.Workspace)
+	r.Equal(15.0, got.CPUPercent)
+	r.Equal(uint64(150), got.MemoryUsage)
+	r.Equal(1.5, got.MemoryPercent)
+	r.Equal(uint64(11), got.NetworkRx)
+	r.Equal(uint64(22), got.NetworkTx)
+	r.Equal(uint64(33), got.BlockRead)
+	r.Equal(uint64(44), got.BlockWrite)
+	r.Len(got.Containers, 2)
+}
+
+func TestAggregateStats_NoSamples_ReturnsEmptyContainers(t *testing.T) {
+	r := require.New(t)
+
+	got := AggregateStats("/home/user/app", nil)
+
+	r.NotNil(got.Containers)
+	r.Empty(got.Containers)
+}
+
+// ============================================================================
+// Tests for GroupStats
+// ============================================================================
+
+func TestGroupStats_MatchesByShortIDAndSortsByMemory(t *testing.T) {
+	r := require.New(t)
+
+	grouped := map[string][]*Container{
+		"/home/user/small": {{ID: "aaaaaaaaaaaa", Names: "small"}},
+		"/home/user/big":   {{ID: "bbbbbbbbbbbb", Names: "big"}, {ID: "cccccccccccc", Names: "big_db"}},
+		"/home/user/idle":  {{ID: "dddddddddddd", Names: "idle"}},
+	}
+	stats := []*ContainerStats{
+		{ID: "aaaaaaaaaaaa", MemoryUsage: 100},
+		{ID: "bbbbbbbbbbbb0123", MemoryUsage: 300},
+		{ID: "cccccccccccc", MemoryUsage: 200},
+	}
+
+	got := GroupStats(grouped, stats)
+
+	r.Len(got, 3)
+	r.Equal("/home/user/big", got[0].Workspace)
+	r.Equal(uint64(500), got[0].MemoryUsage)
+	r.Len(got[0].Containers, 2)
+	r.Equal("/home/user/small", got[1].Workspace)
+	r.Equal("/home/user/idle", got[2].Workspace)
+	r.Empty(got[2].Containers)
+}
+
+// ============================================================================
+// Tests for formatting
+// ============================================================================
+
+func TestFormatBytes(t *testing.T) {
+	cases := map[uint64]string{
+		0:               "0B",
+		1023:            "1023B",
+		1024:            "1.0KiB",
+		1536:            "1.5KiB",
+		300 << 20:       "300.0MiB",
+		3 << 30:         "3.0GiB",
+		5 << 40:         "5.0TiB",
+		uint64(1 << 63): "8192.0PiB",
+	}
+
+	for size, expected := range cases {
+		r := require.New(t)
+		r.Equal(expected, FormatBytes(size))
+	}
+}
+
+func TestFormatWorkspaceStats_OneLinePerWorkspace(t *testing.T) {
+	r := require.New(t)
+
+	out := FormatWorkspaceStats([]WorkspaceStats{
+		{Workspace: "/home/user/big", CPUPercent: 12.5, MemoryUsage: 2 << 30, Containers: []*ContainerStats{{}, {}}},
+		{Workspace: "/home/user/small", MemoryUsage: 10 << 20},
+	})
+
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	r.Len(lines, 5)
+	r.Contains(lines[2], "CPU %")
+	r.Contains(lines[3], "12.50%")
+	r.Contains(lines[3], "2.0GiB")
+	r.Contains(lines[3], "/home/user/big")
+	r.Contains(out, "/home/user/small")
+}
+
+func TestFormatWorkspaceStats_Empty(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal("Nenhum DevContainer ativo encontrado.", FormatWorkspaceStats(nil))
+}
+
+func TestFormatContainerStats_ListsContainersAndTotal(t *testing.T) {
+	r := require.New(t)
+
+	out := FormatContainerStats(AggregateStats("/home/user/app", []*ContainerStats{
+		{ID: "0123456789abcdef", Name: "app_1", CPUPercent: 1, MemoryUsage: 1 << 20, MemoryLimit: 8 << 30, PIDs: 7},
+		{ID: "fedcba987654", Name: "app_db_1", CPUPercent: 2, MemoryUsage: 3 << 20},
+	}))
+
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	r.Len(lines, 6)
+	r.Equal("/home/user/app", lines[0])
+	r.Contains(lines[3], "0123456789ab ")
+	r.Contains(lines[3], "1.0MiB / 8.0GiB")
+	r.True(strings.HasPrefix(lines[5], "TOTAL"))
+	r.Contains(lines[5], "3.00%")
+	r.Contains(lines[5], "4.0MiB")
+}
+
+// ============================================================================
+// Tests for GroupWorkspaces
+// ============================================================================
+
+func TestGroupWorkspaces_KeepsInfoOrderAndStats(t *testing.T) {
+	r := require.New(t)
+
+	grouped := map[string][]*Container{
+		NoLocalFolderGroup: {{ID: "eeeeeeeeeeee", Names: "orphan"}},
+		"/home/user/b":     {{ID: "bbbbbbbbbbbb", Names: "b_db", ComposeProject: "b"}, {ID: "cccccccccccc", Names: "b_app", LocalFolder: "/home/user/b"}},
+		"/home/user/a":     {{ID: "aaaaaaaaaaaa", Names: "a", LocalFolder: "/home/user/a"}},
+	}
+	stats := []*ContainerStats{{ID: "bbbbbbbbbbbb", MemoryUsage: 10}, {ID: "cccccccccccc", MemoryUsage: 5}}
+
+	got := GroupWorkspaces(grouped, stats)
+
+	r.Len(got, 3)
+	r.Equal("/home/user/a", got[0].Path)
+	r.Equal("/home/user/b", got[1].Path)
+	r.Equal(NoLocalFolderGroup, got[2].Path)
+	r.Equal("b_app", got[1].Containers[0].Names)
+	r.Equal(uint64(15), got[1].Stats.MemoryUsage)
+	r.Equal(uint64(10), got[1].ContainerStats("bbbbbbbbbbbb").MemoryUsage)
+	r.Nil(got[0].ContainerStats("aaaaaaaaaaaa"))
+}
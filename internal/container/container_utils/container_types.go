@@ -1,8 +1,140 @@
 package container_utils
 
-type Container struct {
-	ID          string
-	Names       string
-	Status      string
-	LocalFolder string
+import (
+	"time"
+
+	"github.com/Brennon-Oliveira/dev-cli/internal/engine"
+)
+
+// Container is the container listed by the engine, grouped and rendered by
+// the helpers of this package.
+type Container = engine.Container
+
+// WorkspaceContainer is a container of `dev info` in machine readable
+// output, with the workspace it was grouped under.
+type WorkspaceContainer struct {
+	Workspace  string `json:"workspace" yaml:"workspace"`
+	*Container `yaml:",inline"`
+}
+
+// PortMapping is a port of `dev ports`: published by a container of the
+// workspace or declared in devcontainer.json.
+type PortMapping struct {
+	Service       string `json:"service" yaml:"service"`
+	Container     string `json:"container" yaml:"container"`
+	ContainerPort int    `json:"containerPort" yaml:"containerPort"`
+	Protocol      string `json:"protocol" yaml:"protocol"`
+	HostIP        string `json:"hostIP" yaml:"hostIP"`
+	HostPort      int    `json:"hostPort" yaml:"hostPort"`
+	// Declared is the devcontainer.json property declaring the port, if any.
+	Declared string `json:"declared" yaml:"declared"`
+	// Status is PortPublished, PortForwarded or PortUnreachable.
+	Status string `json:"status" yaml:"status"`
+}
+
+// PortForward is a host port of `dev forward` tunneled into a port of the
+// devcontainer.
+type PortForward struct {
+	ContainerPort int    `json:"containerPort" yaml:"containerPort"`
+	HostIP        string `json:"hostIP" yaml:"hostIP"`
+	HostPort      int    `json:"hostPort" yaml:"hostPort"`
+}
+
+// AffectedContainer is a container changed by `dev kill` or `dev down`,
+// listed before confirming and by --dry-run.
+type AffectedContainer struct {
+	ID      string   `json:"id" yaml:"id"`
+	Name    string   `json:"name" yaml:"name"`
+	Service string   `json:"service" yaml:"service"`
+	State   string   `json:"state" yaml:"state"`
+	Volumes []string `json:"volumes" yaml:"volumes"`
+}
+
+// WorkspaceVolume is a named volume mounted by containers of the
+// workspace, as listed by `dev volumes`.
+type WorkspaceVolume struct {
+	Name     string   `json:"name" yaml:"name"`
+	Services []string `json:"services" yaml:"services"`
+	// InUse is set when a running container mounts the volume.
+	InUse bool `json:"inUse" yaml:"inUse"`
+}
+
+// Snapshot is an image committed from the devcontainer of a workspace by
+// `dev snapshot save`.
+type Snapshot struct {
+	Name    string    `json:"name" yaml:"name"`
+	Image   string    `json:"image" yaml:"image"`
+	Created time.Time `json:"created" yaml:"created"`
+	// Size is the image size in bytes.
+	Size uint64 `json:"size" yaml:"size"`
+}
+
+// LogLine is a line of `dev logs` in machine readable output.
+type LogLine struct {
+	Container string `json:"container" yaml:"container"`
+	Service   string `json:"service" yaml:"service"`
+	Line      string `json:"line" yaml:"line"`
+}
+
+// ContainerStats is a resource usage sample of a container.
+type ContainerStats = engine.ContainerStats
+
+// WorkspaceStats is the resource usage of a workspace, summed over its
+// running containers, as shown by `dev stats` and `dev info --stats`.
+type WorkspaceStats struct {
+	Workspace     string            `json:"workspace" yaml:"workspace"`
+	CPUPercent    float64           `json:"cpuPercent" yaml:"cpuPercent"`
+	MemoryUsage   uint64            `json:"memoryUsage" yaml:"memoryUsage"`
+	MemoryPercent float64           `json:"memoryPercent" yaml:"memoryPercent"`
+	NetworkRx     uint64            `json:"networkRx" yaml:"networkRx"`
+	NetworkTx     uint64            `json:"networkTx" yaml:"networkTx"`
+	BlockRead     uint64            `json:"blockRead" yaml:"blockRead"`
+	BlockWrite    uint64            `json:"blockWrite" yaml:"blockWrite"`
+	Containers    []*ContainerStats `json:"containers" yaml:"containers"`
+}
+
+// Workspace is a devcontainer workspace with its containers and their
+// resource usage, as shown by `dev top`.
+type Workspace struct {
+	Path       string
+	Containers []*Container
+	Stats      WorkspaceStats
+}
+
+// ContainerStats returns the sample of a container of the workspace, or
+// nil when it was not sampled.
+func (w Workspace) ContainerStats(id string) *ContainerStats {
+	for _, stat := range w.Stats.Containers {
+		if truncateID(stat.ID) == truncateID(id) {
+			return stat
+		}
+	}
+
+	return nil
+}
+
+// CleanedResource is a resource removed by `dev clean`, or that would be
+// removed with --dry-run.
+type CleanedResource struct {
+	// Type is CleanContainer, CleanVolume, CleanNetwork or CleanImage.
+	Type string `json:"type" yaml:"type"`
+	ID   string `json:"id" yaml:"id"`
+	Name string `json:"name" yaml:"name"`
+	// Size is the size in bytes reported for images, including the layers
+	// shared with other images. It is zero for the other types.
+	Size uint64 `json:"size" yaml:"size"`
+}
+
+// ServiceStatus is the readiness of a container of the workspace, as
+// reported by `dev wait`.
+type ServiceStatus struct {
+	Service   string `json:"service" yaml:"service"`
+	Container string `json:"container" yaml:"container"`
+	State     string `json:"state" yaml:"state"`
+	// Health is "starting", "healthy" or "unhealthy", empty when the
+	// container has no healthcheck.
+	Health string `json:"health" yaml:"health"`
+	// ExitCode is the code of the last run, meaningful once exited.
+	ExitCode int  `json:"exitCode" yaml:"exitCode"`
+	Ready    bool `json:"ready" yaml:"ready"`
 }
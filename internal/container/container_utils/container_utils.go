@@ -1,45 +1,35 @@
 package container_utils
 
 import (
+	"cmp"
 	"fmt"
+	"maps"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
 )
 
-type ParseContainerOutputFunc func(output string) map[string][]*Container
+type GroupContainersFunc func(containers []*Container) map[string][]*Container
 type FormatGroupedContainersFunc func(grouped map[string][]*Container) string
+type ParsePortsFunc func(output string) []PortMapping
 
-func ParseContainerOutput(output string) map[string][]*Container {
+// NoLocalFolderGroup groups the auxiliary containers without a devcontainer.
+const NoLocalFolderGroup = "[sem pasta local mapeada]"
+
+// GroupContainers groups the containers by the local folder of their
+// devcontainer. Auxiliary containers join the devcontainer of the same
+// compose project or, without one, the devcontainer sharing their name
+// prefix.
+func GroupContainers(containers []*Container) map[string][]*Container {
 	logger.Verbose("═══════════════════════════════════════════════════════════════")
-	logger.Verbose("PARSING DE OUTPUT DE CONTAINERS")
+	logger.Verbose("AGRUPAMENTO DE CONTAINERS")
 	logger.Verbose("═══════════════════════════════════════════════════════════════")
 
 	grouped := make(map[string][]*Container)
-	lines := strings.Split(output, "\n")
-	var containers []*Container
 
-	logger.Verbose(fmt.Sprintf("Total de linhas no output: %d", len(lines)))
-	logger.Verbose("")
-
-	logger.Verbose("▶ Parseando linhas...")
-	for i, line := range lines {
-		if i == 0 || strings.TrimSpace(line) == "" {
-			continue
-		}
-
-		container := parseContainerLine(line)
-		if container == nil {
-			logger.Verbose(fmt.Sprintf("  ⚠ Linha %d não pôde ser parseada", i))
-			continue
-		}
-
-		logger.Verbose(fmt.Sprintf("  ✓ Container parseado: %s", container.Names))
-
-		containers = append(containers, container)
-	}
-
-	logger.Verbose(fmt.Sprintf("✓ Total de containers parseados: %d", len(containers)))
+	logger.Verbose(fmt.Sprintf("Total de containers: %d", len(containers)))
 	logger.Verbose("")
 
 	logger.Verbose("▶ Agrupando containers com local_folder (principais)...")
@@ -53,16 +43,15 @@ func ParseContainerOutput(output string) map[string][]*Container {
 	logger.Verbose("▶ Agrupando containers sem local_folder (auxiliares)...")
 	for _, container := range containers {
 		if container.LocalFolder == "" {
-			prefix := extractDevcontainerPrefix(container.Names)
-			logger.Verbose(fmt.Sprintf("  ℹ %s (prefixo: %s)", container.Names, prefix))
+			logger.Verbose(fmt.Sprintf("  ℹ %s (projeto: %s)", container.Names, container.ComposeProject))
 
-			mainFolder := findMainContainerFolder(prefix, containers)
+			mainFolder := findMainContainerFolder(container, containers)
 			if mainFolder != "" {
 				logger.Verbose(fmt.Sprintf("    ✓ Agrupado em: %s", mainFolder))
 				grouped[mainFolder] = append(grouped[mainFolder], container)
 			} else {
 				logger.Verbose("    ⚠ Nenhum container principal encontrado")
-				grouped["[sem pasta local mapeada]"] = append(grouped["[sem pasta local mapeada]"], container)
+				grouped[NoLocalFolderGroup] = append(grouped[NoLocalFolderGroup], container)
 			}
 		}
 	}
@@ -92,20 +81,22 @@ func FormatGroupedContainers(grouped map[string][]*Container) string {
 	var output strings.Builder
 	output.WriteString("Os containers atuais são:\n\n")
 
-	for folder, containers := range grouped {
+	for _, folder := range sortedGroupFolders(grouped) {
+		containers := sortedGroup(grouped[folder])
+
 		logger.Verbose(fmt.Sprintf("▶ Pasta: %s", folder))
 		logger.Verbose(fmt.Sprintf("  └─ %d container(s)", len(containers)))
 
 		output.WriteString(folder + "\n")
 		output.WriteString("---\n")
 
-		output.WriteString(fmt.Sprintf("%-12s %-45s %-15s %s\n",
-			"CONTAINER ID", "NAMES", "STATUS", "FOLDER"))
+		output.WriteString(fmt.Sprintf("%-12s %-45s %-10s %-25s %-20s %s\n",
+			"CONTAINER ID", "NAMES", "STATE", "STATUS", "PROJECT", "FOLDER"))
 
 		for i, container := range containers {
 			logger.Verbose(fmt.Sprintf("    %d. %s", i+1, container.Names))
-			output.WriteString(fmt.Sprintf("%-12s %-45s %-15s %s\n",
-				container.ID, container.Names, container.Status, container.LocalFolder))
+			output.WriteString(fmt.Sprintf("%-12s %-45s %-10s %-25s %-20s %s\n",
+				container.ID, container.Names, container.State, container.Status, container.ComposeProject, container.LocalFolder))
 		}
 
 		output.WriteString("---\n\n")
@@ -117,35 +108,93 @@ func FormatGroupedContainers(grouped map[string][]*Container) string {
 	return output.String()
 }
 
-func parseContainerLine(line string) *Container {
-	parts := strings.Fields(line)
-	if len(parts) < 3 {
-		return nil
+// WorkspaceContainers flattens the groups into a list in the same order as
+// the table, so machine readable output is stable.
+func WorkspaceContainers(grouped map[string][]*Container) []WorkspaceContainer {
+	result := []WorkspaceContainer{}
+	for _, workspace := range sortedGroupFolders(grouped) {
+		for _, container := range sortedGroup(grouped[workspace]) {
+			result = append(result, WorkspaceContainer{Workspace: workspace, Container: container})
+		}
+	}
+
+	return result
+}
+
+// sortedGroupFolders returns the group folders sorted, with the group of
+// containers without a local folder last.
+func sortedGroupFolders(grouped map[string][]*Container) []string {
+	folders := slices.Collect(maps.Keys(grouped))
+
+	slices.SortFunc(folders, func(a, b string) int {
+		return cmp.Or(
+			cmp.Compare(boolToInt(a == NoLocalFolderGroup), boolToInt(b == NoLocalFolderGroup)),
+			cmp.Compare(a, b),
+		)
+	})
+
+	return folders
+}
+
+// sortedGroup returns the containers of a group with the devcontainers
+// first, then sorted by name.
+func sortedGroup(containers []*Container) []*Container {
+	sorted := slices.Clone(containers)
+
+	slices.SortStableFunc(sorted, func(a, b *Container) int {
+		return cmp.Or(
+			cmp.Compare(boolToInt(a.LocalFolder == ""), boolToInt(b.LocalFolder == "")),
+			cmp.Compare(a.Names, b.Names),
+		)
+	})
+
+	return sorted
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
 	}
 
-	id := parts[0]
-	names := parts[1]
+	return 0
+}
 
-	var status string
-	var localFolder string
+// ParsePorts parses the `port` output, one "3000/tcp -> 0.0.0.0:3000" per
+// line. Lines in another format are ignored.
+func ParsePorts(output string) []PortMapping {
+	ports := []PortMapping{}
 
-	for j := 2; j < len(parts); j++ {
-		if strings.HasPrefix(parts[j], "/") {
-			localFolder = strings.Join(parts[j:], " ")
-			break
+	for _, line := range strings.Split(output, "\n") {
+		containerSide, hostSide, found := strings.Cut(strings.TrimSpace(line), " -> ")
+		if !found {
+			continue
 		}
-		if status != "" {
-			status += " "
+
+		port, protocol, _ := strings.Cut(containerSide, "/")
+		containerPort, err := strconv.Atoi(port)
+		if err != nil {
+			continue
 		}
-		status += parts[j]
-	}
 
-	return &Container{
-		ID:          id,
-		Names:       names,
-		Status:      status,
-		LocalFolder: localFolder,
+		separator := strings.LastIndex(hostSide, ":")
+		if separator < 0 {
+			continue
+		}
+
+		hostPort, err := strconv.Atoi(hostSide[separator+1:])
+		if err != nil {
+			continue
+		}
+
+		ports = append(ports, PortMapping{
+			ContainerPort: containerPort,
+			Protocol:      protocol,
+			HostIP:        strings.Trim(hostSide[:separator], "[]"),
+			HostPort:      hostPort,
+		})
 	}
+
+	return ports
 }
 
 func extractDevcontainerPrefix(names string) string {
@@ -156,7 +205,16 @@ func extractDevcontainerPrefix(names string) string {
 	return ""
 }
 
-func findMainContainerFolder(prefix string, containers []*Container) string {
+func findMainContainerFolder(aux *Container, containers []*Container) string {
+	if aux.ComposeProject != "" {
+		for _, container := range containers {
+			if container.LocalFolder != "" && container.ComposeProject == aux.ComposeProject {
+				return container.LocalFolder
+			}
+		}
+	}
+
+	prefix := extractDevcontainerPrefix(aux.Names)
 	for _, container := range containers {
 		if container.LocalFolder != "" && strings.HasPrefix(container.Names, prefix) {
 			return container.LocalFolder
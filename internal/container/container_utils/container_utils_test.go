@@ -1,6 +1,7 @@
 package container_utils
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"strings"
@@ -9,6 +10,7 @@ import (
 	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 func TestMain(m *testing.M) {
@@ -30,18 +32,6 @@ func createMockContainer(id, names, status, localFolder string) *Container {
 	}
 }
 
-func createMockDockerOutput(containers []*Container) string {
-	var lines []string
-	lines = append(lines, "CONTAINER ID\tNAMES\tSTATUS\tLOCAL FOLDER")
-
-	for _, c := range containers {
-		line := fmt.Sprintf("%s\t%s\t%s\t%s", c.ID, c.Names, c.Status, c.LocalFolder)
-		lines = append(lines, line)
-	}
-
-	return strings.Join(lines, "\n")
-}
-
 func assertGroupContainsContainer(t *testing.T, grouped map[string][]*Container, folder string, expectedNames []string) {
 	r := require.New(t)
 	containers, exists := grouped[folder]
@@ -56,32 +46,28 @@ func assertGroupContainsContainer(t *testing.T, grouped map[string][]*Container,
 }
 
 // ============================================================================
-// Tests for ParseContainerOutput
+// Tests for GroupContainers
 // ============================================================================
 
-func TestParseContainerOutput_SingleContainerWithLocalFolder_GroupedCorrectly(t *testing.T) {
+func TestGroupContainers_SingleContainerWithLocalFolder_GroupedCorrectly(t *testing.T) {
 	r := require.New(t)
 	containers := []*Container{
 		createMockContainer("abc123", "myapp_devcontainer-sfa.app-1", "Up 5 minutes", "/home/user/myapp"),
 	}
-	output := createMockDockerOutput(containers)
-
-	got := ParseContainerOutput(output)
+	got := GroupContainers(containers)
 
 	r.Len(got, 1)
 	assertGroupContainsContainer(t, got, "/home/user/myapp", []string{"myapp_devcontainer-sfa.app-1"})
 }
 
-func TestParseContainerOutput_ThreeContainersWithCommonPrefix_AllGroupedTogether(t *testing.T) {
+func TestGroupContainers_ThreeContainersWithCommonPrefix_AllGroupedTogether(t *testing.T) {
 	r := require.New(t)
 	containers := []*Container{
 		createMockContainer("f7bc76eda682", "simple-financial-app_devcontainer-sfa.app-1", "Up 5 minutes", "/home/brennon/projects/sfa/simple-financial-app"),
 		createMockContainer("9c88be57f94f", "simple-financial-app_devcontainer-sfa.smtp-1", "Up 5 minutes", ""),
 		createMockContainer("10103216832d", "simple-financial-app_devcontainer-sfa.db-1", "Up 10 minutes", ""),
 	}
-	output := createMockDockerOutput(containers)
-
-	got := ParseContainerOutput(output)
+	got := GroupContainers(containers)
 
 	r.Len(got, 1)
 	r.Len(got["/home/brennon/projects/sfa/simple-financial-app"], 3)
@@ -92,7 +78,7 @@ func TestParseContainerOutput_ThreeContainersWithCommonPrefix_AllGroupedTogether
 	})
 }
 
-func TestParseContainerOutput_TwoDevcontainersWithDifferentLocalFolders_CreatedMultipleGroups(t *testing.T) {
+func TestGroupContainers_TwoDevcontainersWithDifferentLocalFolders_CreatedMultipleGroups(t *testing.T) {
 	r := require.New(t)
 	containers := []*Container{
 		createMockContainer("id1", "app1_devcontainer-sfa.app-1", "Up 5 minutes", "/home/user/app1"),
@@ -100,9 +86,7 @@ func TestParseContainerOutput_TwoDevcontainersWithDifferentLocalFolders_CreatedM
 		createMockContainer("id3", "app2_devcontainer-sfa.app-1", "Up 10 minutes", "/home/user/app2"),
 		createMockContainer("id4", "app2_devcontainer-sfa.db-1", "Up 10 minutes", ""),
 	}
-	output := createMockDockerOutput(containers)
-
-	got := ParseContainerOutput(output)
+	got := GroupContainers(containers)
 
 	r.Len(got, 2)
 	r.Len(got["/home/user/app1"], 2)
@@ -117,70 +101,45 @@ func TestParseContainerOutput_TwoDevcontainersWithDifferentLocalFolders_CreatedM
 	})
 }
 
-func TestParseContainerOutput_ContainerWithMultiWordStatus_ParsedCorrectly(t *testing.T) {
+func TestGroupContainers_ContainerWithMultiWordStatus_ParsedCorrectly(t *testing.T) {
 	r := require.New(t)
 	containers := []*Container{
 		createMockContainer("abc123", "myapp_devcontainer-sfa.app-1", "Up About a minute", "/home/user/myapp"),
 	}
-	output := createMockDockerOutput(containers)
-
-	got := ParseContainerOutput(output)
+	got := GroupContainers(containers)
 
 	r.Len(got, 1)
 	parsedContainer := got["/home/user/myapp"][0]
 	assert.Equal(t, "Up About a minute", parsedContainer.Status)
 }
 
-func TestParseContainerOutput_ContainerWithVeryLongStatus_HandlesProperly(t *testing.T) {
+func TestGroupContainers_ContainerWithVeryLongStatus_HandlesProperly(t *testing.T) {
 	r := require.New(t)
 	longStatus := "Up 29 days, 13 hours and 45 minutes"
 	containers := []*Container{
 		createMockContainer("abc123", "myapp_devcontainer-sfa.app-1", longStatus, "/home/user/myapp"),
 	}
-	output := createMockDockerOutput(containers)
-
-	got := ParseContainerOutput(output)
+	got := GroupContainers(containers)
 
 	r.Len(got, 1)
 	parsedContainer := got["/home/user/myapp"][0]
 	assert.Equal(t, longStatus, parsedContainer.Status)
 }
 
-func TestParseContainerOutput_MalformedLineWithLessThanThreeFields_IgnoredSilently(t *testing.T) {
+func TestGroupContainers_NoContainers_ReturnsEmptyMap(t *testing.T) {
 	r := require.New(t)
-	output := "CONTAINER ID\tNAMES\tSTATUS\tLOCAL FOLDER\nabc def"
 
-	got := ParseContainerOutput(output)
+	got := GroupContainers(nil)
 
 	r.Len(got, 0)
 }
 
-func TestParseContainerOutput_EmptyOutput_ReturnsEmptyMap(t *testing.T) {
-	r := require.New(t)
-	output := ""
-
-	got := ParseContainerOutput(output)
-
-	r.Len(got, 0)
-}
-
-func TestParseContainerOutput_OutputWithOnlyHeader_ReturnsEmptyMap(t *testing.T) {
-	r := require.New(t)
-	output := "CONTAINER ID\tNAMES\tSTATUS\tLOCAL FOLDER"
-
-	got := ParseContainerOutput(output)
-
-	r.Len(got, 0)
-}
-
-func TestParseContainerOutput_ContainerWithoutLocalFolderNoMainContainer_GroupedInDefaultBucket(t *testing.T) {
+func TestGroupContainers_ContainerWithoutLocalFolderNoMainContainer_GroupedInDefaultBucket(t *testing.T) {
 	r := require.New(t)
 	containers := []*Container{
 		createMockContainer("orphan123", "orphan_devcontainer-sfa.app-1", "Up 5 minutes", ""),
 	}
-	output := createMockDockerOutput(containers)
-
-	got := ParseContainerOutput(output)
+	got := GroupContainers(containers)
 
 	r.Len(got, 1)
 	_, exists := got["[sem pasta local mapeada]"]
@@ -188,30 +147,26 @@ func TestParseContainerOutput_ContainerWithoutLocalFolderNoMainContainer_Grouped
 	assert.Len(t, got["[sem pasta local mapeada]"], 1)
 }
 
-func TestParseContainerOutput_MultipleOrphanContainers_AllInDefaultBucket(t *testing.T) {
+func TestGroupContainers_MultipleOrphanContainers_AllInDefaultBucket(t *testing.T) {
 	r := require.New(t)
 	containers := []*Container{
 		createMockContainer("id1", "orphan1_devcontainer-sfa.app-1", "Up 5 minutes", ""),
 		createMockContainer("id2", "orphan1_devcontainer-sfa.db-1", "Up 5 minutes", ""),
 		createMockContainer("id3", "orphan2_devcontainer-sfa.app-1", "Up 5 minutes", ""),
 	}
-	output := createMockDockerOutput(containers)
-
-	got := ParseContainerOutput(output)
+	got := GroupContainers(containers)
 
 	r.Len(got, 1)
 	assert.Len(t, got["[sem pasta local mapeada]"], 3)
 }
 
-func TestParseContainerOutput_PrefixWithSpecialCharactersAndNumbers_ExtractedCorrectly(t *testing.T) {
+func TestGroupContainers_PrefixWithSpecialCharactersAndNumbers_ExtractedCorrectly(t *testing.T) {
 	r := require.New(t)
 	containers := []*Container{
 		createMockContainer("abc123", "my-app-v2.1_devcontainer-sfa.app-1", "Up 5 minutes", "/home/user/my-app-v2.1"),
 		createMockContainer("def456", "my-app-v2.1_devcontainer-sfa.db-1", "Up 5 minutes", ""),
 	}
-	output := createMockDockerOutput(containers)
-
-	got := ParseContainerOutput(output)
+	got := GroupContainers(containers)
 
 	r.Len(got, 1)
 	assert.Len(t, got["/home/user/my-app-v2.1"], 2)
@@ -221,6 +176,22 @@ func TestParseContainerOutput_PrefixWithSpecialCharactersAndNumbers_ExtractedCor
 	})
 }
 
+func TestGroupContainers_AuxiliaryInSameComposeProject_GroupedWithMainContainer(t *testing.T) {
+	r := require.New(t)
+	main := createMockContainer("id1", "workspace-app-1", "Up 5 minutes", "/home/user/my app")
+	main.ComposeProject = "workspace"
+	aux := createMockContainer("id2", "postgres-db", "Up 5 minutes", "")
+	aux.ComposeProject = "workspace"
+	other := createMockContainer("id3", "other-app-1", "Up 5 minutes", `C:\Users\user\other`)
+	other.ComposeProject = "other"
+
+	got := GroupContainers([]*Container{main, aux, other})
+
+	r.Len(got, 2)
+	assertGroupContainsContainer(t, got, "/home/user/my app", []string{"workspace-app-1", "postgres-db"})
+	assertGroupContainsContainer(t, got, `C:\Users\user\other`, []string{"other-app-1"})
+}
+
 // ============================================================================
 // Tests for FormatGroupedContainers
 // ============================================================================
@@ -417,3 +388,124 @@ func TestFormatGroupedContainers_OutputAlwaysContainsSeparators(t *testing.T) {
 	separatorCount := strings.Count(got, "---")
 	assert.GreaterOrEqual(t, separatorCount, 2)
 }
+
+// ============================================================================
+// Tests for WorkspaceContainers
+// ============================================================================
+
+func TestWorkspaceContainers_SortedByWorkspaceAndName(t *testing.T) {
+	r := require.New(t)
+	grouped := map[string][]*Container{
+		"/home/user/b": {
+			createMockContainer("id3", "b-app", "Up", "/home/user/b"),
+		},
+		"/home/user/a": {
+			createMockContainer("id2", "a-db", "Up", ""),
+			createMockContainer("id1", "a-app", "Up", "/home/user/a"),
+		},
+	}
+
+	got := WorkspaceContainers(grouped)
+
+	r.Len(got, 3)
+	r.Equal("/home/user/a", got[0].Workspace)
+	r.Equal("a-app", got[0].Names)
+	r.Equal("a-db", got[1].Names)
+	r.Equal("/home/user/b", got[2].Workspace)
+}
+
+func TestWorkspaceContainers_EmptyGroups_ReturnsEmptyList(t *testing.T) {
+	r := require.New(t)
+
+	got := WorkspaceContainers(map[string][]*Container{})
+
+	r.NotNil(got)
+	r.Empty(got)
+}
+
+func TestWorkspaceContainers_JSONSchemaIsFlat(t *testing.T) {
+	r := require.New(t)
+	grouped := map[string][]*Container{
+		"/home/user/a": {createMockContainer("id1", "a-app", "Up", "/home/user/a")},
+	}
+
+	data, err := json.Marshal(WorkspaceContainers(grouped))
+
+	r.Nil(err)
+	r.Contains(string(data), `"workspace":"/home/user/a","id":"id1","names":"a-app"`)
+}
+
+func TestWorkspaceContainers_YAMLSchemaIsFlat(t *testing.T) {
+	r := require.New(t)
+	grouped := map[string][]*Container{
+		"/home/user/a": {createMockContainer("id1", "a-app", "Up", "/home/user/a")},
+	}
+
+	data, err := yaml.Marshal(WorkspaceContainers(grouped))
+
+	r.Nil(err)
+	r.Contains(string(data), "- workspace: /home/user/a\n  id: id1\n  names: a-app\n")
+}
+
+// ============================================================================
+// Tests for ParsePorts
+// ============================================================================
+
+func TestParsePorts_ParsesIPv4AndIPv6Mappings(t *testing.T) {
+	r := require.New(t)
+	output := "3000/tcp -> 0.0.0.0:3000\r\n3000/tcp -> [::]:3000\n5353/udp -> 127.0.0.1:15353\n"
+
+	got := ParsePorts(output)
+
+	r.Equal([]PortMapping{
+		{ContainerPort: 3000, Protocol: "tcp", HostIP: "0.0.0.0", HostPort: 3000},
+		{ContainerPort: 3000, Protocol: "tcp", HostIP: "::", HostPort: 3000},
+		{ContainerPort: 5353, Protocol: "udp", HostIP: "127.0.0.1", HostPort: 15353},
+	}, got)
+}
+
+func TestParsePorts_IgnoresUnknownLines(t *testing.T) {
+	r := require.New(t)
+
+	got := ParsePorts("Error: no public port\nabc/tcp -> 0.0.0.0:1\n80/tcp -> 0.0.0.0:http\n")
+
+	r.NotNil(got)
+	r.Empty(got)
+}
+
+func TestFormatGroupedContainers_GroupsAndContainersSortedDeterministically(t *testing.T) {
+	r := require.New(t)
+	grouped := map[string][]*Container{
+		"[sem pasta local mapeada]": {createMockContainer("id9", "orphan", "Up", "")},
+		"/home/user/b":              {createMockContainer("id3", "b-app", "Up", "/home/user/b")},
+		"/home/user/a": {
+			createMockContainer("id2", "a-cache", "Up", ""),
+			createMockContainer("id4", "a-db", "Up", ""),
+			createMockContainer("id1", "z-app", "Up", "/home/user/a"),
+		},
+	}
+
+	for range 10 {
+		got := FormatGroupedContainers(grouped)
+
+		r.Less(strings.Index(got, "/home/user/a\n"), strings.Index(got, "/home/user/b\n"))
+		r.Less(strings.Index(got, "/home/user/b\n"), strings.Index(got, "[sem pasta local mapeada]"))
+		r.Less(strings.Index(got, "z-app"), strings.Index(got, "a-cache"))
+		r.Less(strings.Index(got, "a-cache"), strings.Index(got, "a-db"))
+	}
+}
+
+func TestFormatGroupedContainers_ShowsStateStatusAndProject(t *testing.T) {
+	r := require.New(t)
+	container := createMockContainer("abc123", "app-1", "Exited (0) 2 hours ago", "/home/user/app")
+	container.State = "exited"
+	container.ComposeProject = "shop"
+
+	got := FormatGroupedContainers(map[string][]*Container{"/home/user/app": {container}})
+
+	r.Contains(got, "STATE")
+	r.Contains(got, "PROJECT")
+	r.Contains(got, "exited")
+	r.Contains(got, "Exited (0) 2 hours ago")
+	r.Contains(got, "shop")
+}
@@ -0,0 +1,88 @@
+package container_utils
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// VolumeMountPath is where the throwaway container of `dev volumes` mounts
+// the volume it copies.
+const VolumeMountPath = "/volume"
+
+// BackupVolumeCommand writes the content of the volume to stdout as a tar
+// archive.
+var BackupVolumeCommand = []string{"tar", "cf", "-", "-C", VolumeMountPath, "."}
+
+// RestoreVolumeCommand empties the volume and extracts the tar archive read
+// from stdin into it. Owners are kept by ID, as the users of the service
+// image don't exist in the helper image.
+var RestoreVolumeCommand = []string{
+	"sh", "-c",
+	"find " + VolumeMountPath + " -mindepth 1 -maxdepth 1 -exec rm -rf {} + && tar xf - --numeric-owner -C " + VolumeMountPath,
+}
+
+type FormatVolumesFunc func(volumes []WorkspaceVolume) string
+type MkdirAllFunc func(path string, perm os.FileMode) error
+type CreateTempFunc func(dir string, pattern string) (TempFile, error)
+type RenameFunc func(oldpath string, newpath string) error
+type RemoveFunc func(name string) error
+type StatFunc func(name string) (os.FileInfo, error)
+type OpenFunc func(name string) (io.ReadCloser, error)
+
+// TempFile is the temporary file a backup is written to before it replaces
+// the previous archive.
+type TempFile interface {
+	io.WriteCloser
+	Name() string
+}
+
+// CreateTemp creates a temporary file in dir, like os.CreateTemp.
+func CreateTemp(dir string, pattern string) (TempFile, error) {
+	file, err := os.CreateTemp(dir, pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	return file, nil
+}
+
+// Open opens a file for reading, like os.Open.
+func Open(name string) (io.ReadCloser, error) {
+	file, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return file, nil
+}
+
+// VolumeBackupFile is the archive of a volume in a backup directory.
+func VolumeBackupFile(dir string, volume string) string {
+	return filepath.Join(dir, volume+".tar")
+}
+
+// FormatVolumes renders the named volumes of a workspace with the services
+// mounting them.
+func FormatVolumes(volumes []WorkspaceVolume) string {
+	if len(volumes) == 0 {
+		return "Nenhum volume nomeado encontrado nos containers do workspace."
+	}
+
+	var output strings.Builder
+	line := "%-40s %-30s %s\n"
+	output.WriteString(fmt.Sprintf(line, "VOLUME", "SERVICES", "IN USE"))
+
+	for _, volume := range volumes {
+		inUse := "não"
+		if volume.InUse {
+			inUse = "sim"
+		}
+
+		output.WriteString(fmt.Sprintf(line, volume.Name, strings.Join(volume.Services, ", "), inUse))
+	}
+
+	return output.String()
+}
@@ -0,0 +1,43 @@
+package container_utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================================
+// Tests for VolumeBackupFile
+// ============================================================================
+
+func TestVolumeBackupFile_NamesArchiveAfterVolume(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal("/backups/project_pgdata.tar", VolumeBackupFile("/backups", "project_pgdata"))
+}
+
+// ============================================================================
+// Tests for FormatVolumes
+// ============================================================================
+
+func TestFormatVolumes_NoVolumes(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal("Nenhum volume nomeado encontrado nos containers do workspace.", FormatVolumes(nil))
+}
+
+func TestFormatVolumes_WritesServicesAndUsage(t *testing.T) {
+	r := require.New(t)
+
+	out := FormatVolumes([]WorkspaceVolume{
+		{Name: "project_pgdata", Services: []string{"db"}, InUse: true},
+		{Name: "project_shared", Services: []string{"app", "worker"}},
+	})
+
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	r.Len(lines, 3)
+	r.Regexp(`^VOLUME\s+SERVICES\s+IN USE$`, lines[0])
+	r.Regexp(`^project_pgdata\s+db\s+sim$`, lines[1])
+	r.Regexp(`^project_shared\s+app, worker\s+não$`, lines[2])
+}
@@ -0,0 +1,73 @@
+package container_utils
+
+import (
+	"fmt"
+	"strings"
+)
+
+// HealthHealthy is the health of a container whose healthcheck passes.
+const HealthHealthy = "healthy"
+
+// HealthUnhealthy is the health of a container whose healthcheck fails.
+const HealthUnhealthy = "unhealthy"
+
+type FormatServiceStatusFunc func(statuses []ServiceStatus) string
+
+// IsReady tells whether a container is running and, when it has a
+// healthcheck, healthy.
+func IsReady(state string, health string) bool {
+	return state == "running" && (health == "" || health == HealthHealthy)
+}
+
+// IsDone tells whether a one-shot compose service, such as a migration or
+// seed job, finished successfully.
+func IsDone(state string, exitCode int) bool {
+	return state == "exited" && exitCode == 0
+}
+
+// IsFailed tells whether a container exited with an error, died or its
+// healthcheck failed, so it won't become ready without an intervention.
+func IsFailed(state string, health string, exitCode int) bool {
+	return (state == "exited" && exitCode != 0) || state == "dead" || health == HealthUnhealthy
+}
+
+// DescribeServiceStatus is the status line of a service while waiting.
+func DescribeServiceStatus(status ServiceStatus) string {
+	description := status.State
+	if status.State == "exited" {
+		description += fmt.Sprintf(" (código %d)", status.ExitCode)
+	}
+	if status.Health != "" {
+		description += " (" + status.Health + ")"
+	}
+
+	return fmt.Sprintf("%s: %s", status.Service, description)
+}
+
+// FormatServiceStatus renders the readiness of the services of a
+// workspace.
+func FormatServiceStatus(statuses []ServiceStatus) string {
+	if len(statuses) == 0 {
+		return "Nenhum container encontrado."
+	}
+
+	var output strings.Builder
+	line := "%-20s %-30s %-12s %-12s %s\n"
+	output.WriteString(fmt.Sprintf(line, "SERVICE", "NAME", "STATE", "HEALTH", "READY"))
+
+	for _, status := range statuses {
+		health := status.Health
+		if health == "" {
+			health = "-"
+		}
+
+		ready := "não"
+		if status.Ready {
+			ready = "sim"
+		}
+
+		output.WriteString(fmt.Sprintf(line, status.Service, status.Container, status.State, health, ready))
+	}
+
+	return output.String()
+}
@@ -0,0 +1,115 @@
+package container_utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================================
+// Tests for IsReady
+// ============================================================================
+
+func TestIsReady(t *testing.T) {
+	tests := []struct {
+		name     string
+		state    string
+		health   string
+		expected bool
+	}{
+		{name: "running without healthcheck", state: "running", expected: true},
+		{name: "running and healthy", state: "running", health: "healthy", expected: true},
+		{name: "healthcheck starting", state: "running", health: "starting"},
+		{name: "unhealthy", state: "running", health: "unhealthy"},
+		{name: "exited", state: "exited"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			r.Equal(tt.expected, IsReady(tt.state, tt.health))
+		})
+	}
+}
+
+// ============================================================================
+// Tests for IsFailed
+// ============================================================================
+
+func TestIsFailed(t *testing.T) {
+	tests := []struct {
+		name     string
+		state    string
+		health   string
+		exitCode int
+		expected bool
+	}{
+		{name: "running without healthcheck", state: "running"},
+		{name: "healthcheck starting", state: "running", health: "starting"},
+		{name: "created", state: "created"},
+		{name: "restarting", state: "restarting"},
+		{name: "exited successfully", state: "exited"},
+		{name: "unhealthy", state: "running", health: "unhealthy", expected: true},
+		{name: "exited with error", state: "exited", exitCode: 1, expected: true},
+		{name: "dead", state: "dead", expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			r.Equal(tt.expected, IsFailed(tt.state, tt.health, tt.exitCode))
+		})
+	}
+}
+
+// ============================================================================
+// Tests for IsDone
+// ============================================================================
+
+func TestIsDone_OnlyExitedSuccessfully(t *testing.T) {
+	r := require.New(t)
+
+	r.True(IsDone("exited", 0))
+	r.False(IsDone("exited", 2))
+	r.False(IsDone("running", 0))
+}
+
+// ============================================================================
+// Tests for DescribeServiceStatus
+// ============================================================================
+
+func TestDescribeServiceStatus_AddsHealthWhenPresent(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal("app: running", DescribeServiceStatus(ServiceStatus{Service: "app", State: "running"}))
+	r.Equal("db: running (starting)", DescribeServiceStatus(ServiceStatus{Service: "db", State: "running", Health: "starting"}))
+	r.Equal("migrate: exited (código 1)", DescribeServiceStatus(ServiceStatus{Service: "migrate", State: "exited", ExitCode: 1}))
+}
+
+// ============================================================================
+// Tests for FormatServiceStatus
+// ============================================================================
+
+func TestFormatServiceStatus_NoContainers(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal("Nenhum container encontrado.", FormatServiceStatus(nil))
+}
+
+func TestFormatServiceStatus_WritesStateAndHealth(t *testing.T) {
+	r := require.New(t)
+
+	out := FormatServiceStatus([]ServiceStatus{
+		{Service: "app", Container: "project_app_1", State: "running", Ready: true},
+		{Service: "db", Container: "project_db_1", State: "running", Health: "starting"},
+	})
+
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	r.Len(lines, 3)
+	r.Regexp(`^SERVICE\s+NAME\s+STATE\s+HEALTH\s+READY$`, lines[0])
+	r.Regexp(`^app\s+project_app_1\s+running\s+-\s+sim$`, lines[1])
+	r.Regexp(`^db\s+project_db_1\s+running\s+starting\s+não$`, lines[2])
+}
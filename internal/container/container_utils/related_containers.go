@@ -1,18 +1,21 @@
 package container_utils
 
 import (
+	"slices"
 	"strings"
 
-	"github.com/Brennon-Oliveira/dev-cli/internal/exec"
+	"github.com/Brennon-Oliveira/dev-cli/internal/engine"
 	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
 	"github.com/Brennon-Oliveira/dev-cli/internal/pather"
 )
 
 type TryPathsFunc func(path string, pth pather.Pather) []string
-type FindMainContainersForPathFunc func(tool string, p string, executor exec.Executor) ([]string, error)
-type ExtractProjectFromContainerFunc func(tool string, id string, executor exec.Executor) (string, error)
-type FindComposeContainersForProjectFunc func(tool string, project string, executor exec.Executor) ([]string, error)
+type FindMainContainersForPathFunc func(eng engine.Engine, p string) ([]string, error)
+type ExtractProjectFromContainerFunc func(eng engine.Engine, id string) (string, error)
+type FindComposeContainersForProjectFunc func(eng engine.Engine, project string) ([]string, error)
 type DeduplicateAndFilterContainerIDsFunc func(idMap map[string]bool) []string
+type FindDevcontainersFunc func(eng engine.Engine, all bool) ([]*Container, error)
+type GroupContainersByPodFunc func(eng engine.Engine, ids []string) ([]string, []string, error)
 
 func TryPaths(path string, pth pather.Pather) []string {
 	pathsToTry := []string{path}
@@ -26,68 +29,143 @@ func TryPaths(path string, pth pather.Pather) []string {
 	return pathsToTry
 }
 
-func FindMainContainersForPath(tool string, p string, executor exec.Executor) ([]string, error) {
+func FindMainContainersForPath(eng engine.Engine, p string) ([]string, error) {
 	logger.Verbose("Verificando caminho: %s", p)
 	filter := "label=devcontainer.local_folder=" + p
 	logger.Verbose("Filtro aplicado: %s", filter)
 
-	out, err := executor.Output(tool, "ps", "-a", "-q", "--filter", filter)
+	mainIDs, err := eng.List(engine.ListOptions{All: true, Filters: []string{filter}})
 	if err != nil {
 		logger.Error("Houve um erro ao buscar os containers relacionados")
 		return nil, err
 	}
 
-	idStr := strings.TrimSpace(string(out))
-	if idStr == "" {
+	if len(mainIDs) == 0 {
 		return nil, nil
 	}
 
-	idStr = strings.ReplaceAll(idStr, "\r\n", "\n")
-	mainIDs := strings.Split(idStr, "\n")
 	logger.Verbose("Containers encontrados:")
-	logger.Verbose(idStr)
+	logger.Verbose(strings.Join(mainIDs, "\n"))
 	return mainIDs, nil
 }
 
-func ExtractProjectFromContainer(tool string, id string, executor exec.Executor) (string, error) {
+func ExtractProjectFromContainer(eng engine.Engine, id string) (string, error) {
 	logger.Verbose("Verificando container '%s'", id)
 
-	out, err := executor.Output(tool, "inspect", "-f", `{{ if .Config.Labels }}{{ index .Config.Labels "com.docker.compose.project" }}{{ end }}`, id)
+	details, err := eng.Inspect(id)
 	if err != nil {
 		logger.Error("Houve um erro ao inspecionar o container %s", id)
 		return "", err
 	}
 
-	project := strings.TrimSpace(string(out))
-
-	if project == "" || project == "<no value>" {
-		logger.Verbose("Sem projeto associado")
-		return "", nil
+	for _, label := range eng.ComposeProjectLabels() {
+		if project := details.Labels[label]; project != "" {
+			logger.Verbose("Projeto encontrado: %s (%s)", project, label)
+			return project, nil
+		}
 	}
 
-	logger.Verbose("Projeto encontrado: %s", project)
-	return project, nil
+	logger.Verbose("Sem projeto associado")
+	return "", nil
 }
 
-func FindComposeContainersForProject(tool string, project string, executor exec.Executor) ([]string, error) {
-	filter := "label=com.docker.compose.project=" + project
-	out, err := executor.Output(tool, "ps", "-a", "-q", "--filter", filter)
+// FindComposeContainersForProject returns the containers labeled with
+// project under any of the engine compose labels, without repetitions.
+func FindComposeContainersForProject(eng engine.Engine, project string) ([]string, error) {
+	var compIDs []string
+	seen := make(map[string]bool)
+
+	for _, label := range eng.ComposeProjectLabels() {
+		filter := "label=" + label + "=" + project
+
+		ids, err := eng.List(engine.ListOptions{All: true, Filters: []string{filter}})
+		if err != nil {
+			logger.Error("Houve um erro ao buscar os containers do projeto %s", project)
+			return nil, err
+		}
+
+		for _, id := range ids {
+			if !seen[id] {
+				seen[id] = true
+				compIDs = append(compIDs, id)
+			}
+		}
+	}
 
+	return compIDs, nil
+}
+
+// FindDevcontainers lists the containers labeled with a local folder and
+// the other services of their compose projects, which don't carry the label.
+// Stopped containers are included when all is set.
+func FindDevcontainers(eng engine.Engine, all bool) ([]*Container, error) {
+	mainContainers, err := eng.ListContainers(engine.ListOptions{All: all, Filters: []string{"label=devcontainer.local_folder"}})
 	if err != nil {
-		logger.Error("Houve um erro ao buscar os containers do projeto %s", project)
+		logger.Error("Houve um erro ao buscar os DevContainers")
 		return nil, err
 	}
 
-	compIDsStr := strings.TrimSpace(string(out))
+	containers := slices.Clone(mainContainers)
+	seen := make(map[string]bool)
+	var projects []string
 
-	if compIDsStr == "" {
-		return nil, nil
+	for _, container := range mainContainers {
+		seen[container.ID] = true
+
+		if container.ComposeProject != "" && !slices.Contains(projects, container.ComposeProject) {
+			projects = append(projects, container.ComposeProject)
+		}
+	}
+
+	for _, project := range projects {
+		logger.Verbose("Buscando os serviços do projeto %s", project)
+
+		for _, label := range eng.ComposeProjectLabels() {
+			services, err := eng.ListContainers(engine.ListOptions{All: all, Filters: []string{"label=" + label + "=" + project}})
+			if err != nil {
+				logger.Error("Houve um erro ao buscar os containers do projeto %s", project)
+				return nil, err
+			}
+
+			for _, service := range services {
+				if !seen[service.ID] {
+					seen[service.ID] = true
+					containers = append(containers, service)
+				}
+			}
+		}
 	}
 
-	compIDsStr = strings.ReplaceAll(compIDsStr, "\r\n", "\n")
-	compIDs := strings.Split(compIDsStr, "\n")
+	return containers, nil
+}
 
-	return compIDs, nil
+// GroupContainersByPod separates the containers handled one by one from the
+// pods that hold the others. Pods are stopped and removed as a whole, which
+// also covers their infra container.
+func GroupContainersByPod(eng engine.Engine, ids []string) ([]string, []string, error) {
+	containerPods, err := eng.ContainerPods(ids...)
+	if err != nil {
+		logger.Error("Houve um erro ao buscar os pods dos containers")
+		return nil, nil, err
+	}
+
+	var standalone, pods []string
+	seen := make(map[string]bool)
+
+	for _, id := range ids {
+		pod, ok := containerPods[id]
+		if !ok {
+			standalone = append(standalone, id)
+			continue
+		}
+
+		if !seen[pod] {
+			seen[pod] = true
+			pods = append(pods, pod)
+		}
+	}
+
+	return standalone, pods, nil
 }
 
 func DeduplicateAndFilterContainerIDs(idMap map[string]bool) []string {
@@ -100,5 +178,8 @@ func DeduplicateAndFilterContainerIDs(idMap map[string]bool) []string {
 		finalIDs = append(finalIDs, id)
 	}
 
+	// Sorted, so the engine commands do not depend on the map order.
+	slices.Sort(finalIDs)
+
 	return finalIDs
 }
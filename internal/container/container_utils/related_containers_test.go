@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/Brennon-Oliveira/dev-cli/internal/engine"
 	"github.com/Brennon-Oliveira/dev-cli/internal/exec"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -19,9 +20,9 @@ func (m *mockPather) GetAbsPath(path string) (string, error) {
 	return args.String(0), args.Error(1)
 }
 
-func (m *mockPather) GetPathFromArgs(args []string) string {
+func (m *mockPather) GetPathFromArgs(args []string) (string, error) {
 	callArgs := m.Called(args)
-	return callArgs.String(0)
+	return callArgs.String(0), callArgs.Error(1)
 }
 
 func (m *mockPather) GetRealPath(path string) (string, error) {
@@ -29,6 +30,21 @@ func (m *mockPather) GetRealPath(path string) (string, error) {
 	return args.String(0), args.Error(1)
 }
 
+func (m *mockPather) DiscoverRoot(absPath string) string {
+	args := m.Called(absPath)
+	return args.String(0)
+}
+
+func newTestEngine(t *testing.T, tool string, executor exec.Executor) engine.Engine {
+	eng, err := engine.NewEngine(tool, engine.WithExecutor(executor))
+	require.NoError(t, err)
+	return eng
+}
+
+func inspectOutputWithLabels(labels string) string {
+	return `[{"Id":"abc123","Name":"/app","Config":{"Labels":` + labels + `},"State":{"Status":"running"}}]`
+}
+
 func TestResolvePaths_SinglePathNoRealPathDifference(t *testing.T) {
 	r := require.New(t)
 
@@ -78,7 +94,7 @@ func TestFindMainContainersForPath_ContainersFound(t *testing.T) {
 		return name == "docker"
 	}), mock.Anything).Return([]byte(mockOutput), nil)
 
-	ids, err := FindMainContainersForPath("docker", "/home/user/app", executor)
+	ids, err := FindMainContainersForPath(newTestEngine(t, "docker", executor), "/home/user/app")
 
 	r.Nil(err)
 	r.Len(ids, 2)
@@ -95,7 +111,7 @@ func TestFindMainContainersForPath_NoContainersFound(t *testing.T) {
 		return name == "docker"
 	}), mock.Anything).Return([]byte(""), nil)
 
-	ids, err := FindMainContainersForPath("docker", "/home/user/app", executor)
+	ids, err := FindMainContainersForPath(newTestEngine(t, "docker", executor), "/home/user/app")
 
 	r.Nil(err)
 	r.Nil(ids)
@@ -110,7 +126,7 @@ func TestFindMainContainersForPath_ExecutorReturnsError(t *testing.T) {
 		return name == "docker"
 	}), mock.Anything).Return(nil, fmt.Errorf("docker error"))
 
-	ids, err := FindMainContainersForPath("docker", "/home/user/app", executor)
+	ids, err := FindMainContainersForPath(newTestEngine(t, "docker", executor), "/home/user/app")
 
 	r.NotNil(err)
 	assert.ErrorContains(t, err, "docker error")
@@ -127,7 +143,7 @@ func TestFindMainContainersForPath_OutputWithWindowsLineEndings(t *testing.T) {
 		return name == "docker"
 	}), mock.Anything).Return([]byte(mockOutput), nil)
 
-	ids, err := FindMainContainersForPath("docker", "/home/user/app", executor)
+	ids, err := FindMainContainersForPath(newTestEngine(t, "docker", executor), "/home/user/app")
 
 	r.Nil(err)
 	r.Len(ids, 2)
@@ -144,7 +160,7 @@ func TestFindMainContainersForPath_UsesPodmanTool(t *testing.T) {
 		return name == "podman"
 	}), mock.Anything).Return([]byte(mockOutput), nil)
 
-	ids, err := FindMainContainersForPath("podman", "/path", executor)
+	ids, err := FindMainContainersForPath(newTestEngine(t, "podman", executor), "/path")
 
 	r.Nil(err)
 	r.Len(ids, 1)
@@ -154,13 +170,13 @@ func TestFindMainContainersForPath_UsesPodmanTool(t *testing.T) {
 func TestExtractProjectFromContainer_ProjectFound(t *testing.T) {
 	r := require.New(t)
 
-	mockOutput := "my-app-compose"
+	mockOutput := inspectOutputWithLabels(`{"com.docker.compose.project":"my-app-compose"}`)
 	executor := exec.NewMockExecutor(t)
 	executor.EXPECT().Output(mock.MatchedBy(func(name string) bool {
 		return name == "docker"
 	}), mock.Anything).Return([]byte(mockOutput), nil)
 
-	project, err := ExtractProjectFromContainer("docker", "abc123", executor)
+	project, err := ExtractProjectFromContainer(newTestEngine(t, "docker", executor), "abc123")
 
 	r.Nil(err)
 	assert.Equal(t, "my-app-compose", project)
@@ -173,24 +189,24 @@ func TestExtractProjectFromContainer_NoProjectLabel(t *testing.T) {
 	executor := exec.NewMockExecutor(t)
 	executor.EXPECT().Output(mock.MatchedBy(func(name string) bool {
 		return name == "docker"
-	}), mock.Anything).Return([]byte(""), nil)
+	}), mock.Anything).Return([]byte(inspectOutputWithLabels(`{"devcontainer.local_folder":"/home/user/app"}`)), nil)
 
-	project, err := ExtractProjectFromContainer("docker", "abc123", executor)
+	project, err := ExtractProjectFromContainer(newTestEngine(t, "docker", executor), "abc123")
 
 	r.Nil(err)
 	assert.Equal(t, "", project)
 	executor.AssertExpectations(t)
 }
 
-func TestExtractProjectFromContainer_NoValueReturned(t *testing.T) {
+func TestExtractProjectFromContainer_ContainerWithoutLabels(t *testing.T) {
 	r := require.New(t)
 
 	executor := exec.NewMockExecutor(t)
 	executor.EXPECT().Output(mock.MatchedBy(func(name string) bool {
 		return name == "docker"
-	}), mock.Anything).Return([]byte("<no value>"), nil)
+	}), mock.Anything).Return([]byte(inspectOutputWithLabels("null")), nil)
 
-	project, err := ExtractProjectFromContainer("docker", "abc123", executor)
+	project, err := ExtractProjectFromContainer(newTestEngine(t, "docker", executor), "abc123")
 
 	r.Nil(err)
 	assert.Equal(t, "", project)
@@ -205,7 +221,7 @@ func TestExtractProjectFromContainer_ExecutorReturnsError(t *testing.T) {
 		return name == "docker"
 	}), mock.Anything).Return(nil, fmt.Errorf("inspect error"))
 
-	project, err := ExtractProjectFromContainer("docker", "abc123", executor)
+	project, err := ExtractProjectFromContainer(newTestEngine(t, "docker", executor), "abc123")
 
 	r.NotNil(err)
 	assert.ErrorContains(t, err, "inspect error")
@@ -220,14 +236,50 @@ func TestExtractProjectFromContainer_ProjectNameWithSpecialCharacters(t *testing
 	executor := exec.NewMockExecutor(t)
 	executor.EXPECT().Output(mock.MatchedBy(func(name string) bool {
 		return name == "docker"
-	}), mock.Anything).Return([]byte(projectName), nil)
+	}), mock.Anything).Return([]byte(inspectOutputWithLabels(`{"com.docker.compose.project":"`+projectName+`"}`)), nil)
 
-	project, err := ExtractProjectFromContainer("docker", "abc123", executor)
+	project, err := ExtractProjectFromContainer(newTestEngine(t, "docker", executor), "abc123")
 
 	r.Nil(err)
 	assert.Equal(t, projectName, project)
 }
 
+func TestExtractProjectFromContainer_PodmanComposeLabel(t *testing.T) {
+	r := require.New(t)
+
+	executor := exec.NewMockExecutor(t)
+	executor.EXPECT().Output("podman", []string{"inspect", "abc123"}).Return([]byte(inspectOutputWithLabels(`{"io.podman.compose.project":"podman-app"}`)), nil)
+
+	project, err := ExtractProjectFromContainer(newTestEngine(t, "podman", executor), "abc123")
+
+	r.Nil(err)
+	r.Equal("podman-app", project)
+}
+
+func TestExtractProjectFromContainer_PodmanWithDockerComposeLabel(t *testing.T) {
+	r := require.New(t)
+
+	executor := exec.NewMockExecutor(t)
+	executor.EXPECT().Output("podman", []string{"inspect", "abc123"}).Return([]byte(inspectOutputWithLabels(`{"com.docker.compose.project":"docker-app"}`)), nil)
+
+	project, err := ExtractProjectFromContainer(newTestEngine(t, "podman", executor), "abc123")
+
+	r.Nil(err)
+	r.Equal("docker-app", project)
+}
+
+func TestExtractProjectFromContainer_DockerIgnoresPodmanComposeLabel(t *testing.T) {
+	r := require.New(t)
+
+	executor := exec.NewMockExecutor(t)
+	executor.EXPECT().Output("docker", []string{"inspect", "abc123"}).Return([]byte(inspectOutputWithLabels(`{"io.podman.compose.project":"podman-app"}`)), nil)
+
+	project, err := ExtractProjectFromContainer(newTestEngine(t, "docker", executor), "abc123")
+
+	r.Nil(err)
+	r.Equal("", project)
+}
+
 func TestFindComposeContainersForProject_ContainersFound(t *testing.T) {
 	r := require.New(t)
 
@@ -237,7 +289,7 @@ func TestFindComposeContainersForProject_ContainersFound(t *testing.T) {
 		return name == "docker"
 	}), mock.Anything).Return([]byte(mockOutput), nil)
 
-	ids, err := FindComposeContainersForProject("docker", "myapp", executor)
+	ids, err := FindComposeContainersForProject(newTestEngine(t, "docker", executor), "myapp")
 
 	r.Nil(err)
 	r.Len(ids, 3)
@@ -255,7 +307,7 @@ func TestFindComposeContainersForProject_NoContainersFound(t *testing.T) {
 		return name == "docker"
 	}), mock.Anything).Return([]byte(""), nil)
 
-	ids, err := FindComposeContainersForProject("docker", "myapp", executor)
+	ids, err := FindComposeContainersForProject(newTestEngine(t, "docker", executor), "myapp")
 
 	r.Nil(err)
 	r.Nil(ids)
@@ -270,7 +322,7 @@ func TestFindComposeContainersForProject_ExecutorReturnsError(t *testing.T) {
 		return name == "docker"
 	}), mock.Anything).Return(nil, fmt.Errorf("docker error"))
 
-	ids, err := FindComposeContainersForProject("docker", "myapp", executor)
+	ids, err := FindComposeContainersForProject(newTestEngine(t, "docker", executor), "myapp")
 
 	r.NotNil(err)
 	assert.ErrorContains(t, err, "docker error")
@@ -287,7 +339,7 @@ func TestFindComposeContainersForProject_OutputWithWindowsLineEndings(t *testing
 		return name == "docker"
 	}), mock.Anything).Return([]byte(mockOutput), nil)
 
-	ids, err := FindComposeContainersForProject("docker", "myapp", executor)
+	ids, err := FindComposeContainersForProject(newTestEngine(t, "docker", executor), "myapp")
 
 	r.Nil(err)
 	r.Len(ids, 3)
@@ -299,17 +351,14 @@ func TestFindComposeContainersForProject_OutputWithWindowsLineEndings(t *testing
 func TestFindComposeContainersForProject_UsesPodmanTool(t *testing.T) {
 	r := require.New(t)
 
-	mockOutput := "container1\ncontainer2"
 	executor := exec.NewMockExecutor(t)
-	executor.EXPECT().Output(mock.MatchedBy(func(name string) bool {
-		return name == "podman"
-	}), mock.Anything).Return([]byte(mockOutput), nil)
+	executor.EXPECT().Output("podman", []string{"ps", "-a", "-q", "--filter", "label=io.podman.compose.project=app"}).Return([]byte("container1\ncontainer2"), nil)
+	executor.EXPECT().Output("podman", []string{"ps", "-a", "-q", "--filter", "label=com.docker.compose.project=app"}).Return([]byte("container2\ncontainer3"), nil)
 
-	ids, err := FindComposeContainersForProject("podman", "app", executor)
+	ids, err := FindComposeContainersForProject(newTestEngine(t, "podman", executor), "app")
 
 	r.Nil(err)
-	r.Len(ids, 2)
-	executor.AssertExpectations(t)
+	r.Equal([]string{"container1", "container2", "container3"}, ids)
 }
 
 func TestDeduplicateAndFilterContainerIDs_SingleID(t *testing.T) {
@@ -338,6 +387,18 @@ func TestDeduplicateAndFilterContainerIDs_MultipleUniqueIDs(t *testing.T) {
 	assert.Contains(t, result, "ghi789")
 }
 
+func TestDeduplicateAndFilterContainerIDs_ReturnsSortedIDs(t *testing.T) {
+	idMap := map[string]bool{
+		"ghi789": true,
+		"abc123": true,
+		"def456": true,
+	}
+
+	result := DeduplicateAndFilterContainerIDs(idMap)
+
+	assert.Equal(t, []string{"abc123", "def456", "ghi789"}, result)
+}
+
 func TestDeduplicateAndFilterContainerIDs_FilterEmptyID(t *testing.T) {
 	idMap := map[string]bool{
 		"abc123": true,
@@ -386,3 +447,97 @@ func TestDeduplicateAndFilterContainerIDs_ManyIDsWithEmpty(t *testing.T) {
 	assert.Contains(t, result, "id2")
 	assert.Contains(t, result, "id3")
 }
+
+func TestGroupContainersByPod_PodmanContainersInPods(t *testing.T) {
+	r := require.New(t)
+
+	executor := exec.NewMockExecutor(t)
+	executor.EXPECT().Output("podman", []string{"container", "inspect", "--format", "{{.Pod}}", "app", "db", "cache", "other"}).Return([]byte("pod1\npod1\n\npod2\n"), nil)
+
+	standalone, pods, err := GroupContainersByPod(newTestEngine(t, "podman", executor), []string{"app", "db", "cache", "other"})
+
+	r.Nil(err)
+	r.Equal([]string{"cache"}, standalone)
+	r.Equal([]string{"pod1", "pod2"}, pods)
+}
+
+func TestGroupContainersByPod_DockerHasNoPods(t *testing.T) {
+	r := require.New(t)
+
+	executor := exec.NewMockExecutor(t)
+
+	standalone, pods, err := GroupContainersByPod(newTestEngine(t, "docker", executor), []string{"app", "db"})
+
+	r.Nil(err)
+	r.Equal([]string{"app", "db"}, standalone)
+	r.Empty(pods)
+	executor.AssertNotCalled(t, "Output")
+}
+
+func TestGroupContainersByPod_ExecutorReturnsError(t *testing.T) {
+	r := require.New(t)
+
+	executor := exec.NewMockExecutor(t)
+	executor.EXPECT().Output("podman", mock.Anything).Return(nil, fmt.Errorf("inspect error"))
+
+	_, _, err := GroupContainersByPod(newTestEngine(t, "podman", executor), []string{"app"})
+
+	r.ErrorContains(err, "inspect error")
+}
+
+func psLine(id, names, labels string) string {
+	return fmt.Sprintf(`{"ID":%q,"Names":%q,"State":"running","Status":"Up 5 minutes","Labels":%q}`, id, names, labels) + "\n"
+}
+
+func TestFindDevcontainers_ListsByLabelAndAddsComposeServices(t *testing.T) {
+	r := require.New(t)
+
+	executor := exec.NewMockExecutor(t)
+	executor.EXPECT().Output("docker", []string{"ps", "--filter", "label=devcontainer.local_folder", "--format", "{{json .}}"}).Return([]byte(
+		psLine("app1", "workspace-app-1", "com.docker.compose.project=shop,devcontainer.local_folder=/home/user/shop")+
+			psLine("app2", "solo", "devcontainer.local_folder=/home/user/solo"),
+	), nil)
+	executor.EXPECT().Output("docker", []string{"ps", "--filter", "label=com.docker.compose.project=shop", "--format", "{{json .}}"}).Return([]byte(
+		psLine("app1", "workspace-app-1", "com.docker.compose.project=shop,devcontainer.local_folder=/home/user/shop")+
+			psLine("db1", "postgres", "com.docker.compose.project=shop"),
+	), nil)
+
+	containers, err := FindDevcontainers(newTestEngine(t, "docker", executor), false)
+
+	r.Nil(err)
+	r.Len(containers, 3)
+	r.Equal("app1", containers[0].ID)
+	r.Equal("app2", containers[1].ID)
+	r.Equal("db1", containers[2].ID)
+	r.Equal("shop", containers[2].ComposeProject)
+}
+
+func TestFindDevcontainers_AllIncludesStoppedContainers(t *testing.T) {
+	r := require.New(t)
+
+	executor := exec.NewMockExecutor(t)
+	executor.EXPECT().Output("podman", []string{"ps", "-a", "--filter", "label=devcontainer.local_folder", "--format", "json"}).Return([]byte(
+		`[{"Id":"app1","Names":["app"],"State":"exited","Labels":{"io.podman.compose.project":"shop","devcontainer.local_folder":"/home/user/shop"}}]`,
+	), nil)
+	executor.EXPECT().Output("podman", []string{"ps", "-a", "--filter", "label=io.podman.compose.project=shop", "--format", "json"}).Return([]byte(
+		`[{"Id":"db1","Names":["db"],"State":"exited","Labels":{"io.podman.compose.project":"shop"}}]`,
+	), nil)
+	executor.EXPECT().Output("podman", []string{"ps", "-a", "--filter", "label=com.docker.compose.project=shop", "--format", "json"}).Return([]byte("[]"), nil)
+
+	containers, err := FindDevcontainers(newTestEngine(t, "podman", executor), true)
+
+	r.Nil(err)
+	r.Len(containers, 2)
+	r.Equal("exited", containers[1].State)
+}
+
+func TestFindDevcontainers_ExecutorReturnsError(t *testing.T) {
+	r := require.New(t)
+
+	executor := exec.NewMockExecutor(t)
+	executor.EXPECT().Output("docker", mock.Anything).Return(nil, fmt.Errorf("daemon down"))
+
+	_, err := FindDevcontainers(newTestEngine(t, "docker", executor), false)
+
+	r.ErrorContains(err, "daemon down")
+}
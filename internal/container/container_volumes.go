@@ -0,0 +1,225 @@
+package container
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/Brennon-Oliveira/dev-cli/internal/container/container_utils"
+	"github.com/Brennon-Oliveira/dev-cli/internal/engine"
+	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
+)
+
+// ListVolumes lists the named volumes mounted by the containers of the
+// workspace.
+func (c *realContainerCLI) ListVolumes(path string) error {
+	eng, err := c.getEngine()
+	if err != nil {
+		return err
+	}
+
+	volumes, err := c.workspaceVolumes(eng, path)
+	if err != nil {
+		return err
+	}
+
+	return c.renderer.Render(volumes, func() string {
+		return c.formatVolumes(volumes)
+	})
+}
+
+// workspaceVolumes finds the named volumes of the containers of the
+// workspace, sorted by name. Anonymous volumes are left out: a rebuild
+// creates new ones, so there is nothing to restore them into.
+func (c *realContainerCLI) workspaceVolumes(eng engine.Engine, path string) ([]container_utils.WorkspaceVolume, error) {
+	containers, err := c.listRelatedContainers(eng, path)
+	if err != nil {
+		return nil, err
+	}
+
+	volumes := []container_utils.WorkspaceVolume{}
+	index := make(map[string]int)
+	for _, container := range containers {
+		details, err := eng.Inspect(container.ID)
+		if err != nil {
+			logger.Error("Não foi possível obter os volumes do container %s.", container.ID)
+			return nil, err
+		}
+
+		for _, name := range details.Volumes {
+			if container_utils.IsAnonymousVolume(name) {
+				continue
+			}
+
+			i, ok := index[name]
+			if !ok {
+				i = len(volumes)
+				index[name] = i
+				volumes = append(volumes, container_utils.WorkspaceVolume{Name: name, Services: []string{}})
+			}
+
+			volumes[i].Services = append(volumes[i].Services, container_utils.ServiceName(container))
+			if container.State == "running" {
+				volumes[i].InUse = true
+			}
+		}
+	}
+
+	slices.SortFunc(volumes, func(a, b container_utils.WorkspaceVolume) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return volumes, nil
+}
+
+// BackupVolumes archives each named volume of the workspace to
+// <dir>/<volume>.tar, through a throwaway container of `volumes.image`.
+func (c *realContainerCLI) BackupVolumes(path string, dir string) error {
+	eng, err := c.getEngine()
+	if err != nil {
+		return err
+	}
+
+	volumes, err := c.workspaceVolumes(eng, path)
+	if err != nil {
+		return err
+	}
+
+	if len(volumes) == 0 {
+		logger.Info("Nenhum volume nomeado encontrado nos containers do workspace.")
+		return nil
+	}
+
+	if err := c.mkdirAll(dir, 0o755); err != nil {
+		logger.Error("Não foi possível criar o diretório %s.", dir)
+		return err
+	}
+
+	cfg, err := c.config.Load()
+	if err != nil {
+		return err
+	}
+
+	image := cfg.Volumes.Image
+	for _, volume := range volumes {
+		if volume.InUse {
+			logger.Warn("O volume %s está em uso por um container em execução. Para um backup consistente, pare o workspace com dev down antes.", volume.Name)
+		}
+
+		file := container_utils.VolumeBackupFile(dir, volume.Name)
+		logger.Info("Salvando o volume %s em %s...", volume.Name, file)
+
+		if err := c.backupVolume(eng, image, volume.Name, file); err != nil {
+			logger.Error("Não foi possível salvar o volume %s.", volume.Name)
+			return err
+		}
+	}
+
+	logger.Success("%d volume(s) salvos em %s.", len(volumes), dir)
+	return nil
+}
+
+// backupVolume writes the archive to a temporary file first, so a failed
+// backup never replaces a previous good one.
+func (c *realContainerCLI) backupVolume(eng engine.Engine, image string, volume string, file string) error {
+	tmp, err := c.createTemp(filepath.Dir(file), filepath.Base(file)+".*")
+	if err != nil {
+		return err
+	}
+	defer c.remove(tmp.Name())
+
+	err = eng.Run(context.Background(), engine.RunOptions{
+		Image:   image,
+		Volumes: []string{volume + ":" + container_utils.VolumeMountPath + ":ro"},
+		Command: container_utils.BackupVolumeCommand,
+		Stdout:  tmp,
+	})
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	return c.rename(tmp.Name(), file)
+}
+
+// RestoreVolumes replaces the content of each named volume of the
+// workspace with its archive in dir. Volumes without an archive are kept
+// as they are, and volumes mounted by a running container are refused.
+func (c *realContainerCLI) RestoreVolumes(path string, dir string, yes bool) error {
+	eng, err := c.getEngine()
+	if err != nil {
+		return err
+	}
+
+	volumes, err := c.workspaceVolumes(eng, path)
+	if err != nil {
+		return err
+	}
+
+	var restorable []container_utils.WorkspaceVolume
+	for _, volume := range volumes {
+		if _, err := c.stat(container_utils.VolumeBackupFile(dir, volume.Name)); err != nil {
+			logger.Warn("Nenhum backup do volume %s em %s, o volume será mantido como está.", volume.Name, dir)
+			continue
+		}
+
+		if volume.InUse {
+			logger.Error("O volume %s está em uso por um container em execução. Pare o workspace com dev down antes de restaurar.", volume.Name)
+			return fmt.Errorf("volume em uso: %s", volume.Name)
+		}
+
+		restorable = append(restorable, volume)
+	}
+
+	if len(restorable) == 0 {
+		logger.Error("Nenhum backup dos volumes do workspace encontrado em %s.", dir)
+		return fmt.Errorf("nenhum backup encontrado em %s", dir)
+	}
+
+	if !yes {
+		logger.Info("O conteúdo atual dos seguintes volumes será substituído pelo backup:\n%s", c.formatVolumes(restorable))
+
+		confirmed, err := c.confirmAction("Restaurar os volumes listados?")
+		if err != nil || !confirmed {
+			return err
+		}
+	}
+
+	cfg, err := c.config.Load()
+	if err != nil {
+		return err
+	}
+
+	image := cfg.Volumes.Image
+	for _, volume := range restorable {
+		file := container_utils.VolumeBackupFile(dir, volume.Name)
+		logger.Info("Restaurando o volume %s a partir de %s...", volume.Name, file)
+
+		if err := c.restoreVolume(eng, image, volume.Name, file); err != nil {
+			logger.Error("Não foi possível restaurar o volume %s.", volume.Name)
+			return err
+		}
+	}
+
+	logger.Success("%d volume(s) restaurados.", len(restorable))
+	return nil
+}
+
+func (c *realContainerCLI) restoreVolume(eng engine.Engine, image string, volume string, file string) error {
+	archive, err := c.open(file)
+	if err != nil {
+		return err
+	}
+	defer archive.Close()
+
+	return eng.Run(context.Background(), engine.RunOptions{
+		Image:   image,
+		Volumes: []string{volume + ":" + container_utils.VolumeMountPath},
+		Command: container_utils.RestoreVolumeCommand,
+		Stdin:   archive,
+	})
+}
@@ -0,0 +1,212 @@
+package container
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/Brennon-Oliveira/dev-cli/internal/config"
+	"github.com/Brennon-Oliveira/dev-cli/internal/container/container_utils"
+	"github.com/Brennon-Oliveira/dev-cli/internal/engine"
+	"github.com/Brennon-Oliveira/dev-cli/internal/output"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================================
+// Helpers
+// ============================================================================
+
+var volumesStack = []*engine.Container{
+	{ID: "app", Names: "project_app_1", ComposeService: "app", LocalFolder: "/home/user/project", State: "exited"},
+	{ID: "cache", Names: "project_cache_1", ComposeService: "cache", State: "exited"},
+	{ID: "db", Names: "project_db_1", ComposeService: "db", State: "exited"},
+}
+
+// mockVolumes mocks containers as the workspace stack, each one with its
+// volumes.
+func mockVolumes(eng *engine.MockEngine, containers []*engine.Container) {
+	eng.EXPECT().ListContainers(engine.ListOptions{All: true, Filters: []string{"id=app", "id=cache", "id=db"}}).Return(containers, nil)
+	eng.EXPECT().Inspect("app").Return(&engine.ContainerDetails{Volumes: []string{"project_shared", anonymousVolume}}, nil)
+	eng.EXPECT().Inspect("cache").Return(&engine.ContainerDetails{Volumes: []string{"project_redis", "project_shared"}}, nil)
+	eng.EXPECT().Inspect("db").Return(&engine.ContainerDetails{Volumes: []string{"project_pgdata"}}, nil)
+}
+
+func volumesConfig(t *testing.T) *config.MockConfig {
+	t.Helper()
+
+	cfg := config.GlobalConfig{}
+	cfg.Volumes.Image = "busybox"
+
+	mockCfg := config.NewMockConfig(t)
+	mockCfg.EXPECT().Load().Return(cfg, nil)
+	return mockCfg
+}
+
+// ============================================================================
+// Tests for ListVolumes
+// ============================================================================
+
+func TestListVolumes_ListsNamedVolumesWithTheirServices(t *testing.T) {
+	r := require.New(t)
+
+	buf := &bytes.Buffer{}
+	stack := slices.Clone(volumesStack)
+	stack[2] = &engine.Container{ID: "db", Names: "project_db_1", ComposeService: "db", State: "running"}
+
+	eng := engine.NewMockEngine(t)
+	mockVolumes(eng, stack)
+
+	err := newWorkspaceContainerCLI(eng, stackIDs, output.FormatJSON, buf).ListVolumes("/home/user/project")
+	r.NoError(err)
+
+	var volumes []container_utils.WorkspaceVolume
+	r.NoError(json.Unmarshal(buf.Bytes(), &volumes))
+	r.Equal([]container_utils.WorkspaceVolume{
+		{Name: "project_pgdata", Services: []string{"db"}, InUse: true},
+		{Name: "project_redis", Services: []string{"cache"}},
+		{Name: "project_shared", Services: []string{"app", "cache"}},
+	}, volumes)
+}
+
+// ============================================================================
+// Tests for BackupVolumes
+// ============================================================================
+
+func TestBackupVolumes_WritesAnArchivePerVolume(t *testing.T) {
+	r := require.New(t)
+
+	fs := newFakeFS(map[string]string{})
+	eng := engine.NewMockEngine(t)
+	mockVolumes(eng, volumesStack)
+	eng.EXPECT().Run(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, opts engine.RunOptions) error {
+		r.Equal("busybox", opts.Image)
+		r.Equal(container_utils.BackupVolumeCommand, opts.Command)
+		r.Nil(opts.Stdin)
+
+		volume, _, _ := strings.Cut(opts.Volumes[0], ":")
+		r.Equal(volume+":/volume:ro", opts.Volumes[0])
+		_, err := opts.Stdout.Write([]byte("tar of " + volume))
+		return err
+	}).Times(3)
+
+	err := newWorkspaceContainerCLI(eng, stackIDs, "", nil, append(fs.options(), WithConfig(volumesConfig(t)))...).BackupVolumes("/home/user/project", "/backup")
+	r.NoError(err)
+
+	r.Equal([]string{"/backup"}, fs.dirs)
+	r.Equal(map[string]string{
+		"/backup/project_pgdata.tar": "tar of project_pgdata",
+		"/backup/project_redis.tar":  "tar of project_redis",
+		"/backup/project_shared.tar": "tar of project_shared",
+	}, fs.files, "no temporary file may be left behind")
+}
+
+func TestBackupVolumes_RunFails_KeepsThePreviousArchive(t *testing.T) {
+	r := require.New(t)
+
+	fs := newFakeFS(map[string]string{"/backup/project_pgdata.tar": "good backup"})
+	eng := engine.NewMockEngine(t)
+	mockVolumes(eng, volumesStack)
+	eng.EXPECT().Run(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, opts engine.RunOptions) error {
+		opts.Stdout.Write([]byte("partial"))
+		return fmt.Errorf("image not found")
+	}).Once()
+
+	err := newWorkspaceContainerCLI(eng, stackIDs, "", nil, append(fs.options(), WithConfig(volumesConfig(t)))...).BackupVolumes("/home/user/project", "/backup")
+
+	r.ErrorContains(err, "image not found")
+	r.Equal(map[string]string{"/backup/project_pgdata.tar": "good backup"}, fs.files)
+}
+
+func TestBackupVolumes_MkdirFails_ReturnsError(t *testing.T) {
+	r := require.New(t)
+
+	eng := engine.NewMockEngine(t)
+	mockVolumes(eng, volumesStack)
+
+	err := newWorkspaceContainerCLI(eng, stackIDs, "", nil, WithMkdirAll(func(path string, perm os.FileMode) error {
+		return fmt.Errorf("permission denied")
+	})).BackupVolumes("/home/user/project", "/backup")
+
+	r.ErrorContains(err, "permission denied")
+	eng.AssertNotCalled(t, "Run", mock.Anything, mock.Anything)
+}
+
+// ============================================================================
+// Tests for RestoreVolumes
+// ============================================================================
+
+func TestRestoreVolumes_PipesArchivesAndSkipsMissingOnes(t *testing.T) {
+	r := require.New(t)
+
+	fs := newFakeFS(map[string]string{
+		"/backup/project_pgdata.tar": "tar of project_pgdata",
+		"/backup/project_redis.tar":  "tar of project_redis",
+	})
+
+	var restored []string
+	eng := engine.NewMockEngine(t)
+	mockVolumes(eng, volumesStack)
+	eng.EXPECT().Run(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, opts engine.RunOptions) error {
+		r.Equal(container_utils.RestoreVolumeCommand, opts.Command)
+
+		data, err := io.ReadAll(opts.Stdin)
+		r.NoError(err)
+		volume, _, _ := strings.Cut(opts.Volumes[0], ":")
+		r.Equal(volume+":/volume", opts.Volumes[0])
+		r.Equal("tar of "+volume, string(data))
+
+		restored = append(restored, volume)
+		return nil
+	}).Times(2)
+
+	err := newWorkspaceContainerCLI(eng, stackIDs, "", nil, append(fs.options(), WithConfig(volumesConfig(t)))...).RestoreVolumes("/home/user/project", "/backup", true)
+	r.NoError(err)
+	r.Equal([]string{"project_pgdata", "project_redis"}, restored)
+}
+
+func TestRestoreVolumes_VolumeInUse_RestoresNothing(t *testing.T) {
+	r := require.New(t)
+
+	fs := newFakeFS(map[string]string{"/backup/project_pgdata.tar": "tar"})
+
+	stack := slices.Clone(volumesStack)
+	stack[2] = &engine.Container{ID: "db", Names: "project_db_1", ComposeService: "db", State: "running"}
+	eng := engine.NewMockEngine(t)
+	mockVolumes(eng, stack)
+
+	err := newWorkspaceContainerCLI(eng, stackIDs, "", nil, fs.options()...).RestoreVolumes("/home/user/project", "/backup", true)
+
+	r.ErrorContains(err, "project_pgdata")
+	eng.AssertNotCalled(t, "Run", mock.Anything, mock.Anything)
+}
+
+func TestRestoreVolumes_NoArchives_ReturnsError(t *testing.T) {
+	r := require.New(t)
+
+	eng := engine.NewMockEngine(t)
+	mockVolumes(eng, volumesStack)
+
+	err := newWorkspaceContainerCLI(eng, stackIDs, "", nil, newFakeFS(map[string]string{}).options()...).RestoreVolumes("/home/user/project", "/backup", true)
+
+	r.ErrorContains(err, "nenhum backup")
+}
+
+func TestRestoreVolumes_NotATerminal_RequiresYes(t *testing.T) {
+	r := require.New(t)
+
+	fs := newFakeFS(map[string]string{"/backup/project_redis.tar": "tar"})
+	eng := engine.NewMockEngine(t)
+	mockVolumes(eng, volumesStack)
+
+	err := newWorkspaceContainerCLI(eng, stackIDs, "", nil, append(fs.options(), WithIsTerminal(func() bool { return false }))...).RestoreVolumes("/home/user/project", "/backup", false)
+
+	r.ErrorContains(err, "--yes")
+	eng.AssertNotCalled(t, "Run", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,17 @@
+package dashboard
+
+// Dashboard is the full-screen view of `dev top`, refreshed until the user
+// quits.
+type Dashboard interface {
+	Run() error
+}
+
+// Terminal switches the terminal between the raw mode of the dashboard and
+// the normal mode of the commands it hands the screen to, like logs and
+// shells.
+type Terminal interface {
+	IsTerminal() bool
+	MakeRaw() error
+	Restore() error
+	Size() (width int, height int, err error)
+}
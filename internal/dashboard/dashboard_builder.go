@@ -0,0 +1,84 @@
+package dashboard
+
+import (
+	"io"
+	"os"
+	"time"
+
+	"github.com/Brennon-Oliveira/dev-cli/internal/container"
+	"github.com/Brennon-Oliveira/dev-cli/internal/devcontainer"
+	"github.com/Brennon-Oliveira/dev-cli/internal/vscode"
+)
+
+const defaultInterval = 3 * time.Second
+
+type realDashboard struct {
+	container    container.ContainerCLI
+	devcontainer devcontainer.DevContainerCLI
+	vscode       vscode.VSCode
+	terminal     Terminal
+	interval     time.Duration
+	input        io.Reader
+	output       io.Writer
+	logWriter    io.Writer
+}
+
+type Option func(*realDashboard)
+
+func NewDashboard(opts ...Option) Dashboard {
+	d := &realDashboard{
+		terminal: NewTerminal(),
+		interval: defaultInterval,
+		input:    os.Stdin,
+		output:   os.Stdout,
+	}
+
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	return d
+}
+
+func WithContainerCLI(c container.ContainerCLI) Option {
+	return func(d *realDashboard) {
+		d.container = c
+	}
+}
+
+func WithDevContainerCLI(dc devcontainer.DevContainerCLI) Option {
+	return func(d *realDashboard) {
+		d.devcontainer = dc
+	}
+}
+
+func WithVSCode(vs vscode.VSCode) Option {
+	return func(d *realDashboard) {
+		d.vscode = vs
+	}
+}
+
+func WithTerminal(t Terminal) Option {
+	return func(d *realDashboard) {
+		d.terminal = t
+	}
+}
+
+// WithInterval sets how often the workspaces are refreshed.
+func WithInterval(interval time.Duration) Option {
+	return func(d *realDashboard) {
+		d.interval = interval
+	}
+}
+
+func WithInput(r io.Reader) Option {
+	return func(d *realDashboard) {
+		d.input = r
+	}
+}
+
+func WithOutput(w io.Writer) Option {
+	return func(d *realDashboard) {
+		d.output = w
+	}
+}
@@ -0,0 +1,273 @@
+package dashboard
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/Brennon-Oliveira/dev-cli/internal/container"
+	"github.com/Brennon-Oliveira/dev-cli/internal/container/container_utils"
+	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
+)
+
+const (
+	enterAltScreen = "\x1b[?1049h\x1b[?25l"
+	leaveAltScreen = "\x1b[?25h\x1b[?1049l"
+)
+
+type refreshResult struct {
+	workspaces []container_utils.Workspace
+	err        error
+	at         time.Time
+}
+
+func (d *realDashboard) Run() error {
+	if !d.terminal.IsTerminal() {
+		err := fmt.Errorf("o dev top precisa de um terminal interativo")
+		logger.Error(err.Error())
+		return err
+	}
+
+	d.logWriter = logger.GetWriter()
+
+	if err := d.enterScreen(); err != nil {
+		return err
+	}
+	defer d.leaveScreen()
+
+	s := &dashboardState{}
+	d.draw(s)
+	d.apply(s, d.fetch())
+
+	keys := make(chan rune)
+	next := make(chan struct{})
+	go d.readKeys(keys, next)
+
+	refreshed := make(chan refreshResult, 1)
+	refreshing := false
+
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		if s.stale && !refreshing {
+			s.stale = false
+			refreshing = true
+			go func() { refreshed <- d.fetch() }()
+		}
+
+		d.draw(s)
+
+		select {
+		case result := <-refreshed:
+			refreshing = false
+			d.apply(s, result)
+		case <-ticker.C:
+			s.stale = true
+		case key, ok := <-keys:
+			if !ok {
+				return nil
+			}
+
+			quit, err := d.handleKey(s, key)
+			if quit || err != nil {
+				return err
+			}
+
+			next <- struct{}{}
+		}
+	}
+}
+
+// handleKey runs the action of a key. It reports whether the dashboard
+// must quit.
+func (d *realDashboard) handleKey(s *dashboardState, key rune) (bool, error) {
+	if s.pendingKill != "" {
+		path := s.pendingKill
+		s.pendingKill = ""
+
+		if key != 's' && key != 'y' {
+			s.status = "Remoção cancelada."
+			return false, nil
+		}
+
+		d.runInPlace(s, fmt.Sprintf("Removendo os containers de %s...", path), func() error {
+			return d.container.KillContainer(path, container.KillOptions{Yes: true})
+		}, fmt.Sprintf("Containers de %s removidos.", path))
+
+		return false, nil
+	}
+
+	switch key {
+	case keyQuit:
+		return true, nil
+	case keyUp:
+		s.selected = max(s.selected-1, 0)
+		return false, nil
+	case keyDown:
+		s.selected = max(min(s.selected+1, len(s.workspaces)-1), 0)
+		return false, nil
+	case keyRefresh:
+		s.stale = true
+		return false, nil
+	case keyStop, keyKill, keyOpen, keyLogs, keyShell:
+	default:
+		return false, nil
+	}
+
+	path, ok := s.selectedPath()
+	if !ok {
+		s.status = "Selecione um workspace com pasta local."
+		return false, nil
+	}
+
+	switch key {
+	case keyStop:
+		d.runInPlace(s, fmt.Sprintf("Parando os containers de %s...", path), func() error {
+			return d.container.DownContainer(path, container.DownOptions{})
+		}, fmt.Sprintf("Containers de %s parados.", path))
+	case keyKill:
+		s.pendingKill = path
+		s.status = fmt.Sprintf("Remover (rm -f) os containers de %s? [s/N]", path)
+	case keyOpen:
+		d.runInPlace(s, fmt.Sprintf("Abrindo o editor em %s...", path), func() error {
+			uri, err := d.vscode.GetContainerWorkspaceURI(path)
+			if err != nil {
+				return err
+			}
+
+			return d.vscode.OpenWorkspaceByURI(uri)
+		}, fmt.Sprintf("Editor aberto em %s.", path))
+	case keyLogs:
+		return false, d.handOver(s, func() error {
+			return d.container.ShowLogs(path, container.LogsOptions{Follow: true})
+		}, fmt.Sprintf("Logs de %s encerrados.", path))
+	case keyShell:
+		return false, d.handOver(s, func() error {
+			return d.devcontainer.OpenShell(path)
+		}, fmt.Sprintf("Shell de %s encerrado.", path))
+	}
+
+	return false, nil
+}
+
+// runInPlace runs an action while the dashboard stays on screen, showing
+// its progress and result in the status line.
+func (d *realDashboard) runInPlace(s *dashboardState, progress string, action func() error, done string) {
+	s.status = progress
+	d.draw(s)
+
+	if err := action(); err != nil {
+		s.status = "Erro: " + err.Error()
+	} else {
+		s.status = done
+	}
+
+	s.stale = true
+}
+
+// handOver gives the terminal to an interactive action, like logs or a
+// shell, and takes it back when the action ends. Ctrl+C ends the action
+// instead of the dashboard.
+func (d *realDashboard) handOver(s *dashboardState, action func() error, done string) error {
+	d.leaveScreen()
+
+	interrupts := make(chan os.Signal, 1)
+	signal.Notify(interrupts, os.Interrupt)
+	defer signal.Stop(interrupts)
+
+	err := action()
+
+	select {
+	case <-interrupts:
+		err = nil
+	default:
+	}
+
+	if err != nil {
+		s.status = "Erro: " + err.Error()
+	} else {
+		s.status = done
+	}
+
+	s.stale = true
+	return d.enterScreen()
+}
+
+func (d *realDashboard) enterScreen() error {
+	if err := d.terminal.MakeRaw(); err != nil {
+		logger.Error("Não foi possível preparar o terminal.")
+		return err
+	}
+
+	logger.SetOutput(io.Discard)
+	_, err := io.WriteString(d.output, enterAltScreen)
+	return err
+}
+
+func (d *realDashboard) leaveScreen() {
+	io.WriteString(d.output, leaveAltScreen)
+	d.terminal.Restore()
+	logger.SetOutput(d.logWriter)
+}
+
+func (d *realDashboard) fetch() refreshResult {
+	workspaces, err := d.container.GetWorkspaces(true)
+	return refreshResult{workspaces: workspaces, err: err, at: time.Now()}
+}
+
+// apply shows a refresh, keeping the selected workspace selected.
+func (d *realDashboard) apply(s *dashboardState, result refreshResult) {
+	s.refreshErr = result.err
+	if result.err != nil {
+		return
+	}
+
+	selected, _ := s.selectedPath()
+
+	s.workspaces = result.workspaces
+	s.updatedAt = result.at
+	s.selected = min(s.selected, max(len(s.workspaces)-1, 0))
+
+	for i, workspace := range s.workspaces {
+		if workspace.Path == selected {
+			s.selected = i
+		}
+	}
+}
+
+func (d *realDashboard) draw(s *dashboardState) {
+	width, height, err := d.terminal.Size()
+	if err != nil {
+		width, height = 80, 24
+	}
+
+	lines := renderDashboard(s, d.interval, width, height)
+
+	screen := "\x1b[H" + strings.Join(lines, "\x1b[K\r\n") + "\x1b[K\x1b[J"
+
+	io.WriteString(d.output, screen)
+}
+
+// readKeys sends the keys read from the input. It waits for each key to be
+// handled before reading the next, so it does not steal the input of the
+// logs and shells the dashboard hands the terminal to.
+func (d *realDashboard) readKeys(keys chan<- rune, next <-chan struct{}) {
+	defer close(keys)
+
+	buf := make([]byte, 16)
+	for {
+		n, err := d.input.Read(buf)
+		if n > 0 {
+			keys <- parseKey(buf[:n])
+			<-next
+		}
+
+		if err != nil {
+			return
+		}
+	}
+}
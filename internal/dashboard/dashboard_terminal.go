@@ -0,0 +1,45 @@
+package dashboard
+
+import (
+	"os"
+
+	"github.com/chzyer/readline"
+)
+
+type stdTerminal struct {
+	fd    int
+	state *readline.State
+}
+
+// NewTerminal returns the Terminal of stdin.
+func NewTerminal() Terminal {
+	return &stdTerminal{fd: int(os.Stdin.Fd())}
+}
+
+func (t *stdTerminal) IsTerminal() bool {
+	return readline.IsTerminal(t.fd) && readline.IsTerminal(int(os.Stdout.Fd()))
+}
+
+func (t *stdTerminal) MakeRaw() error {
+	state, err := readline.MakeRaw(t.fd)
+	if err != nil {
+		return err
+	}
+
+	t.state = state
+	return nil
+}
+
+func (t *stdTerminal) Restore() error {
+	if t.state == nil {
+		return nil
+	}
+
+	state := t.state
+	t.state = nil
+	return readline.Restore(t.fd, state)
+}
+
+func (t *stdTerminal) Size() (int, int, error) {
+	return readline.GetSize(int(os.Stdout.Fd()))
+}
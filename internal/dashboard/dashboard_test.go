@@ -0,0 +1,300 @@
+package dashboard
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/Brennon-Oliveira/dev-cli/internal/container"
+	"github.com/Brennon-Oliveira/dev-cli/internal/container/container_utils"
+	"github.com/Brennon-Oliveira/dev-cli/internal/devcontainer"
+	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
+	"github.com/Brennon-Oliveira/dev-cli/internal/vscode"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	logger.InitLogger()
+	exitCode := m.Run()
+	os.Exit(exitCode)
+}
+
+// ============================================================================
+// Helpers
+// ============================================================================
+
+func testWorkspaces() []container_utils.Workspace {
+	app := &container_utils.Container{ID: "aaaaaaaaaaaa", Names: "app_devcontainer-app-1", State: "running", Ports: []string{"0.0.0.0:3000->3000/tcp"}}
+	db := &container_utils.Container{ID: "bbbbbbbbbbbb", Names: "app_devcontainer-db-1", State: "exited"}
+	api := &container_utils.Container{ID: "cccccccccccc", Names: "api_devcontainer-api-1", State: "running"}
+
+	return []container_utils.Workspace{
+		{
+			Path:       "/home/user/api",
+			Containers: []*container_utils.Container{api},
+			Stats:      container_utils.AggregateStats("/home/user/api", []*container_utils.ContainerStats{{ID: "cccccccccccc", CPUPercent: 1, MemoryUsage: 64 << 20}}),
+		},
+		{
+			Path:       "/home/user/app",
+			Containers: []*container_utils.Container{app, db},
+			Stats:      container_utils.AggregateStats("/home/user/app", []*container_utils.ContainerStats{{ID: "aaaaaaaaaaaa", CPUPercent: 12.5, MemoryUsage: 1 << 30}}),
+		},
+	}
+}
+
+func newTestTerminal(t *testing.T) *MockTerminal {
+	terminal := NewMockTerminal(t)
+	terminal.EXPECT().IsTerminal().Return(true)
+	terminal.EXPECT().MakeRaw().Return(nil)
+	terminal.EXPECT().Restore().Return(nil)
+	terminal.EXPECT().Size().Return(120, 40, nil)
+	return terminal
+}
+
+// newTestDashboard runs the dashboard over the keys, read one per Read
+// call like a terminal delivers them.
+func newTestDashboard(t *testing.T, containerCLI container.ContainerCLI, keys string, opts ...Option) (Dashboard, *bytes.Buffer) {
+	var out bytes.Buffer
+
+	opts = append([]Option{
+		WithContainerCLI(containerCLI),
+		WithTerminal(newTestTerminal(t)),
+		WithInterval(time.Hour),
+		WithInput(iotest.OneByteReader(strings.NewReader(keys))),
+		WithOutput(&out),
+	}, opts...)
+
+	return NewDashboard(opts...), &out
+}
+
+// ============================================================================
+// Tests for Run
+// ============================================================================
+
+func TestRun_NotATerminal_ReturnsError(t *testing.T) {
+	r := require.New(t)
+
+	terminal := NewMockTerminal(t)
+	terminal.EXPECT().IsTerminal().Return(false)
+
+	err := NewDashboard(WithTerminal(terminal)).Run()
+
+	r.ErrorContains(err, "terminal interativo")
+}
+
+func TestRun_QuitRestoresTheScreen(t *testing.T) {
+	r := require.New(t)
+
+	containerCLI := container.NewMockContainerCLI(t)
+	containerCLI.EXPECT().GetWorkspaces(true).Return(testWorkspaces(), nil)
+
+	dashboard, out := newTestDashboard(t, containerCLI, "q")
+
+	err := dashboard.Run()
+
+	r.Nil(err)
+	r.True(strings.HasPrefix(out.String(), enterAltScreen))
+	r.True(strings.HasSuffix(out.String(), leaveAltScreen))
+	r.Contains(out.String(), "/home/user/app")
+}
+
+func TestRun_InputClosed_Quits(t *testing.T) {
+	r := require.New(t)
+
+	containerCLI := container.NewMockContainerCLI(t)
+	containerCLI.EXPECT().GetWorkspaces(true).Return(nil, nil)
+
+	dashboard, out := newTestDashboard(t, containerCLI, "")
+
+	err := dashboard.Run()
+
+	r.Nil(err)
+	r.Contains(out.String(), "Nenhum DevContainer encontrado.")
+}
+
+func TestRun_RefreshError_IsShownInStatus(t *testing.T) {
+	r := require.New(t)
+
+	containerCLI := container.NewMockContainerCLI(t)
+	containerCLI.EXPECT().GetWorkspaces(true).Return(nil, fmt.Errorf("daemon offline"))
+
+	dashboard, out := newTestDashboard(t, containerCLI, "q")
+
+	err := dashboard.Run()
+
+	r.Nil(err)
+	r.Contains(out.String(), "Erro ao atualizar: daemon offline")
+}
+
+func TestRun_StopStopsTheSelectedWorkspace(t *testing.T) {
+	r := require.New(t)
+
+	containerCLI := container.NewMockContainerCLI(t)
+	containerCLI.EXPECT().GetWorkspaces(true).Return(testWorkspaces(), nil)
+	containerCLI.EXPECT().DownContainer("/home/user/app", container.DownOptions{}).Return(nil)
+
+	dashboard, out := newTestDashboard(t, containerCLI, "jsq")
+
+	err := dashboard.Run()
+
+	r.Nil(err)
+	r.Contains(out.String(), "Containers de /home/user/app parados.")
+}
+
+func TestRun_KillAsksForConfirmation(t *testing.T) {
+	r := require.New(t)
+
+	containerCLI := container.NewMockContainerCLI(t)
+	containerCLI.EXPECT().GetWorkspaces(true).Return(testWorkspaces(), nil)
+	containerCLI.EXPECT().KillContainer("/home/user/api", container.KillOptions{Yes: true}).Return(nil).Once()
+
+	dashboard, out := newTestDashboard(t, containerCLI, "xnxsq")
+
+	err := dashboard.Run()
+
+	r.Nil(err)
+	r.Contains(out.String(), "Remoção cancelada.")
+	r.Contains(out.String(), "Containers de /home/user/api removidos.")
+}
+
+func TestRun_OpenOpensTheEditor(t *testing.T) {
+	r := require.New(t)
+
+	containerCLI := container.NewMockContainerCLI(t)
+	containerCLI.EXPECT().GetWorkspaces(true).Return(testWorkspaces(), nil)
+
+	vs := vscode.NewMockVSCode(t)
+	vs.EXPECT().GetContainerWorkspaceURI("/home/user/api").Return("vscode-remote://api", nil)
+	vs.EXPECT().OpenWorkspaceByURI("vscode-remote://api").Return(nil)
+
+	dashboard, _ := newTestDashboard(t, containerCLI, "oq", WithVSCode(vs))
+
+	r.Nil(dashboard.Run())
+}
+
+func TestRun_ShellHandsTheTerminalOver(t *testing.T) {
+	r := require.New(t)
+
+	containerCLI := container.NewMockContainerCLI(t)
+	containerCLI.EXPECT().GetWorkspaces(true).Return(testWorkspaces(), nil)
+
+	dc := devcontainer.NewMockDevContainerCLI(t)
+	dc.EXPECT().OpenShell("/home/user/app").Return(nil)
+
+	dashboard, out := newTestDashboard(t, containerCLI, "jtq", WithDevContainerCLI(dc))
+
+	err := dashboard.Run()
+
+	r.Nil(err)
+	r.Equal(2, strings.Count(out.String(), enterAltScreen))
+	r.Equal(2, strings.Count(out.String(), leaveAltScreen))
+	r.Contains(out.String(), "Shell de /home/user/app encerrado.")
+}
+
+func TestRun_LogsErrorIsShownInStatus(t *testing.T) {
+	r := require.New(t)
+
+	containerCLI := container.NewMockContainerCLI(t)
+	containerCLI.EXPECT().GetWorkspaces(true).Return(testWorkspaces(), nil)
+	containerCLI.EXPECT().ShowLogs("/home/user/api", container.LogsOptions{Follow: true}).Return(fmt.Errorf("sem container"))
+
+	dashboard, out := newTestDashboard(t, containerCLI, "lq")
+
+	err := dashboard.Run()
+
+	r.Nil(err)
+	r.Contains(out.String(), "Erro: sem container")
+}
+
+func TestRun_ActionOnWorkspaceWithoutFolder_IsRefused(t *testing.T) {
+	r := require.New(t)
+
+	containerCLI := container.NewMockContainerCLI(t)
+	containerCLI.EXPECT().GetWorkspaces(true).Return([]container_utils.Workspace{{Path: container_utils.NoLocalFolderGroup}}, nil)
+
+	dashboard, out := newTestDashboard(t, containerCLI, "sq")
+
+	err := dashboard.Run()
+
+	r.Nil(err)
+	r.Contains(out.String(), "Selecione um workspace com pasta local.")
+}
+
+// ============================================================================
+// Tests for rendering
+// ============================================================================
+
+func TestRenderDashboard_ShowsWorkspacesContainersAndUsage(t *testing.T) {
+	r := require.New(t)
+
+	s := &dashboardState{workspaces: testWorkspaces(), selected: 1, updatedAt: time.Date(2024, 1, 15, 10, 30, 0, 0, time.Local)}
+
+	lines := renderDashboard(s, 3*time.Second, 200, 40)
+	out := strings.Join(lines, "\n")
+
+	r.Contains(lines[0], "2 workspace(s)")
+	r.Contains(lines[0], "10:30:00")
+	r.Contains(out, "▶ /home/user/app")
+	r.Contains(out, "1/2")
+	r.Contains(out, "12.50%")
+	r.Contains(out, "1.0GiB")
+	r.Contains(out, "0.0.0.0:3000->3000/tcp")
+	r.Contains(out, "\x1b[7m▶ /home/user/app")
+	r.Equal(helpLine, lines[len(lines)-1])
+}
+
+func TestRenderDashboard_StoppedContainerHasNoUsage(t *testing.T) {
+	r := require.New(t)
+
+	s := &dashboardState{workspaces: testWorkspaces(), updatedAt: time.Now()}
+
+	lines := renderDashboard(s, time.Second, 200, 40)
+
+	for _, line := range lines {
+		if strings.Contains(line, "app_devcontainer-db-1") {
+			r.Contains(line, "exited")
+			r.Equal(2, strings.Count(line, " - "))
+			return
+		}
+	}
+
+	r.Fail("container parado não listado")
+}
+
+func TestRenderDashboard_KeepsSelectionVisible(t *testing.T) {
+	r := require.New(t)
+
+	var workspaces []container_utils.Workspace
+	for i := range 20 {
+		workspaces = append(workspaces, container_utils.Workspace{Path: fmt.Sprintf("/home/user/w%02d", i)})
+	}
+	s := &dashboardState{workspaces: workspaces, selected: 19, updatedAt: time.Now()}
+
+	lines := renderDashboard(s, time.Second, 80, 12)
+
+	r.Len(lines, 12)
+	r.Contains(strings.Join(lines, "\n"), "▶ /home/user/w19")
+}
+
+func TestRenderDashboard_TruncatesToWidth(t *testing.T) {
+	r := require.New(t)
+
+	s := &dashboardState{status: strings.Repeat("é", 100)}
+
+	for _, line := range renderDashboard(s, time.Second, 30, 40) {
+		r.LessOrEqual(len([]rune(line)), 30)
+	}
+}
+
+func TestParseKey(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal(keyUp, parseKey([]byte("\x1b[A")))
+	r.Equal(keyDown, parseKey([]byte("\x1b[B")))
+	r.Equal(keyQuit, parseKey([]byte{3}))
+	r.Equal('s', parseKey([]byte("s")))
+}
@@ -0,0 +1,160 @@
+package dashboard
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Brennon-Oliveira/dev-cli/internal/container/container_utils"
+)
+
+// Keys of the dashboard. Arrows and Ctrl+C are mapped to them by parseKey.
+const (
+	keyUp      = 'k'
+	keyDown    = 'j'
+	keyQuit    = 'q'
+	keyRefresh = 'r'
+	keyStop    = 's'
+	keyKill    = 'x'
+	keyOpen    = 'o'
+	keyLogs    = 'l'
+	keyShell   = 't'
+)
+
+const helpLine = "↑/↓ selecionar · s parar · x remover · o abrir editor · l logs · t shell · r atualizar · q sair"
+
+// dashboardState is what the dashboard shows between two refreshes.
+type dashboardState struct {
+	workspaces  []container_utils.Workspace
+	selected    int
+	status      string
+	refreshErr  error
+	updatedAt   time.Time
+	pendingKill string
+	stale       bool
+}
+
+// selectedPath returns the workspace the actions apply to, if any.
+func (s *dashboardState) selectedPath() (string, bool) {
+	if s.selected < 0 || s.selected >= len(s.workspaces) {
+		return "", false
+	}
+
+	path := s.workspaces[s.selected].Path
+	return path, path != container_utils.NoLocalFolderGroup
+}
+
+// parseKey maps a read from the raw terminal to a key.
+func parseKey(b []byte) rune {
+	switch string(b) {
+	case "\x1b[A", "\x1bOA":
+		return keyUp
+	case "\x1b[B", "\x1bOB":
+		return keyDown
+	case "\x03":
+		return keyQuit
+	}
+
+	r, _ := utf8.DecodeRune(b)
+	return r
+}
+
+// renderDashboard renders the lines of the dashboard, fitted to the
+// terminal size. The selected workspace is kept visible.
+func renderDashboard(s *dashboardState, interval time.Duration, width, height int) []string {
+	header := "dev top · carregando..."
+	if !s.updatedAt.IsZero() {
+		header = fmt.Sprintf("dev top · %d workspace(s) · atualizado às %s · a cada %s",
+			len(s.workspaces), s.updatedAt.Format("15:04:05"), interval)
+	}
+
+	top := []string{
+		header,
+		"",
+		fmt.Sprintf("  %-44s %-8s %8s %10s  %s", "WORKSPACE / CONTAINER", "STATE", "CPU %", "MEM", "PORTS"),
+	}
+
+	var body []string
+	selectedLine := 0
+	for i, workspace := range s.workspaces {
+		marker := "  "
+		if i == s.selected {
+			marker = "▶ "
+			selectedLine = len(body)
+		}
+
+		body = append(body, fmt.Sprintf("%s%-44s %-8s %8s %10s",
+			marker,
+			workspace.Path,
+			fmt.Sprintf("%d/%d", countRunning(workspace.Containers), len(workspace.Containers)),
+			fmt.Sprintf("%.2f%%", workspace.Stats.CPUPercent),
+			container_utils.FormatBytes(workspace.Stats.MemoryUsage),
+		))
+
+		for _, container := range workspace.Containers {
+			cpu, mem := "-", "-"
+			if stat := workspace.ContainerStats(container.ID); stat != nil {
+				cpu = fmt.Sprintf("%.2f%%", stat.CPUPercent)
+				mem = container_utils.FormatBytes(stat.MemoryUsage)
+			}
+
+			body = append(body, fmt.Sprintf("    %-42s %-8s %8s %10s  %s",
+				container.Names, container.State, cpu, mem, strings.Join(container.Ports, ", ")))
+		}
+	}
+
+	if len(s.workspaces) == 0 && !s.updatedAt.IsZero() {
+		body = append(body, "  Nenhum DevContainer encontrado.")
+	}
+
+	status := s.status
+	if s.refreshErr != nil {
+		status = "Erro ao atualizar: " + s.refreshErr.Error()
+	}
+
+	bottom := []string{"", status, helpLine}
+
+	bodyHeight := height - len(top) - len(bottom)
+	if bodyHeight > 0 && len(body) > bodyHeight {
+		offset := 0
+		if selectedLine >= bodyHeight {
+			offset = selectedLine - bodyHeight + 1
+		}
+
+		body = body[offset:min(offset+bodyHeight, len(body))]
+		selectedLine -= offset
+	}
+
+	lines := append(append(top, body...), bottom...)
+	for i := range lines {
+		lines[i] = truncate(lines[i], width)
+	}
+
+	if len(s.workspaces) > 0 {
+		i := len(top) + selectedLine
+		lines[i] = "\x1b[7m" + lines[i] + "\x1b[0m"
+	}
+
+	return lines
+}
+
+func countRunning(containers []*container_utils.Container) int {
+	running := 0
+	for _, container := range containers {
+		if container.State == "running" {
+			running++
+		}
+	}
+
+	return running
+}
+
+// truncate cuts a line to the terminal width, counting runes.
+func truncate(line string, width int) string {
+	if width <= 0 || utf8.RuneCountInString(line) <= width {
+		return line
+	}
+
+	return string([]rune(line)[:width])
+}
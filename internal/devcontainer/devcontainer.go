@@ -1,17 +1,53 @@
 package devcontainer
 
 type DevContainerCLI interface {
-	Up(workspace string) error
+	Up(workspace string, opts UpOptions) error
 	GetWorkspaceFolder(absPath string) (string, error)
 	ReadConfiguration(absPath string) (*DevContainerConfiguration, error)
 	RunInteractive(path string, command string) error
 	OpenShell(path string) error
 }
 
+// UpOptions rebuilds the devcontainer on Up.
+type UpOptions struct {
+	// RemoveExistingContainer recreates the container and rebuilds its image.
+	RemoveExistingContainer bool
+	// BuildNoCache rebuilds the image without the build cache.
+	BuildNoCache bool
+	// OverrideConfig is a devcontainer.json used instead of the one of the
+	// workspace.
+	OverrideConfig string
+}
+
 type DevContainerConfiguration_Workspace struct {
 	WorkspaceFolder string `json:"workspaceFolder"`
 }
 
+// DevContainerConfiguration_Configuration is the devcontainer.json merged by
+// `devcontainer read-configuration`. Ports are numbers or strings, so they
+// are read with DeclaredPorts.
+type DevContainerConfiguration_Configuration struct {
+	ForwardPorts []any `json:"forwardPorts,omitempty"`
+	AppPort      any   `json:"appPort,omitempty"`
+	// Raw holds every property of the configuration, to derive override
+	// configurations from.
+	Raw map[string]any `json:"-"`
+}
+
 type DevContainerConfiguration struct {
-	Workspace DevContainerConfiguration_Workspace `json:"workspace"`
+	Workspace     DevContainerConfiguration_Workspace     `json:"workspace"`
+	Configuration DevContainerConfiguration_Configuration `json:"configuration"`
+}
+
+// DeclaredPort is a port of `forwardPorts` or `appPort`.
+type DeclaredPort struct {
+	// Source is the devcontainer.json property, forwardPorts or appPort.
+	Source string
+	// Service is the compose service of a "service:port" entry, empty for
+	// the devcontainer itself.
+	Service string
+	// Port is the port inside the container.
+	Port int
+	// HostPort is the port expected on the host.
+	HostPort int
 }
@@ -1,9 +1,13 @@
 package devcontainer
 
-import "github.com/Brennon-Oliveira/dev-cli/internal/exec"
+import (
+	"github.com/Brennon-Oliveira/dev-cli/internal/config"
+	"github.com/Brennon-Oliveira/dev-cli/internal/exec"
+)
 
 type realDevContainerCLI struct {
 	executor exec.Executor
+	config   config.Config
 }
 
 type Option func(*realDevContainerCLI)
@@ -23,3 +27,9 @@ func WithExecutor(e exec.Executor) Option {
 		d.executor = e
 	}
 }
+
+func WithConfig(c config.Config) Option {
+	return func(d *realDevContainerCLI) {
+		d.config = c
+	}
+}
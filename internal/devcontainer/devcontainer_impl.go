@@ -9,9 +9,20 @@ import (
 	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
 )
 
-func (d *realDevContainerCLI) Up(workspace string) error {
+func (d *realDevContainerCLI) Up(workspace string, opts UpOptions) error {
+	args := []string{"up", "--workspace-folder", workspace}
+	if opts.RemoveExistingContainer {
+		args = append(args, "--remove-existing-container")
+	}
+	if opts.BuildNoCache {
+		args = append(args, "--build-no-cache")
+	}
+	if opts.OverrideConfig != "" {
+		args = append(args, "--override-config", opts.OverrideConfig)
+	}
+
 	logger.Info("Subindo dev containers")
-	err := d.executor.Run("devcontainer", "up", "--workspace-folder", workspace)
+	err := d.executor.Run("devcontainer", args...)
 	if err != nil {
 		logger.Error("Houve um erro ao subir os devcontainers")
 		return err
@@ -36,6 +47,14 @@ func (d *realDevContainerCLI) ReadConfiguration(absPath string) (*DevContainerCo
 		return nil, err
 	}
 
+	var raw struct {
+		Configuration map[string]any `json:"configuration"`
+	}
+	if err := json.Unmarshal(devcontainerJsonRaw, &raw); err != nil {
+		return nil, err
+	}
+	config.Configuration.Raw = raw.Configuration
+
 	return &config, nil
 }
 
@@ -100,6 +119,17 @@ func (c *realDevContainerCLI) OpenShell(path string) error {
 	tool := "devcontainer"
 
 	shells := []string{"/bin/zsh", "/bin/bash", "/bin/sh"}
+	if c.config != nil {
+		cfg, err := c.config.Load()
+		if err != nil {
+			return err
+		}
+
+		if configured := cfg.Shell.Preferred; configured != "" {
+			shells = append([]string{configured}, shells...)
+		}
+	}
+
 	var preferredShell string
 
 	for _, shell := range shells {
@@ -266,16 +266,16 @@ func (_c *MockDevContainerCLI_RunInteractive_Call) RunAndReturn(run func(path st
 }
 
 // Up provides a mock function for the type MockDevContainerCLI
-func (_mock *MockDevContainerCLI) Up(workspace string) error {
-	ret := _mock.Called(workspace)
+func (_mock *MockDevContainerCLI) Up(workspace string, opts UpOptions) error {
+	ret := _mock.Called(workspace, opts)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Up")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
-		r0 = returnFunc(workspace)
+	if returnFunc, ok := ret.Get(0).(func(string, UpOptions) error); ok {
+		r0 = returnFunc(workspace, opts)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -289,18 +289,24 @@ type MockDevContainerCLI_Up_Call struct {
 
 // Up is a helper method to define mock.On call
 //   - workspace string
-func (_e *MockDevContainerCLI_Expecter) Up(workspace interface{}) *MockDevContainerCLI_Up_Call {
-	return &MockDevContainerCLI_Up_Call{Call: _e.mock.On("Up", workspace)}
+//   - opts UpOptions
+func (_e *MockDevContainerCLI_Expecter) Up(workspace interface{}, opts interface{}) *MockDevContainerCLI_Up_Call {
+	return &MockDevContainerCLI_Up_Call{Call: _e.mock.On("Up", workspace, opts)}
 }
 
-func (_c *MockDevContainerCLI_Up_Call) Run(run func(workspace string)) *MockDevContainerCLI_Up_Call {
+func (_c *MockDevContainerCLI_Up_Call) Run(run func(workspace string, opts UpOptions)) *MockDevContainerCLI_Up_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 string
 		if args[0] != nil {
 			arg0 = args[0].(string)
 		}
+		var arg1 UpOptions
+		if args[1] != nil {
+			arg1 = args[1].(UpOptions)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -311,7 +317,7 @@ func (_c *MockDevContainerCLI_Up_Call) Return(err error) *MockDevContainerCLI_Up
 	return _c
 }
 
-func (_c *MockDevContainerCLI_Up_Call) RunAndReturn(run func(workspace string) error) *MockDevContainerCLI_Up_Call {
+func (_c *MockDevContainerCLI_Up_Call) RunAndReturn(run func(workspace string, opts UpOptions) error) *MockDevContainerCLI_Up_Call {
 	_c.Call.Return(run)
 	return _c
 }
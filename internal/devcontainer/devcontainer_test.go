@@ -6,6 +6,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/Brennon-Oliveira/dev-cli/internal/config"
 	"github.com/Brennon-Oliveira/dev-cli/internal/exec"
 	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
 	"github.com/stretchr/testify/assert"
@@ -32,7 +33,7 @@ func TestUp_JustReturnWhenWork(t *testing.T) {
 		WithExecutor(executor),
 	)
 
-	err := devcontainerCLI.Up(workspace)
+	err := devcontainerCLI.Up(workspace, UpOptions{})
 
 	assert.Nil(t, err)
 	assert.Contains(t, capturedArgs, workspace)
@@ -48,11 +49,27 @@ func TestUp_IfErrorThrowUp(t *testing.T) {
 		WithExecutor(executor),
 	)
 
-	err := devcontainerCLI.Up(workspace)
+	err := devcontainerCLI.Up(workspace, UpOptions{})
 
 	assert.ErrorContains(t, err, "generic error")
 }
 
+func TestUp_Rebuild_PassesRebuildFlags(t *testing.T) {
+	r := require.New(t)
+	workspace := "/tmp/workspace"
+	executor := exec.NewMockExecutor(t)
+
+	executor.EXPECT().Run("devcontainer", []string{"up", "--workspace-folder", workspace, "--remove-existing-container", "--build-no-cache"}).Return(nil)
+
+	devcontainerCLI := NewDevContainerCLI(
+		WithExecutor(executor),
+	)
+
+	err := devcontainerCLI.Up(workspace, UpOptions{RemoveExistingContainer: true, BuildNoCache: true})
+
+	r.Nil(err)
+}
+
 func TestReadConfiguration_ReturnUnmarshaledConfig(t *testing.T) {
 	r := require.New(t)
 	workspace := "/tmp/workspace"
@@ -87,6 +104,44 @@ func TestReadConfiguration_ReturnUnmarshaledConfig(t *testing.T) {
 	assert.Equal(t, config.Workspace.WorkspaceFolder, "my-project")
 }
 
+func TestUp_OverrideConfig_PassesConfigFile(t *testing.T) {
+	r := require.New(t)
+	workspace := "/tmp/workspace"
+	executor := exec.NewMockExecutor(t)
+
+	executor.EXPECT().Run("devcontainer", []string{"up", "--workspace-folder", workspace, "--remove-existing-container", "--override-config", "/tmp/override.json"}).Return(nil)
+
+	devcontainerCLI := NewDevContainerCLI(
+		WithExecutor(executor),
+	)
+
+	err := devcontainerCLI.Up(workspace, UpOptions{RemoveExistingContainer: true, OverrideConfig: "/tmp/override.json"})
+
+	r.Nil(err)
+}
+
+func TestReadConfiguration_KeepsRawConfiguration(t *testing.T) {
+	r := require.New(t)
+	workspace := "/tmp/workspace"
+
+	executor := exec.NewMockExecutor(t)
+	executor.EXPECT().Output("devcontainer", []string{"read-configuration", "--workspace-folder", workspace}).Return([]byte(`{
+		"workspace": {"workspaceFolder": "/workspaces/app"},
+		"configuration": {"image": "mcr.microsoft.com/devcontainers/go:1", "forwardPorts": [3000], "remoteUser": "vscode"}
+	}`), nil)
+
+	devcontainerCLI := NewDevContainerCLI(
+		WithExecutor(executor),
+	)
+
+	config, err := devcontainerCLI.ReadConfiguration(workspace)
+	r.Nil(err)
+
+	r.Equal([]any{float64(3000)}, config.Configuration.ForwardPorts)
+	r.Equal("mcr.microsoft.com/devcontainers/go:1", config.Configuration.Raw["image"])
+	r.Equal("vscode", config.Configuration.Raw["remoteUser"])
+}
+
 func TestReadConfiguration_ThrowErrorIfNotAbleToUnmarshal(t *testing.T) {
 	r := require.New(t)
 	workspace := "/tmp/workspace"
@@ -707,3 +762,63 @@ func TestOpenShell_ClosesSuccessfully(t *testing.T) {
 	r.Nil(err)
 	executor.AssertExpectations(t)
 }
+
+func TestOpenShell_TriesConfiguredShellFirst(t *testing.T) {
+	r := require.New(t)
+	path := "/home/user/project"
+
+	executor := exec.NewMockExecutor(t)
+	cfg := config.NewMockConfig(t)
+
+	globalCfg := config.GlobalConfig{}
+	globalCfg.Shell.Preferred = "/usr/bin/fish"
+	cfg.EXPECT().Load().Return(globalCfg, nil)
+
+	executor.EXPECT().Run("devcontainer", []string{"exec", "--workspace-folder", path, "test", "-x", "/usr/bin/fish"}).Return(nil).Once()
+	executor.EXPECT().RunInteractive("devcontainer", []string{"exec", "--workspace-folder", path, "/usr/bin/fish"}).Return(nil).Once()
+
+	devcontainerCLI := NewDevContainerCLI(
+		WithExecutor(executor),
+		WithConfig(cfg),
+	)
+
+	err := devcontainerCLI.OpenShell(path)
+
+	r.Nil(err)
+}
+
+func TestReadConfiguration_ReadsDeclaredPorts(t *testing.T) {
+	r := require.New(t)
+
+	executor := exec.NewMockExecutor(t)
+	executor.EXPECT().Output(mock.Anything, mock.Anything).Return([]byte(`{
+		"workspace": {"workspaceFolder": "/workspaces/app"},
+		"configuration": {"forwardPorts": [3000, "db:5432", "localhost:9229", "invalid"], "appPort": ["8080:80", 4000]}
+	}`), nil)
+
+	config, err := NewDevContainerCLI(WithExecutor(executor)).ReadConfiguration("/tmp/workspace")
+	r.Nil(err)
+
+	r.Equal([]DeclaredPort{
+		{Source: "forwardPorts", Port: 3000, HostPort: 3000},
+		{Source: "forwardPorts", Service: "db", Port: 5432, HostPort: 5432},
+		{Source: "forwardPorts", Port: 9229, HostPort: 9229},
+		{Source: "appPort", Port: 80, HostPort: 8080},
+		{Source: "appPort", Port: 4000, HostPort: 4000},
+	}, config.DeclaredPorts())
+}
+
+func TestDeclaredPorts_SingleAppPort(t *testing.T) {
+	r := require.New(t)
+
+	config := &DevContainerConfiguration{}
+	config.Configuration.AppPort = "3000"
+
+	r.Equal([]DeclaredPort{{Source: "appPort", Port: 3000, HostPort: 3000}}, config.DeclaredPorts())
+}
+
+func TestDeclaredPorts_NoPorts(t *testing.T) {
+	r := require.New(t)
+
+	r.Empty((&DevContainerConfiguration{}).DeclaredPorts())
+}
@@ -0,0 +1,108 @@
+package devcontainer
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
+)
+
+const (
+	forwardPortsSource = "forwardPorts"
+	appPortSource      = "appPort"
+)
+
+// DeclaredPorts reads `forwardPorts` ([3000, "db:5432"]) and `appPort`
+// (3000, "8080:3000" or a list of them). Invalid entries are skipped.
+func (c *DevContainerConfiguration) DeclaredPorts() []DeclaredPort {
+	var ports []DeclaredPort
+
+	for _, entry := range c.Configuration.ForwardPorts {
+		port, ok := parseForwardPort(entry)
+		if !ok {
+			logger.Verbose("Ignorando entrada inválida em forwardPorts: %v", entry)
+			continue
+		}
+
+		ports = append(ports, port)
+	}
+
+	appPorts, isList := c.Configuration.AppPort.([]any)
+	if !isList && c.Configuration.AppPort != nil {
+		appPorts = []any{c.Configuration.AppPort}
+	}
+
+	for _, entry := range appPorts {
+		port, ok := parseAppPort(entry)
+		if !ok {
+			logger.Verbose("Ignorando entrada inválida em appPort: %v", entry)
+			continue
+		}
+
+		ports = append(ports, port)
+	}
+
+	return ports
+}
+
+// parseForwardPort reads 3000, "3000", "db:5432" or "localhost:3000".
+func parseForwardPort(entry any) (DeclaredPort, bool) {
+	port := DeclaredPort{Source: forwardPortsSource}
+
+	switch value := entry.(type) {
+	case float64:
+		port.Port = int(value)
+	case string:
+		host, portValue, found := strings.Cut(value, ":")
+		if !found {
+			host, portValue = "", value
+		}
+
+		number, err := strconv.Atoi(portValue)
+		if err != nil {
+			return port, false
+		}
+
+		port.Port = number
+		if host != "localhost" && host != "127.0.0.1" {
+			port.Service = host
+		}
+	default:
+		return port, false
+	}
+
+	port.HostPort = port.Port
+	return port, port.Port > 0
+}
+
+// parseAppPort reads 3000, "3000" or "8080:3000", the host port first.
+func parseAppPort(entry any) (DeclaredPort, bool) {
+	port := DeclaredPort{Source: appPortSource}
+
+	switch value := entry.(type) {
+	case float64:
+		port.Port = int(value)
+		port.HostPort = port.Port
+	case string:
+		parts := strings.Split(value, ":")
+
+		number, err := strconv.Atoi(parts[len(parts)-1])
+		if err != nil {
+			return port, false
+		}
+		port.Port = number
+		port.HostPort = number
+
+		if len(parts) > 1 {
+			hostPort, err := strconv.Atoi(parts[len(parts)-2])
+			if err != nil {
+				return port, false
+			}
+			port.HostPort = hostPort
+		}
+	default:
+		return port, false
+	}
+
+	return port, port.Port > 0
+}
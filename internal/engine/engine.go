@@ -0,0 +1,159 @@
+package engine
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// Resource is a kind of object removed by Prune.
+type Resource string
+
+const (
+	ResourceContainer Resource = "container"
+	ResourceNetwork   Resource = "network"
+)
+
+// ListOptions selects the containers returned by List and ListContainers.
+type ListOptions struct {
+	// All includes stopped containers.
+	All bool
+	// Filters are passed as --filter, e.g. "label=devcontainer.local_folder=/path".
+	Filters []string
+}
+
+type LogsOptions struct {
+	Follow bool
+	// Tail limits the output to the last lines. Zero shows everything.
+	Tail int
+	// Since shows only the logs after a timestamp or relative time (10m).
+	Since      string
+	Timestamps bool
+	// Output receives the logs, which otherwise go to the executor stdout.
+	Output io.Writer
+	// ErrOutput receives what the container wrote to stderr, when Output
+	// is set. Nil discards it.
+	ErrOutput io.Writer
+}
+
+// ExecOptions runs Command in a container with its stdin and stdout piped.
+type ExecOptions struct {
+	Command []string
+	Stdin   io.Reader
+	Stdout  io.Writer
+}
+
+// RunOptions runs Command in a throwaway container of Image, with its stdin
+// and stdout piped.
+type RunOptions struct {
+	Image string
+	// Volumes are mounted as with -v, e.g. "data:/volume:ro".
+	Volumes []string
+	Command []string
+	// Stdin is attached with -i when set.
+	Stdin  io.Reader
+	Stdout io.Writer
+}
+
+// Container is the engine independent view of a container listed by `ps`.
+type Container struct {
+	ID    string `json:"id" yaml:"id"`
+	Names string `json:"names" yaml:"names"`
+	Image string `json:"image" yaml:"image"`
+	// State is the machine readable state, e.g. "running" or "exited".
+	State string `json:"state" yaml:"state"`
+	// Status is the human readable state, e.g. "Up 5 minutes".
+	Status  string    `json:"status" yaml:"status"`
+	Created time.Time `json:"created" yaml:"created"`
+	// Ports are the published ports, e.g. "0.0.0.0:3000->3000/tcp".
+	Ports  []string          `json:"ports" yaml:"ports"`
+	Labels map[string]string `json:"labels" yaml:"labels"`
+	// LocalFolder is the workspace folder of the devcontainer, empty for
+	// auxiliary containers.
+	LocalFolder    string `json:"localFolder" yaml:"localFolder"`
+	ComposeProject string `json:"composeProject" yaml:"composeProject"`
+	ComposeService string `json:"composeService" yaml:"composeService"`
+}
+
+// ContainerStats is a one-shot resource usage sample of a container, with
+// sizes in bytes.
+type ContainerStats struct {
+	ID            string  `json:"id" yaml:"id"`
+	Name          string  `json:"name" yaml:"name"`
+	CPUPercent    float64 `json:"cpuPercent" yaml:"cpuPercent"`
+	MemoryUsage   uint64  `json:"memoryUsage" yaml:"memoryUsage"`
+	MemoryLimit   uint64  `json:"memoryLimit" yaml:"memoryLimit"`
+	MemoryPercent float64 `json:"memoryPercent" yaml:"memoryPercent"`
+	NetworkRx     uint64  `json:"networkRx" yaml:"networkRx"`
+	NetworkTx     uint64  `json:"networkTx" yaml:"networkTx"`
+	BlockRead     uint64  `json:"blockRead" yaml:"blockRead"`
+	BlockWrite    uint64  `json:"blockWrite" yaml:"blockWrite"`
+	PIDs          int     `json:"pids" yaml:"pids"`
+}
+
+// Image is the engine independent view of an image listed by `image ls`.
+type Image struct {
+	ID         string
+	Repository string
+	Tag        string
+	// Size is the image size in bytes.
+	Size uint64
+}
+
+// ContainerDetails is the engine independent subset of `inspect`.
+type ContainerDetails struct {
+	ID     string
+	Name   string
+	Image  string
+	State  string
+	Labels map[string]string
+	// Volumes are the names of the volumes mounted in the container.
+	Volumes []string
+	// Health is "starting", "healthy" or "unhealthy", empty without a
+	// healthcheck.
+	Health string
+	// ExitCode is the code of the last run, meaningful once exited.
+	ExitCode int
+}
+
+// Engine runs container operations on a specific engine CLI, hiding the
+// differences between their flags, templates and labels.
+type Engine interface {
+	Name() string
+	List(opts ListOptions) ([]string, error)
+	ListContainers(opts ListOptions) ([]*Container, error)
+	Inspect(id string) (*ContainerDetails, error)
+	// ComposeProjectLabels lists the labels holding the compose project name,
+	// in lookup order.
+	ComposeProjectLabels() []string
+	// ContainerPods maps each of the given containers that belongs to a pod to
+	// the pod ID. Engines without pods return an empty map.
+	ContainerPods(ids ...string) (map[string]string, error)
+	Stop(ids ...string) error
+	StopPods(pods ...string) error
+	Start(ids ...string) error
+	Remove(ids ...string) error
+	RemovePods(pods ...string) error
+	RemoveVolumes(names ...string) error
+	Logs(id string, opts LogsOptions) error
+	Ports(id string) (string, error)
+	// Exec runs a command in a running container until it exits or ctx is
+	// done.
+	Exec(ctx context.Context, id string, opts ExecOptions) error
+	// Run runs a throwaway container, removed once it exits, until it exits
+	// or ctx is done.
+	Run(ctx context.Context, opts RunOptions) error
+	// Stats samples the resource usage of running containers once.
+	Stats(ids ...string) ([]*ContainerStats, error)
+	Prune(resource Resource) error
+	// Commit saves the filesystem of a container as a new image.
+	Commit(id string, image string) error
+	ListImages() ([]*Image, error)
+	RemoveImages(ids ...string) error
+	// ListNetworks returns the names of the networks matching the filters.
+	ListNetworks(filters ...string) ([]string, error)
+	RemoveNetworks(names ...string) error
+	// PruneBuildCache removes the dangling build cache, returning the bytes
+	// reclaimed.
+	PruneBuildCache() (uint64, error)
+}
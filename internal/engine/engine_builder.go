@@ -0,0 +1,63 @@
+package engine
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/Brennon-Oliveira/dev-cli/internal/exec"
+	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
+)
+
+// cliEngine is the Docker compatible implementation shared by every engine.
+// Engines with different behavior embed it and override the methods.
+type cliEngine struct {
+	name     string
+	executor exec.Executor
+}
+
+type Option func(*cliEngine)
+
+type factory func(base *cliEngine) Engine
+
+var engines = map[string]factory{
+	"docker":  newDockerEngine,
+	"podman":  newPodmanEngine,
+	"nerdctl": newNerdctlEngine,
+}
+
+// Names returns the supported engines, sorted.
+func Names() []string {
+	var names []string
+	for name := range engines {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return names
+}
+
+// NewEngine returns the engine registered as name, usually the value of
+// `core.tool`.
+func NewEngine(name string, opts ...Option) (Engine, error) {
+	factory, exists := engines[name]
+	if !exists {
+		logger.Error("Motor de containers '%s' não suportado", name)
+		return nil, fmt.Errorf("motor de containers não suportado: %s", name)
+	}
+
+	base := &cliEngine{
+		name: name,
+	}
+
+	for _, opt := range opts {
+		opt(base)
+	}
+
+	return factory(base), nil
+}
+
+func WithExecutor(e exec.Executor) Option {
+	return func(c *cliEngine) {
+		c.executor = e
+	}
+}
@@ -0,0 +1,393 @@
+package engine
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const localFolderLabel = "devcontainer.local_folder"
+
+// psTimeLayout is the CreatedAt format printed by `docker ps` and `nerdctl ps`.
+const psTimeLayout = "2006-01-02 15:04:05 -0700 MST"
+
+// labelKeyPattern matches the start of a "key=value" label. Values may hold
+// commas, such as the JSON in devcontainer.metadata, so a comma only starts a
+// new label when it is followed by a key.
+var labelKeyPattern = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._/-]*=`)
+
+func newDockerEngine(base *cliEngine) Engine {
+	return base
+}
+
+func (c *cliEngine) Name() string {
+	return c.name
+}
+
+// List returns the IDs of the containers matching opts.
+func (c *cliEngine) List(opts ListOptions) ([]string, error) {
+	args := append(psArgs(opts), "-q")
+	args = append(args, filterArgs(opts)...)
+
+	out, err := c.executor.Output(c.name, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return splitLines(string(out)), nil
+}
+
+// ListContainers parses the `ps` output printed one JSON object per line.
+func (c *cliEngine) ListContainers(opts ListOptions) ([]*Container, error) {
+	args := append(psArgs(opts), filterArgs(opts)...)
+	args = append(args, "--format", "{{json .}}")
+
+	out, err := c.executor.Output(c.name, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	var containers []*Container
+	for _, line := range splitLines(string(out)) {
+		var ps struct {
+			ID        string `json:"ID"`
+			Names     string `json:"Names"`
+			Image     string `json:"Image"`
+			State     string `json:"State"`
+			Status    string `json:"Status"`
+			CreatedAt string `json:"CreatedAt"`
+			Ports     string `json:"Ports"`
+			Labels    string `json:"Labels"`
+		}
+
+		if err := json.Unmarshal([]byte(line), &ps); err != nil {
+			return nil, fmt.Errorf("saída inválida do %s ps: %w", c.name, err)
+		}
+
+		state := ps.State
+		if state == "" {
+			state = stateFromStatus(ps.Status)
+		}
+
+		created, _ := time.Parse(psTimeLayout, ps.CreatedAt)
+
+		container := &Container{
+			ID:      ps.ID,
+			Names:   ps.Names,
+			Image:   ps.Image,
+			State:   state,
+			Status:  ps.Status,
+			Created: created,
+			Ports:   splitPorts(ps.Ports),
+			Labels:  parseLabelList(ps.Labels),
+		}
+		fillLabelFields(container, c.ComposeProjectLabels(), []string{dockerComposeServiceLabel})
+
+		containers = append(containers, container)
+	}
+
+	return containers, nil
+}
+
+func (c *cliEngine) Inspect(id string) (*ContainerDetails, error) {
+	out, err := c.executor.Output(c.name, "inspect", id)
+	if err != nil {
+		return nil, err
+	}
+
+	var inspected []struct {
+		ID     string `json:"Id"`
+		Name   string `json:"Name"`
+		Config struct {
+			Image  string            `json:"Image"`
+			Labels map[string]string `json:"Labels"`
+		} `json:"Config"`
+		State struct {
+			Status   string `json:"Status"`
+			ExitCode int    `json:"ExitCode"`
+			Health   *struct {
+				Status string `json:"Status"`
+			} `json:"Health"`
+			// Healthcheck is the name used by podman before 4.
+			Healthcheck *struct {
+				Status string `json:"Status"`
+			} `json:"Healthcheck"`
+		} `json:"State"`
+		Mounts []struct {
+			Type string `json:"Type"`
+			Name string `json:"Name"`
+		} `json:"Mounts"`
+	}
+
+	if err := json.Unmarshal(out, &inspected); err != nil {
+		return nil, fmt.Errorf("saída inválida do inspect de %s: %w", id, err)
+	}
+
+	if len(inspected) == 0 {
+		return nil, fmt.Errorf("container não encontrado: %s", id)
+	}
+
+	details := &ContainerDetails{
+		ID:       inspected[0].ID,
+		Name:     strings.TrimPrefix(inspected[0].Name, "/"),
+		Image:    inspected[0].Config.Image,
+		State:    inspected[0].State.Status,
+		Labels:   inspected[0].Config.Labels,
+		ExitCode: inspected[0].State.ExitCode,
+	}
+
+	if details.Labels == nil {
+		details.Labels = map[string]string{}
+	}
+
+	if health := inspected[0].State.Health; health != nil {
+		details.Health = health.Status
+	} else if health := inspected[0].State.Healthcheck; health != nil {
+		details.Health = health.Status
+	}
+
+	for _, mount := range inspected[0].Mounts {
+		if mount.Type == "volume" && mount.Name != "" {
+			details.Volumes = append(details.Volumes, mount.Name)
+		}
+	}
+
+	return details, nil
+}
+
+const (
+	dockerComposeProjectLabel = "com.docker.compose.project"
+	dockerComposeServiceLabel = "com.docker.compose.service"
+)
+
+func (c *cliEngine) ComposeProjectLabels() []string {
+	return []string{dockerComposeProjectLabel}
+}
+
+func (c *cliEngine) ContainerPods(ids ...string) (map[string]string, error) {
+	return map[string]string{}, nil
+}
+
+func (c *cliEngine) Stop(ids ...string) error {
+	return c.executor.Run(c.name, append([]string{"stop"}, ids...)...)
+}
+
+func (c *cliEngine) StopPods(pods ...string) error {
+	return fmt.Errorf("o motor %s não suporta pods", c.name)
+}
+
+func (c *cliEngine) Start(ids ...string) error {
+	return c.executor.Run(c.name, append([]string{"start"}, ids...)...)
+}
+
+// Remove forces the removal of the containers, stopping them if needed.
+func (c *cliEngine) Remove(ids ...string) error {
+	return c.executor.Run(c.name, append([]string{"rm", "-f"}, ids...)...)
+}
+
+func (c *cliEngine) RemovePods(pods ...string) error {
+	return fmt.Errorf("o motor %s não suporta pods", c.name)
+}
+
+func (c *cliEngine) RemoveVolumes(names ...string) error {
+	return c.executor.Run(c.name, append([]string{"volume", "rm", "-f"}, names...)...)
+}
+
+func (c *cliEngine) Logs(id string, opts LogsOptions) error {
+	args := []string{"logs"}
+	if opts.Follow {
+		args = append(args, "-f")
+	}
+	if opts.Tail > 0 {
+		args = append(args, "--tail", strconv.Itoa(opts.Tail))
+	}
+	if opts.Since != "" {
+		args = append(args, "--since", opts.Since)
+	}
+	if opts.Timestamps {
+		args = append(args, "--timestamps")
+	}
+	args = append(args, id)
+
+	if opts.Output != nil {
+		return c.executor.RunWithStreams(opts.Output, opts.ErrOutput, c.name, args...)
+	}
+
+	return c.executor.Run(c.name, args...)
+}
+
+func (c *cliEngine) Ports(id string) (string, error) {
+	out, err := c.executor.Output(c.name, "port", id)
+	if err != nil {
+		return "", err
+	}
+
+	return string(out), nil
+}
+
+func (c *cliEngine) Exec(ctx context.Context, id string, opts ExecOptions) error {
+	args := append([]string{"exec", "-i", id}, opts.Command...)
+	return c.executor.RunPiped(ctx, opts.Stdin, opts.Stdout, c.name, args...)
+}
+
+func (c *cliEngine) Run(ctx context.Context, opts RunOptions) error {
+	args := []string{"run", "--rm"}
+	if opts.Stdin != nil {
+		args = append(args, "-i")
+	}
+	for _, volume := range opts.Volumes {
+		args = append(args, "-v", volume)
+	}
+	args = append(append(args, opts.Image), opts.Command...)
+
+	return c.executor.RunPiped(ctx, opts.Stdin, opts.Stdout, c.name, args...)
+}
+
+func (c *cliEngine) Commit(id string, image string) error {
+	return c.executor.Run(c.name, "commit", id, image)
+}
+
+func (c *cliEngine) Prune(resource Resource) error {
+	return c.executor.Run(c.name, string(resource), "prune", "-f")
+}
+
+func psArgs(opts ListOptions) []string {
+	args := []string{"ps"}
+	if opts.All {
+		args = append(args, "-a")
+	}
+
+	return args
+}
+
+func filterArgs(opts ListOptions) []string {
+	var args []string
+	for _, filter := range opts.Filters {
+		args = append(args, "--filter", filter)
+	}
+
+	return args
+}
+
+// splitLines returns the non-empty lines of out, handling Windows line
+// endings.
+func splitLines(out string) []string {
+	var lines []string
+	for _, line := range strings.Split(strings.ReplaceAll(out, "\r\n", "\n"), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	return lines
+}
+
+// parseLabelList parses the "key=value,key=value" label list printed by the
+// Docker compatible `ps` templates.
+func parseLabelList(list string) map[string]string {
+	labels := map[string]string{}
+	if list == "" {
+		return labels
+	}
+
+	var key string
+	for _, part := range strings.Split(list, ",") {
+		if key == "" || labelKeyPattern.MatchString(part) {
+			var value string
+			key, value, _ = strings.Cut(part, "=")
+			labels[key] = value
+			continue
+		}
+
+		labels[key] += "," + part
+	}
+
+	return labels
+}
+
+func splitPorts(ports string) []string {
+	var result []string
+	for _, port := range strings.Split(ports, ",") {
+		if port = strings.TrimSpace(port); port != "" {
+			result = append(result, port)
+		}
+	}
+
+	return result
+}
+
+// stateFromStatus derives the state from engines that only print the human
+// readable status, like nerdctl.
+func stateFromStatus(status string) string {
+	switch {
+	case strings.HasPrefix(status, "Up") && strings.Contains(status, "(Paused)"):
+		return "paused"
+	case strings.HasPrefix(status, "Up"):
+		return "running"
+	case strings.HasPrefix(status, "Exited"):
+		return "exited"
+	case strings.HasPrefix(status, "Created"):
+		return "created"
+	case strings.HasPrefix(status, "Restarting"):
+		return "restarting"
+	case strings.HasPrefix(status, "Dead"):
+		return "dead"
+	}
+
+	return strings.ToLower(status)
+}
+
+// humanDuration prints d the way `docker ps` prints uptimes, e.g.
+// "5 minutes" or "About an hour".
+func humanDuration(d time.Duration) string {
+	seconds := int(d.Seconds())
+	minutes := int(d.Minutes())
+	hours := int(d.Round(time.Hour).Hours())
+
+	switch {
+	case seconds < 1:
+		return "Less than a second"
+	case seconds == 1:
+		return "1 second"
+	case seconds < 60:
+		return fmt.Sprintf("%d seconds", seconds)
+	case minutes == 1:
+		return "About a minute"
+	case minutes < 60:
+		return fmt.Sprintf("%d minutes", minutes)
+	case hours == 1:
+		return "About an hour"
+	case hours < 48:
+		return fmt.Sprintf("%d hours", hours)
+	case hours < 24*7*2:
+		return fmt.Sprintf("%d days", hours/24)
+	case hours < 24*30*2:
+		return fmt.Sprintf("%d weeks", hours/24/7)
+	case hours < 24*365*2:
+		return fmt.Sprintf("%d months", hours/24/30)
+	}
+
+	return fmt.Sprintf("%d years", hours/24/365)
+}
+
+// fillLabelFields sets the fields of container read from its labels, using
+// the first label found for the compose project and service.
+func fillLabelFields(container *Container, projectLabels, serviceLabels []string) {
+	container.LocalFolder = container.Labels[localFolderLabel]
+	container.ComposeProject = firstLabel(container.Labels, projectLabels)
+	container.ComposeService = firstLabel(container.Labels, serviceLabels)
+}
+
+func firstLabel(labels map[string]string, keys []string) string {
+	for _, key := range keys {
+		if value := labels[key]; value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
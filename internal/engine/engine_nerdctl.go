@@ -0,0 +1,11 @@
+package engine
+
+// nerdctlEngine accepts the Docker CLI flags and `ps` JSON, but doesn't print
+// the container state, which ListContainers derives from the status.
+type nerdctlEngine struct {
+	*cliEngine
+}
+
+func newNerdctlEngine(base *cliEngine) Engine {
+	return &nerdctlEngine{cliEngine: base}
+}
@@ -0,0 +1,278 @@
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	podmanComposeProjectLabel = "io.podman.compose.project"
+	podmanComposeServiceLabel = "io.podman.compose.service"
+)
+
+// podmanEngine accepts the Docker CLI flags, but its `ps` JSON is an array
+// with labels as a map and ports as objects. podman-compose
+// labels its services with its own project label and may group them in a
+// pod, which must be stopped or removed as a whole.
+type podmanEngine struct {
+	*cliEngine
+}
+
+func newPodmanEngine(base *cliEngine) Engine {
+	return &podmanEngine{cliEngine: base}
+}
+
+// ListContainers parses the JSON array printed by `podman ps --format json`.
+// Pod infra containers are left out, as they aren't part of the workspace.
+func (p *podmanEngine) ListContainers(opts ListOptions) ([]*Container, error) {
+	args := append(psArgs(opts), filterArgs(opts)...)
+	args = append(args, "--format", "json")
+
+	out, err := p.executor.Output(p.name, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(string(out)) == "" {
+		return nil, nil
+	}
+
+	var ps []struct {
+		ID        string            `json:"Id"`
+		Names     []string          `json:"Names"`
+		Image     string            `json:"Image"`
+		State     string            `json:"State"`
+		Status    string            `json:"Status"`
+		Created   int64             `json:"Created"`
+		StartedAt int64             `json:"StartedAt"`
+		ExitedAt  int64             `json:"ExitedAt"`
+		ExitCode  int               `json:"ExitCode"`
+		Labels    map[string]string `json:"Labels"`
+		IsInfra   bool              `json:"IsInfra"`
+		Ports     []struct {
+			HostIP        string `json:"host_ip"`
+			ContainerPort int    `json:"container_port"`
+			HostPort      int    `json:"host_port"`
+			Range         int    `json:"range"`
+			Protocol      string `json:"protocol"`
+		} `json:"Ports"`
+	}
+
+	if err := json.Unmarshal(out, &ps); err != nil {
+		return nil, fmt.Errorf("saída inválida do %s ps: %w", p.name, err)
+	}
+
+	var containers []*Container
+	for _, c := range ps {
+		if c.IsInfra {
+			continue
+		}
+
+		status := c.Status
+		if status == "" {
+			status = podmanStatus(c.State, c.StartedAt, c.ExitedAt, c.ExitCode)
+		}
+
+		var ports []string
+		for _, port := range c.Ports {
+			ports = append(ports, formatPodmanPort(port.HostIP, port.HostPort, port.ContainerPort, port.Range, port.Protocol))
+		}
+
+		labels := c.Labels
+		if labels == nil {
+			labels = map[string]string{}
+		}
+
+		container := &Container{
+			ID:      shortID(c.ID),
+			Names:   strings.Join(c.Names, ","),
+			Image:   c.Image,
+			State:   c.State,
+			Status:  status,
+			Created: time.Unix(c.Created, 0),
+			Ports:   ports,
+			Labels:  labels,
+		}
+		fillLabelFields(container, p.ComposeProjectLabels(), []string{podmanComposeServiceLabel, dockerComposeServiceLabel})
+
+		containers = append(containers, container)
+	}
+
+	return containers, nil
+}
+
+// ComposeProjectLabels prefers the podman-compose label, falling back to the
+// Docker one set by `podman compose` with docker-compose as provider.
+func (p *podmanEngine) ComposeProjectLabels() []string {
+	return []string{podmanComposeProjectLabel, dockerComposeProjectLabel}
+}
+
+func (p *podmanEngine) ContainerPods(ids ...string) (map[string]string, error) {
+	pods := map[string]string{}
+	if len(ids) == 0 {
+		return pods, nil
+	}
+
+	args := append([]string{"container", "inspect", "--format", "{{.Pod}}"}, ids...)
+	out, err := p.executor.Output(p.name, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Containers outside a pod print an empty line, so the lines can't go
+	// through splitLines.
+	lines := strings.Split(strings.TrimRight(strings.ReplaceAll(string(out), "\r\n", "\n"), "\n"), "\n")
+	if len(lines) != len(ids) {
+		return nil, fmt.Errorf("saída inesperada ao buscar os pods dos containers: %q", string(out))
+	}
+
+	for i, id := range ids {
+		if pod := strings.TrimSpace(lines[i]); pod != "" {
+			pods[id] = pod
+		}
+	}
+
+	return pods, nil
+}
+
+func (p *podmanEngine) StopPods(pods ...string) error {
+	return p.executor.Run(p.name, append([]string{"pod", "stop"}, pods...)...)
+}
+
+func (p *podmanEngine) RemovePods(pods ...string) error {
+	return p.executor.Run(p.name, append([]string{"pod", "rm", "-f"}, pods...)...)
+}
+
+// ListImages parses the JSON array printed by `podman images --format json`,
+// where the repository and tag are part of the image names.
+func (p *podmanEngine) ListImages() ([]*Image, error) {
+	out, err := p.executor.Output(p.name, "images", "--format", "json")
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(string(out)) == "" {
+		return nil, nil
+	}
+
+	var listed []struct {
+		ID    string   `json:"Id"`
+		Names []string `json:"Names"`
+		Size  uint64   `json:"Size"`
+	}
+
+	if err := json.Unmarshal(out, &listed); err != nil {
+		return nil, fmt.Errorf("saída inválida do %s images: %w", p.name, err)
+	}
+
+	var images []*Image
+	for _, image := range listed {
+		repository, tag := "<none>", "<none>"
+		if len(image.Names) > 0 {
+			repository, tag = splitImageName(image.Names[0])
+		}
+
+		images = append(images, &Image{
+			ID:         shortID(image.ID),
+			Repository: repository,
+			Tag:        tag,
+			Size:       image.Size,
+		})
+	}
+
+	return images, nil
+}
+
+// splitImageName splits "localhost/app:latest" into repository and tag. The
+// tag follows the last colon after the last slash, as a registry may have a
+// port.
+func splitImageName(name string) (string, string) {
+	i := strings.LastIndex(name, ":")
+	if i < 0 || i < strings.LastIndex(name, "/") {
+		return name, "latest"
+	}
+
+	return name[:i], name[i+1:]
+}
+
+func (p *podmanEngine) PruneBuildCache() (uint64, error) {
+	return 0, fmt.Errorf("o motor %s não possui cache de build para limpar", p.name)
+}
+
+// Stats parses the JSON array printed by `podman stats --format json`.
+func (p *podmanEngine) Stats(ids ...string) ([]*ContainerStats, error) {
+	args := append([]string{"stats", "--no-stream", "--format", "json"}, ids...)
+
+	out, err := p.executor.Output(p.name, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(string(out)) == "" {
+		return nil, nil
+	}
+
+	var samples []struct {
+		ID       string `json:"id"`
+		Name     string `json:"name"`
+		CPUPerc  string `json:"cpu_percent"`
+		MemUsage string `json:"mem_usage"`
+		MemPerc  string `json:"mem_percent"`
+		NetIO    string `json:"net_io"`
+		BlockIO  string `json:"block_io"`
+		PIDs     string `json:"pids"`
+	}
+
+	if err := json.Unmarshal(out, &samples); err != nil {
+		return nil, fmt.Errorf("saída inválida do %s stats: %w", p.name, err)
+	}
+
+	var stats []*ContainerStats
+	for _, sample := range samples {
+		stat, err := newContainerStats(sample.ID, sample.Name, sample.CPUPerc, sample.MemUsage, sample.MemPerc, sample.NetIO, sample.BlockIO, sample.PIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		stats = append(stats, stat)
+	}
+
+	return stats, nil
+}
+
+// podmanStatus builds the human readable status printed by `docker ps`,
+// which the podman JSON leaves empty.
+func podmanStatus(state string, startedAt, exitedAt int64, exitCode int) string {
+	switch {
+	case state == "running" && startedAt > 0:
+		return "Up " + humanDuration(time.Since(time.Unix(startedAt, 0)))
+	case state == "exited" && exitedAt > 0:
+		return fmt.Sprintf("Exited (%d) %s ago", exitCode, humanDuration(time.Since(time.Unix(exitedAt, 0))))
+	}
+
+	return state
+}
+
+// formatPodmanPort prints a podman port mapping the way `docker ps` does.
+func formatPodmanPort(hostIP string, hostPort, containerPort, portRange int, protocol string) string {
+	if hostIP == "" {
+		hostIP = "0.0.0.0"
+	}
+
+	if portRange > 1 {
+		return fmt.Sprintf("%s:%d-%d->%d-%d/%s", hostIP, hostPort, hostPort+portRange-1, containerPort, containerPort+portRange-1, protocol)
+	}
+
+	return fmt.Sprintf("%s:%d->%d/%s", hostIP, hostPort, containerPort, protocol)
+}
+
+// shortID truncates a container ID to the 12 characters printed by `ps`.
+func shortID(id string) string {
+	if len(id) > 12 {
+		return id[:12]
+	}
+
+	return id
+}
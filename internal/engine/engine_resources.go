@@ -0,0 +1,89 @@
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ListImages parses `image ls` printed one JSON object per line.
+func (c *cliEngine) ListImages() ([]*Image, error) {
+	out, err := c.executor.Output(c.name, "image", "ls", "--format", "{{json .}}")
+	if err != nil {
+		return nil, err
+	}
+
+	var images []*Image
+	for _, line := range splitLines(string(out)) {
+		var image struct {
+			ID         string `json:"ID"`
+			Repository string `json:"Repository"`
+			Tag        string `json:"Tag"`
+			Size       string `json:"Size"`
+		}
+
+		if err := json.Unmarshal([]byte(line), &image); err != nil {
+			return nil, fmt.Errorf("saída inválida do %s image ls: %w", c.name, err)
+		}
+
+		size, err := parseSize(image.Size)
+		if err != nil {
+			return nil, err
+		}
+
+		images = append(images, &Image{
+			ID:         image.ID,
+			Repository: image.Repository,
+			Tag:        image.Tag,
+			Size:       size,
+		})
+	}
+
+	return images, nil
+}
+
+func (c *cliEngine) RemoveImages(ids ...string) error {
+	return c.executor.Run(c.name, append([]string{"image", "rm"}, ids...)...)
+}
+
+func (c *cliEngine) ListNetworks(filters ...string) ([]string, error) {
+	args := []string{"network", "ls", "--format", "{{.Name}}"}
+	for _, filter := range filters {
+		args = append(args, "--filter", filter)
+	}
+
+	out, err := c.executor.Output(c.name, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return splitLines(string(out)), nil
+}
+
+func (c *cliEngine) RemoveNetworks(names ...string) error {
+	return c.executor.Run(c.name, append([]string{"network", "rm"}, names...)...)
+}
+
+// PruneBuildCache runs `builder prune`, reading the reclaimed space from its
+// "Total:" or "Total reclaimed space:" line.
+func (c *cliEngine) PruneBuildCache() (uint64, error) {
+	out, err := c.executor.Output(c.name, "builder", "prune", "-f")
+	if err != nil {
+		return 0, err
+	}
+
+	for _, line := range splitLines(string(out)) {
+		if !strings.HasPrefix(line, "Total") {
+			continue
+		}
+
+		_, value, found := strings.Cut(line, ":")
+		if !found {
+			continue
+		}
+
+		return parseSize(value)
+	}
+
+	return 0, nil
+}
@@ -0,0 +1,154 @@
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// sizeUnits maps the units printed by `stats`, `image ls` and `builder
+// prune`. Memory uses binary units (MiB) and I/O decimal ones (MB),
+// depending on the engine.
+var sizeUnits = map[string]float64{
+	"b":   1,
+	"kb":  1e3,
+	"mb":  1e6,
+	"gb":  1e9,
+	"tb":  1e12,
+	"kib": 1 << 10,
+	"mib": 1 << 20,
+	"gib": 1 << 30,
+	"tib": 1 << 40,
+}
+
+// Stats parses `stats --no-stream` printed one JSON object per line.
+func (c *cliEngine) Stats(ids ...string) ([]*ContainerStats, error) {
+	args := append([]string{"stats", "--no-stream", "--format", "{{json .}}"}, ids...)
+
+	out, err := c.executor.Output(c.name, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	var stats []*ContainerStats
+	for _, line := range splitLines(string(out)) {
+		var sample struct {
+			ID       string `json:"ID"`
+			Name     string `json:"Name"`
+			CPUPerc  string `json:"CPUPerc"`
+			MemUsage string `json:"MemUsage"`
+			MemPerc  string `json:"MemPerc"`
+			NetIO    string `json:"NetIO"`
+			BlockIO  string `json:"BlockIO"`
+			PIDs     string `json:"PIDs"`
+		}
+
+		if err := json.Unmarshal([]byte(line), &sample); err != nil {
+			return nil, fmt.Errorf("saída inválida do %s stats: %w", c.name, err)
+		}
+
+		stat, err := newContainerStats(sample.ID, sample.Name, sample.CPUPerc, sample.MemUsage, sample.MemPerc, sample.NetIO, sample.BlockIO, sample.PIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		stats = append(stats, stat)
+	}
+
+	return stats, nil
+}
+
+func newContainerStats(id, name, cpu, memUsage, memPerc, netIO, blockIO, pids string) (*ContainerStats, error) {
+	stat := &ContainerStats{
+		ID:   shortID(id),
+		Name: name,
+	}
+
+	var err error
+	if stat.CPUPercent, err = parsePercent(cpu); err != nil {
+		return nil, err
+	}
+	if stat.MemoryPercent, err = parsePercent(memPerc); err != nil {
+		return nil, err
+	}
+	if stat.MemoryUsage, stat.MemoryLimit, err = parseSizePair(memUsage); err != nil {
+		return nil, err
+	}
+	if stat.NetworkRx, stat.NetworkTx, err = parseSizePair(netIO); err != nil {
+		return nil, err
+	}
+	if stat.BlockRead, stat.BlockWrite, err = parseSizePair(blockIO); err != nil {
+		return nil, err
+	}
+
+	stat.PIDs, _ = strconv.Atoi(strings.TrimSpace(pids))
+
+	return stat, nil
+}
+
+// parsePercent parses values like "12.34%". Engines print "--" when the
+// value is unavailable, which counts as zero.
+func parsePercent(value string) (float64, error) {
+	value = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(value), "%"))
+	if value == "" || value == "--" {
+		return 0, nil
+	}
+
+	percent, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("porcentagem inválida no stats: %q", value)
+	}
+
+	return percent, nil
+}
+
+// parseSizePair parses values like "40MiB / 7.7GiB".
+func parseSizePair(value string) (uint64, uint64, error) {
+	first, second, _ := strings.Cut(value, "/")
+
+	a, err := parseSize(first)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	b, err := parseSize(second)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return a, b, nil
+}
+
+// parseSize parses values like "1.5kB" or "40MiB" into bytes.
+func parseSize(value string) (uint64, error) {
+	value = strings.TrimSpace(value)
+	if value == "" || value == "--" {
+		return 0, nil
+	}
+
+	i := strings.IndexFunc(value, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.'
+	})
+	if i < 0 {
+		i = len(value)
+	}
+
+	number, err := strconv.ParseFloat(value[:i], 64)
+	if err != nil {
+		return 0, fmt.Errorf("tamanho inválido: %q", value)
+	}
+
+	unit := strings.ToLower(strings.TrimSpace(value[i:]))
+	if unit == "" {
+		unit = "b"
+	}
+
+	multiplier, exists := sizeUnits[unit]
+	if !exists {
+		return 0, fmt.Errorf("unidade de tamanho desconhecida: %q", value)
+	}
+
+	return uint64(math.Round(number * multiplier)), nil
+}
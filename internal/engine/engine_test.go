@@ -0,0 +1,803 @@
+package engine
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Brennon-Oliveira/dev-cli/internal/exec"
+	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	logger.InitLogger()
+	exitCode := m.Run()
+	os.Exit(exitCode)
+}
+
+func newTestEngine(t *testing.T, name string, executor exec.Executor) Engine {
+	eng, err := NewEngine(name, WithExecutor(executor))
+	require.NoError(t, err)
+	return eng
+}
+
+// ============================================================================
+// Tests for NewEngine
+// ============================================================================
+
+func TestNewEngine_RegisteredEngines_UseTheirBinary(t *testing.T) {
+	for _, name := range Names() {
+		t.Run(name, func(t *testing.T) {
+			r := require.New(t)
+
+			executor := exec.NewMockExecutor(t)
+			executor.EXPECT().Run(name, []string{"stop", "abc"}).Return(nil)
+
+			eng := newTestEngine(t, name, executor)
+
+			r.Equal(name, eng.Name())
+			r.Nil(eng.Stop("abc"))
+		})
+	}
+}
+
+func TestNewEngine_UnknownEngine_ReturnsError(t *testing.T) {
+	r := require.New(t)
+
+	eng, err := NewEngine("lxc")
+
+	r.NotNil(err)
+	r.Nil(eng)
+}
+
+func TestNames_ReturnsSortedEngines(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal([]string{"docker", "nerdctl", "podman"}, Names())
+}
+
+// ============================================================================
+// Tests for List
+// ============================================================================
+
+func TestList_BuildsArgsAndSplitsIDs(t *testing.T) {
+	r := require.New(t)
+
+	executor := exec.NewMockExecutor(t)
+	executor.EXPECT().Output("docker", []string{"ps", "-a", "-q", "--filter", "label=a=b", "--filter", "name=x"}).Return([]byte("abc\r\ndef\r\n\n"), nil)
+
+	ids, err := newTestEngine(t, "docker", executor).List(ListOptions{All: true, Filters: []string{"label=a=b", "name=x"}})
+
+	r.Nil(err)
+	r.Equal([]string{"abc", "def"}, ids)
+}
+
+func TestList_NoContainers_ReturnsEmpty(t *testing.T) {
+	r := require.New(t)
+
+	executor := exec.NewMockExecutor(t)
+	executor.EXPECT().Output("podman", []string{"ps", "-q"}).Return([]byte(""), nil)
+
+	ids, err := newTestEngine(t, "podman", executor).List(ListOptions{})
+
+	r.Nil(err)
+	r.Empty(ids)
+}
+
+func TestList_ExecutorError_ReturnsError(t *testing.T) {
+	r := require.New(t)
+
+	executor := exec.NewMockExecutor(t)
+	executor.EXPECT().Output("docker", []string{"ps", "-q"}).Return(nil, fmt.Errorf("daemon down"))
+
+	_, err := newTestEngine(t, "docker", executor).List(ListOptions{})
+
+	r.ErrorContains(err, "daemon down")
+}
+
+// ============================================================================
+// Tests for ListContainers
+// ============================================================================
+
+func TestListContainers_Docker_ParsesJSONLines(t *testing.T) {
+	r := require.New(t)
+
+	output := `{"ID":"abc","Names":"my app-1","Image":"node:20","State":"running","Status":"Up 5 minutes","CreatedAt":"2024-01-15 10:30:00 +0000 UTC","Ports":"0.0.0.0:3000->3000/tcp, :::3000->3000/tcp","Labels":"com.docker.compose.project=app,com.docker.compose.service=web,devcontainer.local_folder=C:\\Users\\me\\my app"}` + "\r\n" +
+		`{"ID":"def","Names":"db","Image":"postgres","State":"exited","Status":"Exited (0) 2 hours ago","CreatedAt":"","Ports":"","Labels":""}` + "\n"
+
+	executor := exec.NewMockExecutor(t)
+	executor.EXPECT().Output("docker", []string{"ps", "-a", "--filter", "name=devcontainer", "--format", "{{json .}}"}).Return([]byte(output), nil)
+
+	containers, err := newTestEngine(t, "docker", executor).ListContainers(ListOptions{All: true, Filters: []string{"name=devcontainer"}})
+
+	r.Nil(err)
+	r.Len(containers, 2)
+	r.Equal(&Container{
+		ID:      "abc",
+		Names:   "my app-1",
+		Image:   "node:20",
+		State:   "running",
+		Status:  "Up 5 minutes",
+		Created: time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC),
+		Ports:   []string{"0.0.0.0:3000->3000/tcp", ":::3000->3000/tcp"},
+		Labels: map[string]string{
+			"com.docker.compose.project": "app",
+			"com.docker.compose.service": "web",
+			"devcontainer.local_folder":  `C:\Users\me\my app`,
+		},
+		LocalFolder:    `C:\Users\me\my app`,
+		ComposeProject: "app",
+		ComposeService: "web",
+	}, containers[0])
+	r.Equal("exited", containers[1].State)
+	r.Empty(containers[1].Labels)
+	r.True(containers[1].Created.IsZero())
+}
+
+func TestListContainers_Nerdctl_DerivesStateFromStatus(t *testing.T) {
+	r := require.New(t)
+
+	output := `{"ID":"abc","Names":"app","Status":"Up","Labels":"devcontainer.local_folder=/home/user/app"}` + "\n" +
+		`{"ID":"def","Names":"db","Status":"Exited (1) 3 minutes ago","Labels":""}` + "\n"
+
+	executor := exec.NewMockExecutor(t)
+	executor.EXPECT().Output("nerdctl", []string{"ps", "--format", "{{json .}}"}).Return([]byte(output), nil)
+
+	containers, err := newTestEngine(t, "nerdctl", executor).ListContainers(ListOptions{})
+
+	r.Nil(err)
+	r.Len(containers, 2)
+	r.Equal("running", containers[0].State)
+	r.Equal("/home/user/app", containers[0].LocalFolder)
+	r.Equal("exited", containers[1].State)
+}
+
+func TestListContainers_Podman_ParsesJSONArray(t *testing.T) {
+	r := require.New(t)
+
+	output := `[
+		{"Id":"0123456789abcdef","Names":["app_web_1"],"Image":"node:20","State":"running","Status":"","Created":1705314600,
+		 "Labels":{"io.podman.compose.project":"app","io.podman.compose.service":"web","devcontainer.local_folder":"/home/user/app"},
+		 "Ports":[{"host_ip":"","container_port":3000,"host_port":3000,"range":1,"protocol":"tcp"},{"host_ip":"127.0.0.1","container_port":8000,"host_port":9000,"range":2,"protocol":"udp"}]},
+		{"Id":"fedcba9876543210","Names":["abc-infra"],"State":"running","IsInfra":true}
+	]`
+
+	executor := exec.NewMockExecutor(t)
+	executor.EXPECT().Output("podman", []string{"ps", "--format", "json"}).Return([]byte(output), nil)
+
+	containers, err := newTestEngine(t, "podman", executor).ListContainers(ListOptions{})
+
+	r.Nil(err)
+	r.Len(containers, 1)
+	r.Equal("0123456789ab", containers[0].ID)
+	r.Equal("app_web_1", containers[0].Names)
+	r.Equal("running", containers[0].Status)
+	r.Equal(time.Unix(1705314600, 0), containers[0].Created)
+	r.Equal([]string{"0.0.0.0:3000->3000/tcp", "127.0.0.1:9000-9001->8000-8001/udp"}, containers[0].Ports)
+	r.Equal("/home/user/app", containers[0].LocalFolder)
+	r.Equal("app", containers[0].ComposeProject)
+	r.Equal("web", containers[0].ComposeService)
+}
+
+func TestListContainers_Podman_EmptyOutput_ReturnsEmpty(t *testing.T) {
+	r := require.New(t)
+
+	executor := exec.NewMockExecutor(t)
+	executor.EXPECT().Output("podman", []string{"ps", "--format", "json"}).Return([]byte("\n"), nil)
+
+	containers, err := newTestEngine(t, "podman", executor).ListContainers(ListOptions{})
+
+	r.Nil(err)
+	r.Empty(containers)
+}
+
+func TestListContainers_InvalidOutput_ReturnsError(t *testing.T) {
+	for _, name := range Names() {
+		t.Run(name, func(t *testing.T) {
+			r := require.New(t)
+
+			executor := exec.NewMockExecutor(t)
+			executor.EXPECT().Output(name, mock.Anything).Return([]byte("CONTAINER ID   NAMES"), nil)
+
+			_, err := newTestEngine(t, name, executor).ListContainers(ListOptions{})
+
+			r.NotNil(err)
+		})
+	}
+}
+
+func TestParseLabelList_ValuesWithCommas_KeptTogether(t *testing.T) {
+	r := require.New(t)
+
+	labels := parseLabelList(`devcontainer.metadata=[{"id":"a"},{"remoteUser":"node"}],devcontainer.local_folder=/home/user/app`)
+
+	r.Equal(map[string]string{
+		"devcontainer.metadata":     `[{"id":"a"},{"remoteUser":"node"}]`,
+		"devcontainer.local_folder": "/home/user/app",
+	}, labels)
+}
+
+// ============================================================================
+// Tests for Inspect
+// ============================================================================
+
+func TestInspect_ParsesDetails(t *testing.T) {
+	r := require.New(t)
+
+	output := `[{"Id":"abc123","Name":"/app-1","Config":{"Image":"node:20","Labels":{"com.docker.compose.project":"app"}},"State":{"Status":"running"}}]`
+
+	executor := exec.NewMockExecutor(t)
+	executor.EXPECT().Output("docker", []string{"inspect", "abc123"}).Return([]byte(output), nil)
+
+	details, err := newTestEngine(t, "docker", executor).Inspect("abc123")
+
+	r.Nil(err)
+	r.Equal(&ContainerDetails{
+		ID:     "abc123",
+		Name:   "app-1",
+		Image:  "node:20",
+		State:  "running",
+		Labels: map[string]string{"com.docker.compose.project": "app"},
+	}, details)
+}
+
+func TestInspect_ReadsExitCode(t *testing.T) {
+	r := require.New(t)
+
+	output := `[{"Id":"abc123","Name":"/app-migrate-1","Config":{},"State":{"Status":"exited","ExitCode":3}}]`
+
+	executor := exec.NewMockExecutor(t)
+	executor.EXPECT().Output("docker", []string{"inspect", "abc123"}).Return([]byte(output), nil)
+
+	details, err := newTestEngine(t, "docker", executor).Inspect("abc123")
+
+	r.Nil(err)
+	r.Equal("exited", details.State)
+	r.Equal(3, details.ExitCode)
+}
+
+func TestInspect_ListsNamedVolumes(t *testing.T) {
+	r := require.New(t)
+
+	output := `[{"Id":"abc123","Name":"/app-1","Config":{},"State":{"Status":"exited"},"Mounts":[` +
+		`{"Type":"bind","Source":"/home/user/app"},` +
+		`{"Type":"volume","Name":"app_node_modules"},` +
+		`{"Type":"volume","Name":"app_pgdata"}]}]`
+
+	executor := exec.NewMockExecutor(t)
+	executor.EXPECT().Output("podman", []string{"inspect", "abc123"}).Return([]byte(output), nil)
+
+	details, err := newTestEngine(t, "podman", executor).Inspect("abc123")
+
+	r.Nil(err)
+	r.Equal([]string{"app_node_modules", "app_pgdata"}, details.Volumes)
+}
+
+func TestInspect_ReadsHealthStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		state    string
+		expected string
+	}{
+		{name: "without healthcheck", state: `{"Status":"running"}`, expected: ""},
+		{name: "docker", state: `{"Status":"running","Health":{"Status":"healthy"}}`, expected: "healthy"},
+		{name: "legacy podman", state: `{"Status":"running","Healthcheck":{"Status":"starting"}}`, expected: "starting"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			output := `[{"Id":"abc123","Name":"/app-1","Config":{},"State":` + tt.state + `}]`
+
+			executor := exec.NewMockExecutor(t)
+			executor.EXPECT().Output("docker", []string{"inspect", "abc123"}).Return([]byte(output), nil)
+
+			details, err := newTestEngine(t, "docker", executor).Inspect("abc123")
+
+			r.Nil(err)
+			r.Equal(tt.expected, details.Health)
+		})
+	}
+}
+
+func TestInspect_InvalidOutput_ReturnsError(t *testing.T) {
+	tests := []struct {
+		name   string
+		output string
+	}{
+		{name: "not json", output: "Error: no such object"},
+		{name: "empty list", output: "[]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			executor := exec.NewMockExecutor(t)
+			executor.EXPECT().Output("docker", []string{"inspect", "abc123"}).Return([]byte(tt.output), nil)
+
+			_, err := newTestEngine(t, "docker", executor).Inspect("abc123")
+
+			r.NotNil(err)
+		})
+	}
+}
+
+// ============================================================================
+// Tests for container operations
+// ============================================================================
+
+func TestOperations_BuildDockerCompatibleArgs(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected []string
+		call     func(eng Engine) error
+	}{
+		{
+			name:     "stop",
+			expected: []string{"stop", "a", "b"},
+			call:     func(eng Engine) error { return eng.Stop("a", "b") },
+		},
+		{
+			name:     "start",
+			expected: []string{"start", "a"},
+			call:     func(eng Engine) error { return eng.Start("a") },
+		},
+		{
+			name:     "remove",
+			expected: []string{"rm", "-f", "a", "b"},
+			call:     func(eng Engine) error { return eng.Remove("a", "b") },
+		},
+		{
+			name:     "logs",
+			expected: []string{"logs", "a"},
+			call:     func(eng Engine) error { return eng.Logs("a", LogsOptions{}) },
+		},
+		{
+			name:     "logs with follow and tail",
+			expected: []string{"logs", "-f", "--tail", "50", "a"},
+			call:     func(eng Engine) error { return eng.Logs("a", LogsOptions{Follow: true, Tail: 50}) },
+		},
+		{
+			name:     "logs with since and timestamps",
+			expected: []string{"logs", "--since", "10m", "--timestamps", "a"},
+			call:     func(eng Engine) error { return eng.Logs("a", LogsOptions{Since: "10m", Timestamps: true}) },
+		},
+		{
+			name:     "remove volumes",
+			expected: []string{"volume", "rm", "-f", "a", "b"},
+			call:     func(eng Engine) error { return eng.RemoveVolumes("a", "b") },
+		},
+		{
+			name:     "remove images",
+			expected: []string{"image", "rm", "a", "b"},
+			call:     func(eng Engine) error { return eng.RemoveImages("a", "b") },
+		},
+		{
+			name:     "commit",
+			expected: []string{"commit", "abc", "dev-snapshot-app:before-20260101-120000"},
+			call:     func(eng Engine) error { return eng.Commit("abc", "dev-snapshot-app:before-20260101-120000") },
+		},
+		{
+			name:     "remove networks",
+			expected: []string{"network", "rm", "app_default"},
+			call:     func(eng Engine) error { return eng.RemoveNetworks("app_default") },
+		},
+		{
+			name:     "prune containers",
+			expected: []string{"container", "prune", "-f"},
+			call:     func(eng Engine) error { return eng.Prune(ResourceContainer) },
+		},
+		{
+			name:     "prune networks",
+			expected: []string{"network", "prune", "-f"},
+			call:     func(eng Engine) error { return eng.Prune(ResourceNetwork) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			executor := exec.NewMockExecutor(t)
+			executor.EXPECT().Run("docker", tt.expected).Return(nil)
+
+			r.Nil(tt.call(newTestEngine(t, "docker", executor)))
+		})
+	}
+}
+
+func TestLogs_WithOutput_WritesStdoutAndStderr(t *testing.T) {
+	r := require.New(t)
+
+	var stdout, stderr bytes.Buffer
+	executor := exec.NewMockExecutor(t)
+	executor.EXPECT().RunWithStreams(&stdout, &stderr, "docker", []string{"logs", "-f", "a"}).Return(nil)
+
+	err := newTestEngine(t, "docker", executor).Logs("a", LogsOptions{Follow: true, Output: &stdout, ErrOutput: &stderr})
+
+	r.Nil(err)
+}
+
+func TestPorts_ReturnsOutput(t *testing.T) {
+	r := require.New(t)
+
+	executor := exec.NewMockExecutor(t)
+	executor.EXPECT().Output("podman", []string{"port", "abc"}).Return([]byte("3000/tcp -> 0.0.0.0:3000\n"), nil)
+
+	out, err := newTestEngine(t, "podman", executor).Ports("abc")
+
+	r.Nil(err)
+	r.Equal("3000/tcp -> 0.0.0.0:3000\n", out)
+}
+
+func TestExec_PipesCommandIntoContainer(t *testing.T) {
+	for _, name := range Names() {
+		t.Run(name, func(t *testing.T) {
+			r := require.New(t)
+
+			stdin := strings.NewReader("ping")
+			var stdout bytes.Buffer
+
+			executor := exec.NewMockExecutor(t)
+			executor.EXPECT().RunPiped(mock.Anything, stdin, &stdout, name, []string{"exec", "-i", "abc", "sh", "-c", "cat"}).Return(nil)
+
+			err := newTestEngine(t, name, executor).Exec(context.Background(), "abc", ExecOptions{
+				Command: []string{"sh", "-c", "cat"},
+				Stdin:   stdin,
+				Stdout:  &stdout,
+			})
+
+			r.Nil(err)
+		})
+	}
+}
+
+func TestRun_MountsVolumesInThrowawayContainer(t *testing.T) {
+	for _, name := range Names() {
+		t.Run(name, func(t *testing.T) {
+			r := require.New(t)
+
+			var stdout bytes.Buffer
+
+			executor := exec.NewMockExecutor(t)
+			executor.EXPECT().RunPiped(mock.Anything, nil, &stdout, name, []string{"run", "--rm", "-v", "data:/volume:ro", "busybox", "tar", "cf", "-", "-C", "/volume", "."}).Return(nil)
+
+			err := newTestEngine(t, name, executor).Run(context.Background(), RunOptions{
+				Image:   "busybox",
+				Volumes: []string{"data:/volume:ro"},
+				Command: []string{"tar", "cf", "-", "-C", "/volume", "."},
+				Stdout:  &stdout,
+			})
+
+			r.Nil(err)
+		})
+	}
+}
+
+func TestRun_WithStdin_AttachesIt(t *testing.T) {
+	r := require.New(t)
+
+	stdin := strings.NewReader("archive")
+
+	executor := exec.NewMockExecutor(t)
+	executor.EXPECT().RunPiped(mock.Anything, stdin, nil, "docker", []string{"run", "--rm", "-i", "-v", "data:/volume", "busybox", "tar", "xf", "-"}).Return(nil)
+
+	err := newTestEngine(t, "docker", executor).Run(context.Background(), RunOptions{
+		Image:   "busybox",
+		Volumes: []string{"data:/volume"},
+		Command: []string{"tar", "xf", "-"},
+		Stdin:   stdin,
+	})
+
+	r.Nil(err)
+}
+
+// ============================================================================
+// Tests for compose labels and pods
+// ============================================================================
+
+func TestComposeProjectLabels_PodmanPrefersPodmanCompose(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal([]string{"com.docker.compose.project"}, newTestEngine(t, "docker", exec.NewMockExecutor(t)).ComposeProjectLabels())
+	r.Equal([]string{"io.podman.compose.project", "com.docker.compose.project"}, newTestEngine(t, "podman", exec.NewMockExecutor(t)).ComposeProjectLabels())
+}
+
+func TestContainerPods_Podman_MapsContainersToPods(t *testing.T) {
+	r := require.New(t)
+
+	executor := exec.NewMockExecutor(t)
+	executor.EXPECT().Output("podman", []string{"container", "inspect", "--format", "{{.Pod}}", "a", "b", "c"}).Return([]byte("pod1\r\n\r\npod2\r\n"), nil)
+
+	pods, err := newTestEngine(t, "podman", executor).ContainerPods("a", "b", "c")
+
+	r.Nil(err)
+	r.Equal(map[string]string{"a": "pod1", "c": "pod2"}, pods)
+}
+
+func TestContainerPods_Podman_UnexpectedOutput_ReturnsError(t *testing.T) {
+	r := require.New(t)
+
+	executor := exec.NewMockExecutor(t)
+	executor.EXPECT().Output("podman", mock.Anything).Return([]byte("pod1\n"), nil)
+
+	_, err := newTestEngine(t, "podman", executor).ContainerPods("a", "b")
+
+	r.NotNil(err)
+}
+
+func TestContainerPods_Docker_ReturnsEmpty(t *testing.T) {
+	r := require.New(t)
+
+	pods, err := newTestEngine(t, "docker", exec.NewMockExecutor(t)).ContainerPods("a")
+
+	r.Nil(err)
+	r.Empty(pods)
+}
+
+func TestPodOperations_Podman_UsePodCommands(t *testing.T) {
+	r := require.New(t)
+
+	executor := exec.NewMockExecutor(t)
+	executor.EXPECT().Run("podman", []string{"pod", "stop", "pod1"}).Return(nil)
+	executor.EXPECT().Run("podman", []string{"pod", "rm", "-f", "pod1", "pod2"}).Return(nil)
+
+	eng := newTestEngine(t, "podman", executor)
+
+	r.Nil(eng.StopPods("pod1"))
+	r.Nil(eng.RemovePods("pod1", "pod2"))
+}
+
+func TestPodOperations_Docker_ReturnError(t *testing.T) {
+	r := require.New(t)
+
+	eng := newTestEngine(t, "docker", exec.NewMockExecutor(t))
+
+	r.NotNil(eng.StopPods("pod1"))
+	r.NotNil(eng.RemovePods("pod1"))
+}
+
+// ============================================================================
+// Tests for status and uptime
+// ============================================================================
+
+func TestHumanDuration(t *testing.T) {
+	tests := []struct {
+		duration time.Duration
+		expected string
+	}{
+		{duration: 0, expected: "Less than a second"},
+		{duration: time.Second, expected: "1 second"},
+		{duration: 45 * time.Second, expected: "45 seconds"},
+		{duration: 90 * time.Second, expected: "About a minute"},
+		{duration: 5 * time.Minute, expected: "5 minutes"},
+		{duration: 65 * time.Minute, expected: "About an hour"},
+		{duration: 5 * time.Hour, expected: "5 hours"},
+		{duration: 72 * time.Hour, expected: "3 days"},
+		{duration: 21 * 24 * time.Hour, expected: "3 weeks"},
+		{duration: 90 * 24 * time.Hour, expected: "3 months"},
+		{duration: 3 * 365 * 24 * time.Hour, expected: "3 years"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			require.Equal(t, tt.expected, humanDuration(tt.duration))
+		})
+	}
+}
+
+func TestListContainers_Podman_BuildsStatusFromTimestamps(t *testing.T) {
+	r := require.New(t)
+
+	startedAt := time.Now().Add(-5 * time.Minute).Unix()
+	exitedAt := time.Now().Add(-3 * time.Hour).Unix()
+	output := fmt.Sprintf(`[
+		{"Id":"app","Names":["app"],"State":"running","StartedAt":%d},
+		{"Id":"db","Names":["db"],"State":"exited","ExitedAt":%d,"ExitCode":137},
+		{"Id":"new","Names":["new"],"State":"created"}
+	]`, startedAt, exitedAt)
+
+	executor := exec.NewMockExecutor(t)
+	executor.EXPECT().Output("podman", []string{"ps", "-a", "--format", "json"}).Return([]byte(output), nil)
+
+	containers, err := newTestEngine(t, "podman", executor).ListContainers(ListOptions{All: true})
+
+	r.Nil(err)
+	r.Len(containers, 3)
+	r.Equal("Up 5 minutes", containers[0].Status)
+	r.Equal("Exited (137) 3 hours ago", containers[1].Status)
+	r.Equal("created", containers[2].Status)
+}
+
+// ============================================================================
+// Tests for Stats
+// ============================================================================
+
+func TestStats_Docker_ParsesJSONLines(t *testing.T) {
+	r := require.New(t)
+
+	output := `{"BlockIO":"1.5MB / 0B","CPUPerc":"12.50%","Container":"abc","ID":"0123456789abcdef","MemPerc":"2.00%","MemUsage":"512MiB / 7.5GiB","Name":"app_web_1","NetIO":"1.2kB / 648B","PIDs":"12"}
+{"BlockIO":"-- / --","CPUPerc":"--","Container":"def","ID":"def","MemPerc":"--","MemUsage":"-- / --","Name":"app_db_1","NetIO":"-- / --","PIDs":"--"}
+`
+
+	executor := exec.NewMockExecutor(t)
+	executor.EXPECT().Output("docker", []string{"stats", "--no-stream", "--format", "{{json .}}", "abc", "def"}).Return([]byte(output), nil)
+
+	stats, err := newTestEngine(t, "docker", executor).Stats("abc", "def")
+
+	r.Nil(err)
+	r.Len(stats, 2)
+	r.Equal(&ContainerStats{
+		ID:            "0123456789ab",
+		Name:          "app_web_1",
+		CPUPercent:    12.5,
+		MemoryUsage:   512 << 20,
+		MemoryLimit:   uint64(7.5 * (1 << 30)),
+		MemoryPercent: 2,
+		NetworkRx:     1200,
+		NetworkTx:     648,
+		BlockRead:     1500000,
+		PIDs:          12,
+	}, stats[0])
+	r.Equal(&ContainerStats{ID: "def", Name: "app_db_1"}, stats[1])
+}
+
+func TestStats_Podman_ParsesJSONArray(t *testing.T) {
+	r := require.New(t)
+
+	output := `[{"id":"0123456789ab","name":"app_web_1","cpu_percent":"3.10%","mem_usage":"40.96MB / 8.254GB","mem_percent":"0.50%","net_io":"10kB / 2kB","block_io":"0B / 4.1MB","pids":"5"}]`
+
+	executor := exec.NewMockExecutor(t)
+	executor.EXPECT().Output("podman", []string{"stats", "--no-stream", "--format", "json", "abc"}).Return([]byte(output), nil)
+
+	stats, err := newTestEngine(t, "podman", executor).Stats("abc")
+
+	r.Nil(err)
+	r.Len(stats, 1)
+	r.Equal("app_web_1", stats[0].Name)
+	r.Equal(3.1, stats[0].CPUPercent)
+	r.Equal(uint64(40960000), stats[0].MemoryUsage)
+	r.Equal(uint64(8254000000), stats[0].MemoryLimit)
+	r.Equal(uint64(10000), stats[0].NetworkRx)
+	r.Equal(uint64(4100000), stats[0].BlockWrite)
+	r.Equal(5, stats[0].PIDs)
+}
+
+func TestStats_InvalidOutput_ReturnsError(t *testing.T) {
+	for _, name := range Names() {
+		t.Run(name, func(t *testing.T) {
+			r := require.New(t)
+
+			executor := exec.NewMockExecutor(t)
+			executor.EXPECT().Output(name, mock.Anything).Return([]byte("CONTAINER ID   NAME"), nil)
+
+			_, err := newTestEngine(t, name, executor).Stats("abc")
+
+			r.NotNil(err)
+		})
+	}
+}
+
+func TestParseSize(t *testing.T) {
+	cases := map[string]uint64{
+		"0B":      0,
+		"648B":    648,
+		"1.2kB":   1200,
+		"1KiB":    1024,
+		"40MiB":   40 << 20,
+		"2GB":     2000000000,
+		" 1GiB ":  1 << 30,
+		"--":      0,
+		"":        0,
+		"3.5 MiB": uint64(3.5 * (1 << 20)),
+	}
+
+	for value, expected := range cases {
+		t.Run(value, func(t *testing.T) {
+			r := require.New(t)
+
+			size, err := parseSize(value)
+
+			r.Nil(err)
+			r.Equal(expected, size)
+		})
+	}
+}
+
+func TestParseSize_UnknownUnit_ReturnsError(t *testing.T) {
+	r := require.New(t)
+
+	_, err := parseSize("10XB")
+
+	r.NotNil(err)
+}
+
+// ============================================================================
+// Tests for images, networks and build cache
+// ============================================================================
+
+func TestListImages_ParsesJSONLines(t *testing.T) {
+	r := require.New(t)
+
+	output := `{"ID":"1a2b3c4d5e6f","Repository":"vsc-app-3f2a-uid","Tag":"latest","Size":"1.2GB"}` + "\n" +
+		`{"ID":"6f5e4d3c2b1a","Repository":"<none>","Tag":"<none>","Size":"512MB"}` + "\n"
+
+	executor := exec.NewMockExecutor(t)
+	executor.EXPECT().Output("docker", []string{"image", "ls", "--format", "{{json .}}"}).Return([]byte(output), nil)
+
+	images, err := newTestEngine(t, "docker", executor).ListImages()
+
+	r.Nil(err)
+	r.Equal([]*Image{
+		{ID: "1a2b3c4d5e6f", Repository: "vsc-app-3f2a-uid", Tag: "latest", Size: 1200000000},
+		{ID: "6f5e4d3c2b1a", Repository: "<none>", Tag: "<none>", Size: 512000000},
+	}, images)
+}
+
+func TestListImages_Podman_SplitsImageNames(t *testing.T) {
+	r := require.New(t)
+
+	output := `[{"Id":"1a2b3c4d5e6f7a8b","Names":["localhost:5000/vsc-app-3f2a:latest"],"Size":1200},{"Id":"6f5e4d3c2b1a","Names":null,"Size":10}]`
+
+	executor := exec.NewMockExecutor(t)
+	executor.EXPECT().Output("podman", []string{"images", "--format", "json"}).Return([]byte(output), nil)
+
+	images, err := newTestEngine(t, "podman", executor).ListImages()
+
+	r.Nil(err)
+	r.Equal([]*Image{
+		{ID: "1a2b3c4d5e6f", Repository: "localhost:5000/vsc-app-3f2a", Tag: "latest", Size: 1200},
+		{ID: "6f5e4d3c2b1a", Repository: "<none>", Tag: "<none>", Size: 10},
+	}, images)
+}
+
+func TestListNetworks_PassesFilters(t *testing.T) {
+	r := require.New(t)
+
+	executor := exec.NewMockExecutor(t)
+	executor.EXPECT().Output("docker", []string{"network", "ls", "--format", "{{.Name}}", "--filter", "label=com.docker.compose.project=app"}).Return([]byte("app_default\n"), nil)
+
+	networks, err := newTestEngine(t, "docker", executor).ListNetworks("label=com.docker.compose.project=app")
+
+	r.Nil(err)
+	r.Equal([]string{"app_default"}, networks)
+}
+
+func TestPruneBuildCache_ParsesReclaimedSpace(t *testing.T) {
+	cases := map[string]uint64{
+		"ID\tRECLAIMABLE\nabc\t1.2GB\nTotal:\t1.5GB\n":                        1500000000,
+		"Deleted build cache objects:\nabc\n\nTotal reclaimed space: 300MB\n": 300000000,
+		"Total:\t0B\n": 0,
+		"":             0,
+	}
+
+	for output, expected := range cases {
+		t.Run(output, func(t *testing.T) {
+			r := require.New(t)
+
+			executor := exec.NewMockExecutor(t)
+			executor.EXPECT().Output("docker", []string{"builder", "prune", "-f"}).Return([]byte(output), nil)
+
+			reclaimed, err := newTestEngine(t, "docker", executor).PruneBuildCache()
+
+			r.Nil(err)
+			r.Equal(expected, reclaimed)
+		})
+	}
+}
+
+func TestPruneBuildCache_Podman_ReturnsError(t *testing.T) {
+	r := require.New(t)
+
+	_, err := newTestEngine(t, "podman", exec.NewMockExecutor(t)).PruneBuildCache()
+
+	r.NotNil(err)
+}
@@ -1,10 +1,20 @@
 package exec
 
-import "io"
+import (
+	"context"
+	"io"
+)
 
 type Executor interface {
 	Run(name string, args ...string) error
 	RunWithOutput(output io.Writer, name string, args ...string) error
+	// RunWithStreams writes the stdout and stderr of the command only to the
+	// given writers. A nil writer discards the stream.
+	RunWithStreams(stdout io.Writer, stderr io.Writer, name string, args ...string) error
+	// RunPiped connects the command to stdin and stdout, killing it when ctx
+	// is done. It returns when the command exits, without waiting for stdin
+	// to end.
+	RunPiped(ctx context.Context, stdin io.Reader, stdout io.Writer, name string, args ...string) error
 	RunInteractive(name string, args ...string) error
 	RunDetached(name string, args ...string) error
 	Output(name string, args ...string) ([]byte, error)
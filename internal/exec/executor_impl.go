@@ -1,6 +1,9 @@
 package exec
 
 import (
+	"bytes"
+	"context"
+	"fmt"
 	"io"
 	"os"
 	"os/exec"
@@ -32,6 +35,51 @@ func (e *realExecutor) RunWithOutput(output io.Writer, name string, args ...stri
 	return cmd.Run()
 }
 
+func (e *realExecutor) RunWithStreams(stdout io.Writer, stderr io.Writer, name string, args ...string) error {
+	cmd := exec.Command(name, args...)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	logger.Verbose("Rodando: %s", strings.Join(append([]string{name}, args...), " "))
+	return cmd.Run()
+}
+
+func (e *realExecutor) RunPiped(ctx context.Context, stdin io.Reader, stdout io.Writer, name string, args ...string) error {
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Stdout = stdout
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	// Copied by hand: with cmd.Stdin, Wait would block until stdin ends even
+	// after the command exits.
+	pipe, err := cmd.StdinPipe()
+	if err != nil {
+		return err
+	}
+
+	logger.Verbose("Rodando: %s", strings.Join(append([]string{name}, args...), " "))
+
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	go func() {
+		if stdin != nil {
+			io.Copy(pipe, stdin)
+		}
+		pipe.Close()
+	}()
+
+	if err := cmd.Wait(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return fmt.Errorf("%w: %s", err, msg)
+		}
+		return err
+	}
+
+	return nil
+}
+
 // CombinedOutput implements [Executor].
 func (e *realExecutor) CombinedOutput(name string, args ...string) (string, error) {
 	panic("unimplemented")
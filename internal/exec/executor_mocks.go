@@ -5,6 +5,7 @@
 package exec
 
 import (
+	"context"
 	"io"
 
 	mock "github.com/stretchr/testify/mock"
@@ -387,6 +388,90 @@ func (_c *MockExecutor_RunInteractive_Call) RunAndReturn(run func(name string, a
 	return _c
 }
 
+// RunPiped provides a mock function for the type MockExecutor
+func (_mock *MockExecutor) RunPiped(ctx context.Context, stdin io.Reader, stdout io.Writer, name string, args ...string) error {
+	var tmpRet mock.Arguments
+	if len(args) > 0 {
+		tmpRet = _mock.Called(ctx, stdin, stdout, name, args)
+	} else {
+		tmpRet = _mock.Called(ctx, stdin, stdout, name)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunPiped")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, io.Reader, io.Writer, string, ...string) error); ok {
+		r0 = returnFunc(ctx, stdin, stdout, name, args...)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockExecutor_RunPiped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunPiped'
+type MockExecutor_RunPiped_Call struct {
+	*mock.Call
+}
+
+// RunPiped is a helper method to define mock.On call
+//   - ctx context.Context
+//   - stdin io.Reader
+//   - stdout io.Writer
+//   - name string
+//   - args ...string
+func (_e *MockExecutor_Expecter) RunPiped(ctx interface{}, stdin interface{}, stdout interface{}, name interface{}, args ...interface{}) *MockExecutor_RunPiped_Call {
+	return &MockExecutor_RunPiped_Call{Call: _e.mock.On("RunPiped",
+		append([]interface{}{ctx, stdin, stdout, name}, args...)...)}
+}
+
+func (_c *MockExecutor_RunPiped_Call) Run(run func(ctx context.Context, stdin io.Reader, stdout io.Writer, name string, args ...string)) *MockExecutor_RunPiped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 io.Reader
+		if args[1] != nil {
+			arg1 = args[1].(io.Reader)
+		}
+		var arg2 io.Writer
+		if args[2] != nil {
+			arg2 = args[2].(io.Writer)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 []string
+		var variadicArgs []string
+		if len(args) > 4 {
+			variadicArgs = args[4].([]string)
+		}
+		arg4 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExecutor_RunPiped_Call) Return(err error) *MockExecutor_RunPiped_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockExecutor_RunPiped_Call) RunAndReturn(run func(ctx context.Context, stdin io.Reader, stdout io.Writer, name string, args ...string) error) *MockExecutor_RunPiped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RunWithOutput provides a mock function for the type MockExecutor
 func (_mock *MockExecutor) RunWithOutput(output io.Writer, name string, args ...string) error {
 	var tmpRet mock.Arguments
@@ -458,3 +543,81 @@ func (_c *MockExecutor_RunWithOutput_Call) RunAndReturn(run func(output io.Write
 	_c.Call.Return(run)
 	return _c
 }
+
+// RunWithStreams provides a mock function for the type MockExecutor
+func (_mock *MockExecutor) RunWithStreams(stdout io.Writer, stderr io.Writer, name string, args ...string) error {
+	var tmpRet mock.Arguments
+	if len(args) > 0 {
+		tmpRet = _mock.Called(stdout, stderr, name, args)
+	} else {
+		tmpRet = _mock.Called(stdout, stderr, name)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunWithStreams")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(io.Writer, io.Writer, string, ...string) error); ok {
+		r0 = returnFunc(stdout, stderr, name, args...)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockExecutor_RunWithStreams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunWithStreams'
+type MockExecutor_RunWithStreams_Call struct {
+	*mock.Call
+}
+
+// RunWithStreams is a helper method to define mock.On call
+//   - stdout io.Writer
+//   - stderr io.Writer
+//   - name string
+//   - args ...string
+func (_e *MockExecutor_Expecter) RunWithStreams(stdout interface{}, stderr interface{}, name interface{}, args ...interface{}) *MockExecutor_RunWithStreams_Call {
+	return &MockExecutor_RunWithStreams_Call{Call: _e.mock.On("RunWithStreams",
+		append([]interface{}{stdout, stderr, name}, args...)...)}
+}
+
+func (_c *MockExecutor_RunWithStreams_Call) Run(run func(stdout io.Writer, stderr io.Writer, name string, args ...string)) *MockExecutor_RunWithStreams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 io.Writer
+		if args[0] != nil {
+			arg0 = args[0].(io.Writer)
+		}
+		var arg1 io.Writer
+		if args[1] != nil {
+			arg1 = args[1].(io.Writer)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 []string
+		var variadicArgs []string
+		if len(args) > 3 {
+			variadicArgs = args[3].([]string)
+		}
+		arg3 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExecutor_RunWithStreams_Call) Return(err error) *MockExecutor_RunWithStreams_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockExecutor_RunWithStreams_Call) RunAndReturn(run func(stdout io.Writer, stderr io.Writer, name string, args ...string) error) *MockExecutor_RunWithStreams_Call {
+	_c.Call.Return(run)
+	return _c
+}
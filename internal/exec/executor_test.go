@@ -2,8 +2,12 @@ package exec
 
 import (
 	"bytes"
+	"context"
+	"io"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
 	"github.com/stretchr/testify/assert"
@@ -232,6 +236,34 @@ func TestRunWithOutput_CustomOutputOnly(t *testing.T) {
 	assert.Contains(t, customContent, testString)
 }
 
+// ============ RunWithStreams Tests ============
+
+func TestRunWithStreams_SplitsStdoutAndStderr(t *testing.T) {
+	r := require.New(t)
+	executorStdout := new(bytes.Buffer)
+	stdout := new(bytes.Buffer)
+	stderr := new(bytes.Buffer)
+
+	executor := NewExecutor(WithStdout(executorStdout))
+
+	err := executor.RunWithStreams(stdout, stderr, "sh", "-c", "echo out; echo err >&2")
+
+	r.Nil(err)
+	r.Equal("out\n", stdout.String())
+	r.Equal("err\n", stderr.String())
+	r.Empty(executorStdout.String())
+}
+
+func TestRunWithStreams_NilStderr_Discards(t *testing.T) {
+	r := require.New(t)
+	stdout := new(bytes.Buffer)
+
+	err := NewExecutor().RunWithStreams(stdout, nil, "sh", "-c", "echo out; echo err >&2")
+
+	r.Nil(err)
+	r.Equal("out\n", stdout.String())
+}
+
 // ============ Output Tests ============
 
 func TestOutput_SuccessWithSimpleCommand(t *testing.T) {
@@ -532,3 +564,59 @@ func TestRunInteractive_DirectsToOsStreams(t *testing.T) {
 	// Output should NOT be in custom stdout because RunInteractive uses os.Stdout
 	assert.Empty(t, customStdout.String())
 }
+
+// ============ RunPiped Tests ============
+
+func TestRunPiped_ConnectsStdinAndStdout(t *testing.T) {
+	r := require.New(t)
+	stdout := new(bytes.Buffer)
+
+	executor := NewExecutor()
+
+	err := executor.RunPiped(context.Background(), strings.NewReader("ping"), stdout, "cat")
+	r.Nil(err)
+	r.Equal("ping", stdout.String())
+}
+
+func TestRunPiped_ReturnsWithoutWaitingForStdin(t *testing.T) {
+	r := require.New(t)
+
+	stdin, _ := io.Pipe()
+	executor := NewExecutor()
+
+	err := executor.RunPiped(context.Background(), stdin, io.Discard, "true")
+	r.Nil(err)
+}
+
+func TestRunPiped_NilStdin_ClosesInput(t *testing.T) {
+	r := require.New(t)
+	stdout := new(bytes.Buffer)
+
+	executor := NewExecutor()
+
+	err := executor.RunPiped(context.Background(), nil, stdout, "cat")
+	r.Nil(err)
+	r.Empty(stdout.String())
+}
+
+func TestRunPiped_IncludesStderrInError(t *testing.T) {
+	r := require.New(t)
+
+	executor := NewExecutor()
+
+	err := executor.RunPiped(context.Background(), strings.NewReader(""), io.Discard, "sh", "-c", "echo falhou >&2; exit 1")
+	r.ErrorContains(err, "falhou")
+}
+
+func TestRunPiped_KillsCommandWhenContextIsDone(t *testing.T) {
+	r := require.New(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	executor := NewExecutor()
+
+	err := executor.RunPiped(ctx, strings.NewReader(""), io.Discard, "sleep", "10")
+	r.NotNil(err)
+	r.ErrorIs(ctx.Err(), context.DeadlineExceeded)
+}
@@ -0,0 +1,27 @@
+package output
+
+import "io"
+
+// Format is the output format selected by `--output`.
+type Format string
+
+const (
+	FormatTable    Format = "table"
+	FormatJSON     Format = "json"
+	FormatYAML     Format = "yaml"
+	FormatTemplate Format = "template"
+)
+
+// Renderer writes command data to stdout in the selected format. Log
+// messages never go through it, so machine readable output stays parseable.
+type Renderer interface {
+	Format() Format
+	// Render writes data in the selected format. Slices are rendered one
+	// template execution per item, like `docker ps --format`. table is only
+	// called by the table format.
+	Render(data any, table func() string) error
+	// LineWriter returns a writer for streamed output, e.g. logs. The table
+	// format writes the stream as is; the others render each line as the
+	// record built by record, JSON as one object per line.
+	LineWriter(record func(line string) any) io.WriteCloser
+}
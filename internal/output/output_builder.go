@@ -0,0 +1,51 @@
+package output
+
+import (
+	"io"
+	"os"
+)
+
+type realRenderer struct {
+	format   Format
+	template string
+	writer   io.Writer
+}
+
+type Option func(*realRenderer)
+
+// NewRenderer returns a renderer for the format selected with
+// SetFormatOverride, table by default, writing to stdout.
+func NewRenderer(opts ...Option) *realRenderer {
+	format, template := GetFormatOverride()
+
+	r := &realRenderer{
+		format:   format,
+		template: template,
+		writer:   os.Stdout,
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+func WithFormat(format Format) Option {
+	return func(r *realRenderer) {
+		r.format = format
+	}
+}
+
+// WithTemplate sets the Go template used by FormatTemplate.
+func WithTemplate(template string) Option {
+	return func(r *realRenderer) {
+		r.template = template
+	}
+}
+
+func WithWriter(w io.Writer) Option {
+	return func(r *realRenderer) {
+		r.writer = w
+	}
+}
@@ -0,0 +1,179 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/template"
+
+	"gopkg.in/yaml.v3"
+)
+
+var templateFuncs = template.FuncMap{
+	"json": func(v any) (string, error) {
+		data, err := json.Marshal(v)
+		return string(data), err
+	},
+	"join":  strings.Join,
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+}
+
+func (r *realRenderer) Format() Format {
+	return r.format
+}
+
+func (r *realRenderer) Render(data any, table func() string) error {
+	switch r.format {
+	case FormatJSON:
+		encoder := json.NewEncoder(r.writer)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(normalize(data))
+	case FormatYAML:
+		encoder := yaml.NewEncoder(r.writer)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(normalize(data)); err != nil {
+			return err
+		}
+		return encoder.Close()
+	case FormatTemplate:
+		tmpl, err := r.parseTemplate()
+		if err != nil {
+			return err
+		}
+		return executeTemplate(r.writer, tmpl, data)
+	}
+
+	out := table()
+	if !strings.HasSuffix(out, "\n") {
+		out += "\n"
+	}
+
+	_, err := io.WriteString(r.writer, out)
+	return err
+}
+
+func (r *realRenderer) LineWriter(record func(line string) any) io.WriteCloser {
+	if r.format == FormatTable {
+		return nopCloser{r.writer}
+	}
+
+	return &lineWriter{renderer: r, record: record}
+}
+
+func (r *realRenderer) parseTemplate() (*template.Template, error) {
+	tmpl, err := template.New("output").Funcs(templateFuncs).Parse(r.template)
+	if err != nil {
+		return nil, fmt.Errorf("template de saída inválido: %w", err)
+	}
+
+	return tmpl, nil
+}
+
+// renderRecord writes a single streamed record. JSON records are compact so
+// the stream is one object per line.
+func (r *realRenderer) renderRecord(record any) error {
+	switch r.format {
+	case FormatJSON:
+		return json.NewEncoder(r.writer).Encode(record)
+	case FormatYAML:
+		data, err := yaml.Marshal(record)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(r.writer, "---\n%s", data)
+		return err
+	}
+
+	tmpl, err := r.parseTemplate()
+	if err != nil {
+		return err
+	}
+
+	return executeTemplate(r.writer, tmpl, record)
+}
+
+// executeTemplate runs tmpl once per item of a slice, or once for any other
+// value, ending each execution with a line break.
+func executeTemplate(w io.Writer, tmpl *template.Template, data any) error {
+	value := reflect.ValueOf(data)
+	if value.Kind() != reflect.Slice {
+		return executeTemplateLine(w, tmpl, data)
+	}
+
+	for i := 0; i < value.Len(); i++ {
+		if err := executeTemplateLine(w, tmpl, value.Index(i).Interface()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func executeTemplateLine(w io.Writer, tmpl *template.Template, data any) error {
+	if err := tmpl.Execute(w, data); err != nil {
+		return fmt.Errorf("erro ao aplicar o template de saída: %w", err)
+	}
+
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// normalize renders nil slices as empty lists instead of null.
+func normalize(data any) any {
+	value := reflect.ValueOf(data)
+	if value.Kind() == reflect.Slice && value.IsNil() {
+		return []any{}
+	}
+
+	return data
+}
+
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error {
+	return nil
+}
+
+// lineWriter renders every complete line written to it. Close renders the
+// last line when it has no line break.
+type lineWriter struct {
+	renderer *realRenderer
+	record   func(line string) any
+	pending  []byte
+}
+
+func (w *lineWriter) Write(p []byte) (int, error) {
+	w.pending = append(w.pending, p...)
+
+	for {
+		i := bytes.IndexByte(w.pending, '\n')
+		if i < 0 {
+			break
+		}
+
+		line := strings.TrimSuffix(string(w.pending[:i]), "\r")
+		w.pending = w.pending[i+1:]
+
+		if err := w.renderer.renderRecord(w.record(line)); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(p), nil
+}
+
+func (w *lineWriter) Close() error {
+	if len(w.pending) == 0 {
+		return nil
+	}
+
+	line := string(w.pending)
+	w.pending = nil
+	return w.renderer.renderRecord(w.record(line))
+}
@@ -0,0 +1,212 @@
+package output
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testItem struct {
+	Name  string `json:"name" yaml:"name"`
+	Ports []int  `json:"ports" yaml:"ports"`
+}
+
+func newTestRenderer(format Format, template string) (*realRenderer, *bytes.Buffer) {
+	var buf bytes.Buffer
+	return NewRenderer(WithFormat(format), WithTemplate(template), WithWriter(&buf)), &buf
+}
+
+// ============================================================================
+// Tests for ParseFormat
+// ============================================================================
+
+func TestParseFormat_ValidValues(t *testing.T) {
+	tests := []struct {
+		value    string
+		format   Format
+		template string
+	}{
+		{value: "table", format: FormatTable},
+		{value: "json", format: FormatJSON},
+		{value: "yaml", format: FormatYAML},
+		{value: "template={{.Name}} {{.ID}}", format: FormatTemplate, template: "{{.Name}} {{.ID}}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			r := require.New(t)
+
+			format, template, err := ParseFormat(tt.value)
+
+			r.Nil(err)
+			r.Equal(tt.format, format)
+			r.Equal(tt.template, template)
+		})
+	}
+}
+
+func TestParseFormat_InvalidValues(t *testing.T) {
+	for _, value := range []string{"", "xml", "JSON", "template="} {
+		t.Run(value, func(t *testing.T) {
+			r := require.New(t)
+
+			_, _, err := ParseFormat(value)
+
+			r.NotNil(err)
+		})
+	}
+}
+
+func TestSetFormatOverride_SelectsDefaultRendererFormat(t *testing.T) {
+	r := require.New(t)
+	t.Cleanup(func() { SetFormatOverride("table") })
+
+	r.Nil(SetFormatOverride("template={{.Name}}"))
+
+	renderer := NewRenderer()
+	r.Equal(FormatTemplate, renderer.Format())
+	r.Equal("{{.Name}}", renderer.template)
+
+	r.NotNil(SetFormatOverride("xml"))
+	format, _ := GetFormatOverride()
+	r.Equal(FormatTemplate, format)
+}
+
+// ============================================================================
+// Tests for Render
+// ============================================================================
+
+func TestRender_Table_WritesTableWithLineBreak(t *testing.T) {
+	r := require.New(t)
+	renderer, buf := newTestRenderer(FormatTable, "")
+
+	err := renderer.Render([]testItem{{Name: "app"}}, func() string { return "NAME\napp" })
+
+	r.Nil(err)
+	r.Equal("NAME\napp\n", buf.String())
+}
+
+func TestRender_JSON_WritesIndentedJSON(t *testing.T) {
+	r := require.New(t)
+	renderer, buf := newTestRenderer(FormatJSON, "")
+
+	err := renderer.Render([]testItem{{Name: "app", Ports: []int{3000}}}, nil)
+
+	r.Nil(err)
+	r.JSONEq(`[{"name":"app","ports":[3000]}]`, buf.String())
+	r.Contains(buf.String(), "\n  {")
+}
+
+func TestRender_JSON_NilSliceWritesEmptyList(t *testing.T) {
+	r := require.New(t)
+	renderer, buf := newTestRenderer(FormatJSON, "")
+
+	var items []testItem
+	err := renderer.Render(items, nil)
+
+	r.Nil(err)
+	r.Equal("[]\n", buf.String())
+}
+
+func TestRender_YAML_WritesYAML(t *testing.T) {
+	r := require.New(t)
+	renderer, buf := newTestRenderer(FormatYAML, "")
+
+	err := renderer.Render([]testItem{{Name: "app", Ports: []int{3000}}}, nil)
+
+	r.Nil(err)
+	r.Equal("- name: app\n  ports:\n    - 3000\n", buf.String())
+}
+
+func TestRender_Template_ExecutesOncePerItem(t *testing.T) {
+	r := require.New(t)
+	renderer, buf := newTestRenderer(FormatTemplate, "{{.Name}}\t{{json .Ports}}")
+
+	err := renderer.Render([]testItem{{Name: "app", Ports: []int{3000}}, {Name: "db"}}, nil)
+
+	r.Nil(err)
+	r.Equal("app\t[3000]\ndb\tnull\n", buf.String())
+}
+
+func TestRender_Template_SingleValue(t *testing.T) {
+	r := require.New(t)
+	renderer, buf := newTestRenderer(FormatTemplate, "{{upper .Name}}")
+
+	err := renderer.Render(testItem{Name: "app"}, nil)
+
+	r.Nil(err)
+	r.Equal("APP\n", buf.String())
+}
+
+func TestRender_Template_InvalidTemplateReturnsError(t *testing.T) {
+	r := require.New(t)
+	renderer, _ := newTestRenderer(FormatTemplate, "{{.Name")
+
+	err := renderer.Render(testItem{Name: "app"}, nil)
+
+	r.ErrorContains(err, "template de saída inválido")
+}
+
+func TestRender_Template_UnknownFieldReturnsError(t *testing.T) {
+	r := require.New(t)
+	renderer, _ := newTestRenderer(FormatTemplate, "{{.Missing}}")
+
+	err := renderer.Render(testItem{Name: "app"}, nil)
+
+	r.NotNil(err)
+}
+
+// ============================================================================
+// Tests for LineWriter
+// ============================================================================
+
+func writeLines(w io.WriteCloser, chunks ...string) {
+	for _, chunk := range chunks {
+		w.Write([]byte(chunk))
+	}
+	w.Close()
+}
+
+func lineRecord(line string) any {
+	return testItem{Name: line}
+}
+
+func TestLineWriter_Table_WritesStreamAsIs(t *testing.T) {
+	r := require.New(t)
+	renderer, buf := newTestRenderer(FormatTable, "")
+
+	writeLines(renderer.LineWriter(lineRecord), "first\nsec", "ond")
+
+	r.Equal("first\nsecond", buf.String())
+}
+
+func TestLineWriter_JSON_WritesOneObjectPerLine(t *testing.T) {
+	r := require.New(t)
+	renderer, buf := newTestRenderer(FormatJSON, "")
+
+	writeLines(renderer.LineWriter(lineRecord), "first\r\nsec", "ond\nthird")
+
+	r.Equal(`{"name":"first","ports":null}`+"\n"+
+		`{"name":"second","ports":null}`+"\n"+
+		`{"name":"third","ports":null}`+"\n", buf.String())
+}
+
+func TestLineWriter_YAML_WritesOneDocumentPerLine(t *testing.T) {
+	r := require.New(t)
+	renderer, buf := newTestRenderer(FormatYAML, "")
+
+	writeLines(renderer.LineWriter(lineRecord), "first\nsecond\n")
+
+	r.Equal("---\nname: first\nports: []\n---\nname: second\nports: []\n", buf.String())
+}
+
+func TestLineWriter_Template_AppliesTemplatePerLine(t *testing.T) {
+	r := require.New(t)
+	renderer, buf := newTestRenderer(FormatTemplate, "> {{.Name}}")
+
+	writeLines(renderer.LineWriter(lineRecord), "first\nsecond\n")
+
+	r.Equal("> first\n> second\n", buf.String())
+}
@@ -0,0 +1,54 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+)
+
+const templatePrefix = "template="
+
+var formatOverride = struct {
+	format   Format
+	template string
+}{format: FormatTable}
+
+// ParseFormat parses the value of `--output`: table, json, yaml or
+// template=<go template>.
+func ParseFormat(value string) (Format, string, error) {
+	if template, found := strings.CutPrefix(value, templatePrefix); found {
+		if template == "" {
+			return "", "", fmt.Errorf("o template não pode ser vazio")
+		}
+
+		return FormatTemplate, template, nil
+	}
+
+	switch Format(value) {
+	case FormatTable, FormatJSON, FormatYAML:
+		return Format(value), "", nil
+	}
+
+	return "", "", fmt.Errorf("formato de saída inválido: %s (use table, json, yaml ou template=...)", value)
+}
+
+// SetFormatOverride selects the format used by the renderers created after
+// it, usually from the `--output` flag.
+func SetFormatOverride(value string) error {
+	format, template, err := ParseFormat(value)
+	if err != nil {
+		return err
+	}
+
+	formatOverride.format = format
+	formatOverride.template = template
+	return nil
+}
+
+func GetFormatOverride() (Format, string) {
+	return formatOverride.format, formatOverride.template
+}
+
+// CompletionValues lists the values suggested for `--output`.
+func CompletionValues() []string {
+	return []string{string(FormatTable), string(FormatJSON), string(FormatYAML), templatePrefix}
+}
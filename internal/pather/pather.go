@@ -3,5 +3,6 @@ package pather
 type Pather interface {
 	GetAbsPath(target string) (string, error)
 	GetRealPath(absPath string) (string, error)
-	GetPathFromArgs(args []string) string
+	GetPathFromArgs(args []string) (string, error)
+	DiscoverRoot(absPath string) string
 }
@@ -1,13 +1,20 @@
 package pather
 
 import (
+	"os"
+
 	"github.com/Brennon-Oliveira/dev-cli/internal/env"
 	"github.com/Brennon-Oliveira/dev-cli/internal/exec"
+	"github.com/Brennon-Oliveira/dev-cli/internal/project"
 )
 
 type realPather struct {
 	executor  exec.Executor
 	lookupEnv env.LookupEnvFunc
+	registry  project.Registry
+	discover  bool
+	stat      func(name string) (os.FileInfo, error)
+	readDir   func(name string) ([]os.DirEntry, error)
 }
 
 type Option func(*realPather)
@@ -15,6 +22,10 @@ type Option func(*realPather)
 func NewPather(opts ...Option) *realPather {
 	p := &realPather{
 		lookupEnv: env.LookupEnv,
+		registry:  project.NewRegistry(),
+		discover:  true,
+		stat:      os.Stat,
+		readDir:   os.ReadDir,
 	}
 
 	for _, opt := range opts {
@@ -35,3 +46,29 @@ func WithLookupEnv(l env.LookupEnvFunc) Option {
 		p.lookupEnv = l
 	}
 }
+
+func WithRegistry(r project.Registry) Option {
+	return func(p *realPather) {
+		p.registry = r
+	}
+}
+
+// WithDiscover enables or disables the upward search for the workspace root
+// done by DiscoverRoot (--no-discover).
+func WithDiscover(enabled bool) Option {
+	return func(p *realPather) {
+		p.discover = enabled
+	}
+}
+
+func WithStat(f func(name string) (os.FileInfo, error)) Option {
+	return func(p *realPather) {
+		p.stat = f
+	}
+}
+
+func WithReadDir(f func(name string) ([]os.DirEntry, error)) Option {
+	return func(p *realPather) {
+		p.readDir = f
+	}
+}
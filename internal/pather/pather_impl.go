@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"path/filepath"
 	"strings"
+
+	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
 )
 
 func (p *realPather) GetAbsPath(target string) (string, error) {
@@ -13,12 +15,103 @@ func (p *realPather) GetAbsPath(target string) (string, error) {
 	return filepath.Abs(target)
 }
 
-func (p *realPather) GetPathFromArgs(args []string) string {
-	path := ""
-	if len(args) > 0 {
-		path = args[0]
+// GetPathFromArgs returns the path given as the first argument, resolving it
+// as a registered project name when it names one.
+func (p *realPather) GetPathFromArgs(args []string) (string, error) {
+	if len(args) == 0 {
+		return "", nil
+	}
+	return p.resolveProject(args[0])
+}
+
+// resolveProject returns the path of the project registered as target, or
+// target itself when no project has that name. Targets that look like paths
+// are never looked up, and an existing directory wins over a project with
+// the same name.
+func (p *realPather) resolveProject(target string) (string, error) {
+	if p.registry == nil || strings.ContainsAny(target, `/\`) || strings.HasPrefix(target, ".") {
+		return target, nil
+	}
+
+	path, exists, err := p.registry.Lookup(target)
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		return target, nil
+	}
+
+	if info, err := p.stat(target); err == nil && info.IsDir() {
+		logger.Warn("'%s' é um diretório existente e também um projeto registrado em %s; usando o diretório. Use o caminho completo para abrir o projeto.", target, path)
+		return target, nil
+	}
+
+	logger.Verbose("Projeto '%s' resolvido para %s", target, path)
+	return path, nil
+}
+
+// DiscoverRoot walks upward from absPath looking for the directory that
+// holds the devcontainer configuration, so commands work from any
+// subdirectory of a workspace. The search stops at the git root or at the
+// filesystem root; absPath is returned when nothing is found.
+func (p *realPather) DiscoverRoot(absPath string) string {
+	if !p.discover {
+		return absPath
+	}
+
+	dir := absPath
+	for {
+		if p.hasDevcontainerConfig(dir) {
+			if dir != absPath {
+				logger.Verbose("Raiz do workspace encontrada em %s", dir)
+			}
+			return dir
+		}
+
+		if _, err := p.stat(filepath.Join(dir, ".git")); err == nil {
+			break
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			break
+		}
+		dir = parent
+	}
+
+	return absPath
+}
+
+// hasDevcontainerConfig reports whether dir has a `.devcontainer.json`,
+// `.devcontainer/devcontainer.json` or `.devcontainer/<name>/devcontainer.json`.
+func (p *realPather) hasDevcontainerConfig(dir string) bool {
+	candidates := []string{
+		filepath.Join(dir, ".devcontainer", "devcontainer.json"),
+		filepath.Join(dir, ".devcontainer.json"),
 	}
-	return path
+
+	for _, candidate := range candidates {
+		if _, err := p.stat(candidate); err == nil {
+			return true
+		}
+	}
+
+	entries, err := p.readDir(filepath.Join(dir, ".devcontainer"))
+	if err != nil {
+		return false
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		if _, err := p.stat(filepath.Join(dir, ".devcontainer", entry.Name(), "devcontainer.json")); err == nil {
+			return true
+		}
+	}
+
+	return false
 }
 
 func (p *realPather) GetRealPath(absPath string) (string, error) {
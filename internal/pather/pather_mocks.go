@@ -35,6 +35,57 @@ func (_m *MockPather) EXPECT() *MockPather_Expecter {
 	return &MockPather_Expecter{mock: &_m.Mock}
 }
 
+// DiscoverRoot provides a mock function for the type MockPather
+func (_mock *MockPather) DiscoverRoot(absPath string) string {
+	ret := _mock.Called(absPath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DiscoverRoot")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(absPath)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockPather_DiscoverRoot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiscoverRoot'
+type MockPather_DiscoverRoot_Call struct {
+	*mock.Call
+}
+
+// DiscoverRoot is a helper method to define mock.On call
+//   - absPath string
+func (_e *MockPather_Expecter) DiscoverRoot(absPath interface{}) *MockPather_DiscoverRoot_Call {
+	return &MockPather_DiscoverRoot_Call{Call: _e.mock.On("DiscoverRoot", absPath)}
+}
+
+func (_c *MockPather_DiscoverRoot_Call) Run(run func(absPath string)) *MockPather_DiscoverRoot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPather_DiscoverRoot_Call) Return(s string) *MockPather_DiscoverRoot_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockPather_DiscoverRoot_Call) RunAndReturn(run func(absPath string) string) *MockPather_DiscoverRoot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAbsPath provides a mock function for the type MockPather
 func (_mock *MockPather) GetAbsPath(target string) (string, error) {
 	ret := _mock.Called(target)
@@ -96,7 +147,7 @@ func (_c *MockPather_GetAbsPath_Call) RunAndReturn(run func(target string) (stri
 }
 
 // GetPathFromArgs provides a mock function for the type MockPather
-func (_mock *MockPather) GetPathFromArgs(args []string) string {
+func (_mock *MockPather) GetPathFromArgs(args []string) (string, error) {
 	ret := _mock.Called(args)
 
 	if len(ret) == 0 {
@@ -104,12 +155,21 @@ func (_mock *MockPather) GetPathFromArgs(args []string) string {
 	}
 
 	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]string) (string, error)); ok {
+		return returnFunc(args)
+	}
 	if returnFunc, ok := ret.Get(0).(func([]string) string); ok {
 		r0 = returnFunc(args)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = returnFunc(args)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // MockPather_GetPathFromArgs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPathFromArgs'
@@ -136,12 +196,12 @@ func (_c *MockPather_GetPathFromArgs_Call) Run(run func(args []string)) *MockPat
 	return _c
 }
 
-func (_c *MockPather_GetPathFromArgs_Call) Return(s string) *MockPather_GetPathFromArgs_Call {
-	_c.Call.Return(s)
+func (_c *MockPather_GetPathFromArgs_Call) Return(s string, err error) *MockPather_GetPathFromArgs_Call {
+	_c.Call.Return(s, err)
 	return _c
 }
 
-func (_c *MockPather_GetPathFromArgs_Call) RunAndReturn(run func(args []string) string) *MockPather_GetPathFromArgs_Call {
+func (_c *MockPather_GetPathFromArgs_Call) RunAndReturn(run func(args []string) (string, error)) *MockPather_GetPathFromArgs_Call {
 	_c.Call.Return(run)
 	return _c
 }
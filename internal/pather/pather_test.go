@@ -1,14 +1,17 @@
 package pather
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"testing"
 
 	"github.com/Brennon-Oliveira/dev-cli/internal/exec"
 	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
+	"github.com/Brennon-Oliveira/dev-cli/internal/project"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -70,7 +73,8 @@ func TestGetAbsPath_AbsolutePathUncharged(t *testing.T) {
 func TestGetPathFromArgs_Empty(t *testing.T) {
 	args := []string{}
 	pather := NewPather()
-	got := pather.GetPathFromArgs(args)
+	got, err := pather.GetPathFromArgs(args)
+	assert.Nil(t, err)
 	assert.Empty(t, got)
 }
 
@@ -78,7 +82,8 @@ func TestGetPathFromArgs_RelativeWithDotPath(t *testing.T) {
 	path := "./subdir"
 	args := []string{path}
 	pather := NewPather()
-	got := pather.GetPathFromArgs(args)
+	got, err := pather.GetPathFromArgs(args)
+	assert.Nil(t, err)
 	assert.Equal(t, path, got)
 }
 
@@ -86,7 +91,8 @@ func TestGetPathFromArgs_RelativeWithoutDotPath(t *testing.T) {
 	path := "subdir"
 	args := []string{path}
 	pather := NewPather()
-	got := pather.GetPathFromArgs(args)
+	got, err := pather.GetPathFromArgs(args)
+	assert.Nil(t, err)
 	assert.Equal(t, path, got)
 }
 
@@ -94,7 +100,8 @@ func TestGetPathFromArgs_AbsolutePath(t *testing.T) {
 	path := "/tmp/test"
 	args := []string{path}
 	pather := NewPather()
-	got := pather.GetPathFromArgs(args)
+	got, err := pather.GetPathFromArgs(args)
+	assert.Nil(t, err)
 	assert.Equal(t, path, got)
 }
 
@@ -157,3 +164,176 @@ func TestGetRealPath_NoWSLReturnsOriginal(t *testing.T) {
 	r.Nil(err)
 	assert.Equal(t, path, got)
 }
+
+type fakeFileInfo struct {
+	os.FileInfo
+	dir bool
+}
+
+func (f fakeFileInfo) IsDir() bool { return f.dir }
+
+func newRegistryPather(registry project.Registry, dirs ...string) *realPather {
+	return NewPather(
+		WithRegistry(registry),
+		WithStat(func(name string) (os.FileInfo, error) {
+			if slices.Contains(dirs, name) {
+				return fakeFileInfo{dir: true}, nil
+			}
+			return nil, os.ErrNotExist
+		}),
+	)
+}
+
+func TestGetPathFromArgs_RegisteredProject_ReturnsProjectPath(t *testing.T) {
+	r := require.New(t)
+
+	registry := project.NewMockRegistry(t)
+	registry.EXPECT().Lookup("api").Return("/home/testuser/api", true, nil)
+
+	got, err := newRegistryPather(registry).GetPathFromArgs([]string{"api"})
+	r.Nil(err)
+	r.Equal("/home/testuser/api", got)
+}
+
+func TestGetPathFromArgs_UnknownProject_TreatedAsPath(t *testing.T) {
+	r := require.New(t)
+
+	registry := project.NewMockRegistry(t)
+	registry.EXPECT().Lookup("subdir").Return("", false, nil)
+
+	got, err := newRegistryPather(registry).GetPathFromArgs([]string{"subdir"})
+	r.Nil(err)
+	r.Equal("subdir", got)
+}
+
+func TestGetPathFromArgs_ExistingDirectory_WinsOverProject(t *testing.T) {
+	r := require.New(t)
+
+	registry := project.NewMockRegistry(t)
+	registry.EXPECT().Lookup("src").Return("/home/testuser/src", true, nil)
+
+	got, err := newRegistryPather(registry, "src").GetPathFromArgs([]string{"src"})
+	r.Nil(err)
+	r.Equal("src", got)
+}
+
+func TestGetPathFromArgs_PathLikeTarget_NotLookedUp(t *testing.T) {
+	for _, target := range []string{".", "./api", "../api", "projects/api", "/home/testuser/api"} {
+		t.Run(target, func(t *testing.T) {
+			r := require.New(t)
+
+			registry := project.NewMockRegistry(t)
+
+			got, err := newRegistryPather(registry).GetPathFromArgs([]string{target})
+			r.Nil(err)
+			r.Equal(target, got)
+		})
+	}
+}
+
+func TestGetPathFromArgs_UnreadableRegistry_ReturnsError(t *testing.T) {
+	r := require.New(t)
+
+	registry := project.NewMockRegistry(t)
+	registry.EXPECT().Lookup("api").Return("", false, errors.New("registro de projetos corrompido"))
+
+	_, err := newRegistryPather(registry).GetPathFromArgs([]string{"api"})
+	r.NotNil(err)
+}
+
+func TestGetAbsPath_DoesNotLookUpProjects(t *testing.T) {
+	r := require.New(t)
+	cwd, err := os.Getwd()
+	r.Nil(err)
+
+	registry := project.NewMockRegistry(t)
+
+	got, err := newRegistryPather(registry).GetAbsPath("billing")
+	r.Nil(err)
+	r.Equal(filepath.Join(cwd, "billing"), got)
+}
+
+type fakeDirEntry struct {
+	os.DirEntry
+	name string
+}
+
+func (f fakeDirEntry) Name() string { return f.name }
+func (f fakeDirEntry) IsDir() bool  { return true }
+
+func newDiscoverPather(files []string, dirs map[string][]string) *realPather {
+	return NewPather(
+		WithStat(func(name string) (os.FileInfo, error) {
+			if slices.Contains(files, name) {
+				return nil, nil
+			}
+			return nil, os.ErrNotExist
+		}),
+		WithReadDir(func(name string) ([]os.DirEntry, error) {
+			var entries []os.DirEntry
+			for _, entry := range dirs[name] {
+				entries = append(entries, fakeDirEntry{name: entry})
+			}
+			if entries == nil {
+				return nil, os.ErrNotExist
+			}
+			return entries, nil
+		}),
+	)
+}
+
+func TestDiscoverRoot(t *testing.T) {
+	tests := []struct {
+		name     string
+		start    string
+		files    []string
+		dirs     map[string][]string
+		expected string
+	}{
+		{
+			name:     "config in the same directory",
+			start:    "/work/repo",
+			files:    []string{"/work/repo/.devcontainer/devcontainer.json"},
+			expected: "/work/repo",
+		},
+		{
+			name:     "devcontainer.json at the root of a parent",
+			start:    "/work/repo/src/pkg",
+			files:    []string{"/work/repo/.devcontainer.json"},
+			expected: "/work/repo",
+		},
+		{
+			name:     "named configuration in a parent",
+			start:    "/work/repo/src",
+			files:    []string{"/work/repo/.devcontainer/python/devcontainer.json"},
+			dirs:     map[string][]string{"/work/repo/.devcontainer": {"python"}},
+			expected: "/work/repo",
+		},
+		{
+			name:     "stops at the git root",
+			start:    "/work/repo/src",
+			files:    []string{"/work/repo/.git", "/work/.devcontainer.json"},
+			expected: "/work/repo/src",
+		},
+		{
+			name:     "nothing found until the filesystem root",
+			start:    "/work/repo/src",
+			expected: "/work/repo/src",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pather := newDiscoverPather(tt.files, tt.dirs)
+
+			assert.Equal(t, tt.expected, pather.DiscoverRoot(tt.start))
+		})
+	}
+}
+
+func TestDiscoverRoot_Disabled_ReturnsPath(t *testing.T) {
+	pather := newDiscoverPather([]string{"/work/repo/.devcontainer.json"}, nil)
+	WithDiscover(false)(pather)
+
+	assert.Equal(t, "/work/repo/src", pather.DiscoverRoot("/work/repo/src"))
+}
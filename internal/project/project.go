@@ -0,0 +1,16 @@
+package project
+
+// Project is a workspace registered under a short name, so it can be used
+// instead of its path, e.g. `dev run api`.
+type Project struct {
+	Name string `json:"-"`
+	Path string `json:"path"`
+}
+
+type Registry interface {
+	GetRegistryPath() (string, error)
+	Add(name string, path string) error
+	Remove(name string) error
+	List() ([]Project, error)
+	Lookup(name string) (string, bool, error)
+}
@@ -0,0 +1,59 @@
+package project
+
+import "os"
+
+type realRegistry struct {
+	userHomeDir func() (string, error)
+	readFile    func(name string) ([]byte, error)
+	mkdirAll    func(path string, perm os.FileMode) error
+	writeFile   func(name string, data []byte, perm os.FileMode) error
+	stat        func(name string) (os.FileInfo, error)
+}
+
+type Option func(*realRegistry)
+
+func NewRegistry(opts ...Option) *realRegistry {
+	r := &realRegistry{
+		userHomeDir: os.UserHomeDir,
+		readFile:    os.ReadFile,
+		mkdirAll:    os.MkdirAll,
+		writeFile:   os.WriteFile,
+		stat:        os.Stat,
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+func WithUserHomeDir(f func() (string, error)) Option {
+	return func(r *realRegistry) {
+		r.userHomeDir = f
+	}
+}
+
+func WithReadFile(f func(name string) ([]byte, error)) Option {
+	return func(r *realRegistry) {
+		r.readFile = f
+	}
+}
+
+func WithMkdirAll(f func(path string, perm os.FileMode) error) Option {
+	return func(r *realRegistry) {
+		r.mkdirAll = f
+	}
+}
+
+func WithWriteFile(f func(name string, data []byte, perm os.FileMode) error) Option {
+	return func(r *realRegistry) {
+		r.writeFile = f
+	}
+}
+
+func WithStat(f func(name string) (os.FileInfo, error)) Option {
+	return func(r *realRegistry) {
+		r.stat = f
+	}
+}
@@ -0,0 +1,156 @@
+package project
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
+)
+
+type registryFile struct {
+	Projects map[string]Project `json:"projects"`
+}
+
+func (r *realRegistry) GetRegistryPath() (string, error) {
+	home, err := r.userHomeDir()
+	if err != nil {
+		logger.Error("Não foi possível determinar o diretório home do usuário")
+		return "", err
+	}
+
+	return filepath.Join(home, ".dev-cli", "projects.json"), nil
+}
+
+func (r *realRegistry) Add(name string, path string) error {
+	if err := ValidateName(name); err != nil {
+		logger.Error("%v", err)
+		return err
+	}
+
+	info, err := r.stat(path)
+	if err != nil || !info.IsDir() {
+		logger.Error("O caminho %s não existe ou não é um diretório", path)
+		return fmt.Errorf("diretório inválido: %s", path)
+	}
+
+	file, err := r.read()
+	if err != nil {
+		return err
+	}
+
+	if existing, exists := file.Projects[name]; exists {
+		logger.Error("O projeto '%s' já está registrado em %s. Remova-o com 'dev project rm %s' antes", name, existing.Path, name)
+		return fmt.Errorf("projeto já registrado: %s", name)
+	}
+
+	file.Projects[name] = Project{Path: path}
+
+	return r.write(file)
+}
+
+func (r *realRegistry) Remove(name string) error {
+	file, err := r.read()
+	if err != nil {
+		return err
+	}
+
+	if _, exists := file.Projects[name]; !exists {
+		logger.Error("O projeto '%s' não está registrado", name)
+		return fmt.Errorf("projeto não registrado: %s", name)
+	}
+
+	delete(file.Projects, name)
+
+	return r.write(file)
+}
+
+// List returns the registered projects sorted by name.
+func (r *realRegistry) List() ([]Project, error) {
+	file, err := r.read()
+	if err != nil {
+		return nil, err
+	}
+
+	projects := make([]Project, 0, len(file.Projects))
+	for name, project := range file.Projects {
+		project.Name = name
+		projects = append(projects, project)
+	}
+
+	slices.SortFunc(projects, func(a, b Project) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return projects, nil
+}
+
+// Lookup returns the path of the project registered as name. Anything that
+// isn't a valid project name is never looked up, so paths like "." or
+// "./api" keep their meaning. A registry that can't be read is reported
+// instead of being treated as empty.
+func (r *realRegistry) Lookup(name string) (string, bool, error) {
+	if ValidateName(name) != nil {
+		return "", false, nil
+	}
+
+	file, err := r.read()
+	if err != nil {
+		return "", false, err
+	}
+
+	project, exists := file.Projects[name]
+
+	return project.Path, exists, nil
+}
+
+func (r *realRegistry) read() (*registryFile, error) {
+	file := &registryFile{Projects: map[string]Project{}}
+
+	path, err := r.GetRegistryPath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := r.readFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return file, nil
+	}
+	if err != nil {
+		logger.Error("Não foi possível ler o registro de projetos %s", path)
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, file); err != nil {
+		logger.Error("O registro de projetos %s está corrompido: %v", path, err)
+		return nil, fmt.Errorf("registro de projetos corrompido %s: %w", path, err)
+	}
+
+	if file.Projects == nil {
+		file.Projects = map[string]Project{}
+	}
+
+	return file, nil
+}
+
+func (r *realRegistry) write(file *registryFile) error {
+	path, err := r.GetRegistryPath()
+	if err != nil {
+		return err
+	}
+
+	if err := r.mkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(file, "", " ")
+	if err != nil {
+		return err
+	}
+
+	return r.writeFile(path, data, 0644)
+}
@@ -0,0 +1,217 @@
+package project
+
+import (
+	"os"
+	"testing"
+
+	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	logger.InitLogger()
+	exitCode := m.Run()
+	os.Exit(exitCode)
+}
+
+type fakeDirInfo struct {
+	os.FileInfo
+	dir bool
+}
+
+func (f fakeDirInfo) IsDir() bool { return f.dir }
+
+func newTestRegistry(content string, written *[]byte) *realRegistry {
+	return NewRegistry(
+		WithUserHomeDir(func() (string, error) {
+			return "/home/testuser", nil
+		}),
+		WithReadFile(func(name string) ([]byte, error) {
+			if content == "" {
+				return nil, os.ErrNotExist
+			}
+			return []byte(content), nil
+		}),
+		WithMkdirAll(func(path string, perm os.FileMode) error {
+			return nil
+		}),
+		WithWriteFile(func(name string, data []byte, perm os.FileMode) error {
+			*written = data
+			return nil
+		}),
+		WithStat(func(name string) (os.FileInfo, error) {
+			switch name {
+			case "/home/testuser/api", "/home/testuser/billing":
+				return fakeDirInfo{dir: true}, nil
+			case "/home/testuser/file.txt":
+				return fakeDirInfo{dir: false}, nil
+			}
+			return nil, os.ErrNotExist
+		}),
+	)
+}
+
+// ============================================================================
+// Tests for GetRegistryPath
+// ============================================================================
+
+func TestGetRegistryPath_ReturnsFileInDevCliDir(t *testing.T) {
+	r := require.New(t)
+
+	var written []byte
+	registry := newTestRegistry("", &written)
+
+	path, err := registry.GetRegistryPath()
+
+	r.Nil(err)
+	r.Equal("/home/testuser/.dev-cli/projects.json", path)
+}
+
+// ============================================================================
+// Tests for Add
+// ============================================================================
+
+func TestAdd_NewProject_WritesRegistry(t *testing.T) {
+	r := require.New(t)
+
+	var written []byte
+	registry := newTestRegistry(`{"projects":{"billing":{"path":"/home/testuser/billing"}}}`, &written)
+
+	err := registry.Add("api", "/home/testuser/api")
+
+	r.Nil(err)
+	r.JSONEq(`{"projects":{
+		"api":{"path":"/home/testuser/api"},
+		"billing":{"path":"/home/testuser/billing"}
+	}}`, string(written))
+}
+
+func TestAdd_InvalidInput_ReturnsErrorWithoutWriting(t *testing.T) {
+	tests := []struct {
+		name        string
+		projectName string
+		path        string
+	}{
+		{name: "name looks like a path", projectName: "./api", path: "/home/testuser/api"},
+		{name: "name is dot dot", projectName: "..", path: "/home/testuser/api"},
+		{name: "path does not exist", projectName: "api", path: "/home/testuser/missing"},
+		{name: "path is a file", projectName: "api", path: "/home/testuser/file.txt"},
+		{name: "name already registered", projectName: "billing", path: "/home/testuser/api"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			var written []byte
+			registry := newTestRegistry(`{"projects":{"billing":{"path":"/home/testuser/billing"}}}`, &written)
+
+			err := registry.Add(tt.projectName, tt.path)
+
+			r.NotNil(err)
+			r.Nil(written)
+		})
+	}
+}
+
+// ============================================================================
+// Tests for Remove
+// ============================================================================
+
+func TestRemove_RegisteredProject_WritesRegistry(t *testing.T) {
+	r := require.New(t)
+
+	var written []byte
+	registry := newTestRegistry(`{"projects":{"api":{"path":"/home/testuser/api"}}}`, &written)
+
+	err := registry.Remove("api")
+
+	r.Nil(err)
+	r.JSONEq(`{"projects":{}}`, string(written))
+}
+
+func TestRemove_UnknownProject_ReturnsError(t *testing.T) {
+	r := require.New(t)
+
+	var written []byte
+	registry := newTestRegistry("", &written)
+
+	err := registry.Remove("api")
+
+	r.NotNil(err)
+	r.Nil(written)
+}
+
+// ============================================================================
+// Tests for List and Lookup
+// ============================================================================
+
+func TestList_ReturnsProjectsSortedByName(t *testing.T) {
+	r := require.New(t)
+
+	var written []byte
+	registry := newTestRegistry(`{"projects":{
+		"billing":{"path":"/home/testuser/billing"},
+		"api":{"path":"/home/testuser/api"}
+	}}`, &written)
+
+	projects, err := registry.List()
+
+	r.Nil(err)
+	r.Equal([]Project{
+		{Name: "api", Path: "/home/testuser/api"},
+		{Name: "billing", Path: "/home/testuser/billing"},
+	}, projects)
+}
+
+func TestList_CorruptFile_ReturnsError(t *testing.T) {
+	r := require.New(t)
+
+	var written []byte
+	registry := newTestRegistry(`{"projects":`, &written)
+
+	_, err := registry.List()
+
+	r.NotNil(err)
+}
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		name         string
+		target       string
+		expectedPath string
+		expectedOk   bool
+	}{
+		{name: "registered name", target: "api", expectedPath: "/home/testuser/api", expectedOk: true},
+		{name: "unknown name", target: "billing", expectedOk: false},
+		{name: "dot is never a project", target: ".", expectedOk: false},
+		{name: "relative path is never a project", target: "./api", expectedOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			var written []byte
+			registry := newTestRegistry(`{"projects":{"api":{"path":"/home/testuser/api"}}}`, &written)
+
+			path, ok, err := registry.Lookup(tt.target)
+
+			r.Nil(err)
+			r.Equal(tt.expectedOk, ok)
+			r.Equal(tt.expectedPath, path)
+		})
+	}
+}
+
+func TestLookup_CorruptRegistry_ReturnsError(t *testing.T) {
+	r := require.New(t)
+
+	var written []byte
+	registry := newTestRegistry(`{"projects":`, &written)
+
+	_, ok, err := registry.Lookup("api")
+
+	r.NotNil(err)
+	r.False(ok)
+}
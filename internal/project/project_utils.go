@@ -0,0 +1,18 @@
+package project
+
+import (
+	"fmt"
+	"regexp"
+)
+
+var namePattern = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+// ValidateName ensures name can't be mistaken for a path, e.g. "api" or
+// "billing-v2" but not "./api" or "..".
+func ValidateName(name string) error {
+	if !namePattern.MatchString(name) {
+		return fmt.Errorf("nome de projeto inválido '%s': use letras, números, '.', '-' e '_', começando com letra ou número", name)
+	}
+
+	return nil
+}
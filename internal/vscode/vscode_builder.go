@@ -1,6 +1,7 @@
 package vscode
 
 import (
+	"github.com/Brennon-Oliveira/dev-cli/internal/config"
 	"github.com/Brennon-Oliveira/dev-cli/internal/devcontainer"
 	"github.com/Brennon-Oliveira/dev-cli/internal/exec"
 	"github.com/Brennon-Oliveira/dev-cli/internal/pather"
@@ -10,6 +11,7 @@ type realVSCode struct {
 	pather          pather.Pather
 	executor        exec.Executor
 	devcontainerCLI devcontainer.DevContainerCLI
+	config          config.Config
 }
 
 type Option func(*realVSCode)
@@ -41,3 +43,9 @@ func WithExecutor(e exec.Executor) Option {
 		vs.executor = e
 	}
 }
+
+func WithConfig(c config.Config) Option {
+	return func(vs *realVSCode) {
+		vs.config = c
+	}
+}
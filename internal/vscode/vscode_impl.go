@@ -3,6 +3,7 @@ package vscode
 import (
 	"encoding/hex"
 	"fmt"
+	"strings"
 
 	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
 )
@@ -34,5 +35,19 @@ func (r *realVSCode) GetContainerWorkspaceURI(absPath string) (string, error) {
 }
 
 func (r *realVSCode) OpenWorkspaceByURI(workspaceURI string) error {
-	return r.executor.RunDetached("code", "--folder-uri", workspaceURI)
+	editor := []string{"code"}
+	if r.config != nil {
+		cfg, err := r.config.Load()
+		if err != nil {
+			return err
+		}
+
+		if fields := strings.Fields(cfg.Editor.Command); len(fields) > 0 {
+			editor = fields
+		}
+	}
+
+	args := append(editor[1:], "--folder-uri", workspaceURI)
+
+	return r.executor.RunDetached(editor[0], args...)
 }
@@ -6,6 +6,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/Brennon-Oliveira/dev-cli/internal/config"
 	"github.com/Brennon-Oliveira/dev-cli/internal/devcontainer"
 	"github.com/Brennon-Oliveira/dev-cli/internal/exec"
 	"github.com/Brennon-Oliveira/dev-cli/internal/logger"
@@ -72,3 +73,24 @@ func TestOpenWorkspaceByURI_ShouldTryOpenVscode(t *testing.T) {
 	err := vscode.OpenWorkspaceByURI(workspaceURI)
 	a.Nil(err)
 }
+
+func TestOpenWorkspaceByURI_UsesConfiguredEditorCommand(t *testing.T) {
+	r := require.New(t)
+
+	executor := exec.NewMockExecutor(t)
+	cfg := config.NewMockConfig(t)
+
+	globalCfg := config.GlobalConfig{}
+	globalCfg.Editor.Command = "code-insiders --new-window"
+	cfg.EXPECT().Load().Return(globalCfg, nil)
+
+	executor.EXPECT().RunDetached("code-insiders", []string{"--new-window", "--folder-uri", "vscode-remote://uri"}).Return(nil)
+
+	vscode := NewVSCode(
+		WithExecutor(executor),
+		WithConfig(cfg),
+	)
+
+	err := vscode.OpenWorkspaceByURI("vscode-remote://uri")
+	r.Nil(err)
+}